@@ -0,0 +1,154 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package diff
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// NewDiffCommand creates the diff sub command for apisix-ingress-controller,
+// it shows the pending changes reported by a running controller.
+func NewDiffCommand() *cobra.Command {
+	var (
+		endpoint string
+		cluster  string
+		kind     string
+		key      string
+		output   string
+		timeout  time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "diff [flags]",
+		Short: "show the pending changes of APISIX resources",
+		Long: `show the pending changes of APISIX resources
+
+The resources are translated by the leader apisix-ingress-controller and compared with
+its APISIX cache, nothing is applied. All the resources are compared by default, use
+--kind and --key to compare a single kind or resource. The controller must run with
+enable_diff, and the configs of plugins are redacted.
+
+    apisix-ingress-controller diff --endpoint http://127.0.0.1:8080 --kind ApisixRoute --key default/httpbin`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if output != outputText && output != outputJSON {
+				return fmt.Errorf("unsupported output format %s", output)
+			}
+			query := url.Values{}
+			for name, value := range map[string]string{"cluster": cluster, "kind": kind, "key": key} {
+				if value != "" {
+					query.Set(name, value)
+				}
+			}
+			client := &http.Client{Timeout: timeout}
+			resp, err := client.Get(strings.TrimSuffix(endpoint, "/") + "/debug/diff?" + query.Encode())
+			if err != nil {
+				return err
+			}
+			defer resp.Body.Close()
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return err
+			}
+			if resp.StatusCode == http.StatusNotFound {
+				return errors.New("the diff endpoint is not found, enable_diff should be set")
+			}
+			if resp.StatusCode != http.StatusOK {
+				var errResp struct {
+					Error string `json:"error"`
+				}
+				if err := json.Unmarshal(data, &errResp); err != nil || errResp.Error == "" {
+					return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+				}
+				return errors.New(errResp.Error)
+			}
+			if output == outputJSON {
+				_, err = cmd.OutOrStdout().Write(append(data, '\n'))
+				return err
+			}
+			var diff utils.ManifestDiff
+			if err := json.Unmarshal(data, &diff); err != nil {
+				return err
+			}
+			printDiff(cmd.OutOrStdout(), &diff)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&endpoint, "endpoint", "http://127.0.0.1:8080", "the HTTP endpoint of the leader apisix-ingress-controller")
+	cmd.Flags().StringVar(&cluster, "cluster", "", "the APISIX cluster to compare with, the default cluster is used if empty")
+	cmd.Flags().StringVar(&kind, "kind", "", "the kind of resources to compare, e.g. ApisixRoute, Ingress")
+	cmd.Flags().StringVar(&key, "key", "", "the key (namespace/name) of the resource to compare, --kind is required")
+	cmd.Flags().StringVarP(&output, "output", "o", outputText, "output format, text or json")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Second, "timeout of the request")
+	return cmd
+}
+
+func printDiff(w io.Writer, diff *utils.ManifestDiff) {
+	fmt.Fprintf(w, "cluster: %s\n", diff.Cluster)
+	if len(diff.Objects) == 0 {
+		fmt.Fprintln(w, "no pending changes")
+	}
+	for _, obj := range diff.Objects {
+		sign := "~"
+		switch obj.Action {
+		case utils.DiffActionAdd:
+			sign = "+"
+		case utils.DiffActionDelete:
+			sign = "-"
+		}
+		if obj.Name != "" {
+			fmt.Fprintf(w, "%s %s %s (id: %s)\n", sign, obj.Kind, obj.Name, obj.ID)
+		} else {
+			fmt.Fprintf(w, "%s %s %s\n", sign, obj.Kind, obj.ID)
+		}
+		for _, field := range obj.Fields {
+			fmt.Fprintf(w, "    %s: %s -> %s\n", field.Path, formatValue(field.Old), formatValue(field.New))
+		}
+	}
+	if len(diff.Errors) > 0 {
+		fmt.Fprintln(w, "errors:")
+		for _, err := range diff.Errors {
+			fmt.Fprintf(w, "    %s\n", err)
+		}
+	}
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,77 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package diff
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffCommand(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/debug/diff", r.URL.Path)
+		if r.URL.Query().Get("cluster") == "disabled" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("kind") == "Foo" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"bad diff request: unsupported resource kind Foo"}`))
+			return
+		}
+		assert.Equal(t, "ApisixRoute", r.URL.Query().Get("kind"))
+		assert.Equal(t, "default/httpbin", r.URL.Query().Get("key"))
+		_, _ = w.Write([]byte(`{
+  "cluster": "default",
+  "objects": [
+    {"kind": "route", "id": "1", "name": "default_httpbin_rule1", "action": "add"},
+    {"kind": "upstream", "id": "2", "name": "default_httpbin_80", "action": "update",
+     "fields": [{"path": "nodes[0].host", "old": "10.0.0.1", "new": "10.0.0.2"}]}
+  ]
+}`))
+	}))
+	defer srv.Close()
+
+	var out bytes.Buffer
+	cmd := NewDiffCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"--endpoint", srv.URL, "--kind", "ApisixRoute", "--key", "default/httpbin"})
+	assert.Nil(t, cmd.Execute())
+	assert.Equal(t, `cluster: default
++ route default_httpbin_rule1 (id: 1)
+~ upstream default_httpbin_80 (id: 2)
+    nodes[0].host: "10.0.0.1" -> "10.0.0.2"
+`, out.String())
+
+	cmd = NewDiffCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--endpoint", srv.URL, "--kind", "Foo"})
+	err := cmd.Execute()
+	assert.NotNil(t, err)
+	assert.Equal(t, "bad diff request: unsupported resource kind Foo", err.Error())
+
+	cmd = NewDiffCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--endpoint", srv.URL, "--cluster", "disabled"})
+	err = cmd.Execute()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "enable_diff")
+}
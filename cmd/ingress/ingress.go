@@ -177,10 +177,12 @@ the static address provided here will be used to update the status information o
 When ingress-publish-service is specified at the same time, ingress-status-address is preferred.
 For example, no available LB exists in the bare metal environment.`)
 	cmd.PersistentFlags().BoolVar(&cfg.EnableProfiling, "enable-profiling", true, "enable profiling via web interface host:port/debug/pprof")
+	cmd.PersistentFlags().BoolVar(&cfg.EnableDiff, "enable-diff", false, "enable the pending changes of APISIX resources via web interface host:port/debug/diff")
 	cmd.PersistentFlags().StringVar(&cfg.Kubernetes.Kubeconfig, "kubeconfig", "", "Kubernetes configuration file (by default in-cluster configuration will be used)")
 	cmd.PersistentFlags().DurationVar(&cfg.Kubernetes.ResyncInterval.Duration, "resync-interval", time.Minute, "the controller resync (with Kubernetes) interval, the minimum resync interval is 30s")
 	cmd.PersistentFlags().StringSliceVar(&cfg.Kubernetes.NamespaceSelector, "namespace-selector", []string{""}, "labels that controller used to select namespaces which will watch for resources")
 	cmd.PersistentFlags().StringVar(&cfg.Kubernetes.IngressClass, "ingress-class", config.IngressClass, "the class of an Ingress object is set using the field IngressClassName in Kubernetes clusters version v1.18.0 or higher or the annotation \"kubernetes.io/ingress.class\" (deprecated)")
+	cmd.PersistentFlags().StringVar(&cfg.Kubernetes.IngressClassController, "ingress-class-controller", config.IngressClassController, "the controller value of the IngressClass resources served by this controller")
 	cmd.PersistentFlags().StringVar(&cfg.Kubernetes.ElectionID, "election-id", config.IngressAPISIXLeader, "election id used for campaign the controller leader")
 	cmd.PersistentFlags().StringVar(&cfg.Kubernetes.IngressVersion, "ingress-version", config.IngressNetworkingV1, "the supported ingress api group version, can be \"networking/v1beta1\", \"networking/v1\" (for Kubernetes version v1.19.0 or higher) and \"extensions/v1beta1\"")
 	cmd.PersistentFlags().StringVar(&cfg.Kubernetes.APIVersion, "api-version", config.DefaultAPIVersion, config.APIVersionDescribe)
@@ -190,8 +192,23 @@ For example, no available LB exists in the bare metal environment.`)
 	cmd.PersistentFlags().StringVar(&cfg.APISIX.AdminAPIVersion, "apisix-admin-api-version", "v2", `the APISIX admin API version. can be "v2" or "v3". Default value is v2.`)
 	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterBaseURL, "default-apisix-cluster-base-url", "", "the base URL of admin api / manager api for the default APISIX cluster")
 	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterAdminKey, "default-apisix-cluster-admin-key", "", "admin key used for the authorization of admin api / manager api for the default APISIX cluster")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterAdminKeySecret, "default-apisix-cluster-admin-key-secret", "", "the Secret (in the format of namespace/name) which holds the admin key for the default APISIX cluster, takes precedence over --default-apisix-cluster-admin-key")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterAdminKeySecretKey, "default-apisix-cluster-admin-key-secret-key", config.DefaultAdminKeySecretKey, "the key in the admin key Secret whose value is the admin key")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterCACertFile, "default-apisix-cluster-ca-cert-file", "", "the PEM encoded CA bundle file used to verify the certificate of admin api for the default APISIX cluster")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterClientCertFile, "default-apisix-cluster-client-cert-file", "", "the client certificate file used for mutual TLS with admin api of the default APISIX cluster")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterClientKeyFile, "default-apisix-cluster-client-key-file", "", "the client private key file used for mutual TLS with admin api of the default APISIX cluster")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterTLSServerName, "default-apisix-cluster-tls-server-name", "", "the server name used to verify the certificate of admin api for the default APISIX cluster")
+	cmd.PersistentFlags().BoolVar(&cfg.APISIX.TransactionalSync, "apisix-transactional-sync", false, "apply the objects translated from a resource as a whole, and roll back the applied ones once any of them failed")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.ConfigProvider, "apisix-config-provider", config.ConfigProviderAdminAPI, `the way to deliver resources to APISIX, can be "admin_api" or "yaml". "yaml" renders resources into the APISIX standalone config`)
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.StandaloneConfigFile, "apisix-standalone-config-file", "", "the path of apisix.yaml to render when the config provider is yaml")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.StandaloneConfigMap, "apisix-standalone-config-map", "", "the ConfigMap (in the format of namespace/name) to render apisix.yaml into when the config provider is yaml, takes precedence over --apisix-standalone-config-file")
+	cmd.PersistentFlags().StringVar(&cfg.APISIX.StandaloneConfigMapKey, "apisix-standalone-config-map-key", config.DefaultStandaloneConfigMapKey, "the key in the standalone ConfigMap whose value is apisix.yaml")
+	cmd.PersistentFlags().BoolVar(&cfg.APISIX.DisableGarbageCollection, "disable-garbage-collection", false, "disable deleting the APISIX objects owned by this controller instance (identified by the election id) whose owner resources no longer exist")
 	cmd.PersistentFlags().StringVar(&cfg.APISIX.DefaultClusterName, "default-apisix-cluster-name", "default", "name of the default apisix cluster")
 	cmd.PersistentFlags().DurationVar(&cfg.ApisixResourceSyncInterval.Duration, "apisix-resource-sync-interval", 1*time.Hour, "interval between syncs in seconds. Default value is 1h. Set to 0 to disable.")
+	cmd.PersistentFlags().DurationVar(&cfg.DriftDetection.Interval.Duration, "drift-detection-interval", 0, "interval of detecting the APISIX objects changed out-of-band, the minimum interval is 60s. Set to 0 to disable.")
+	cmd.PersistentFlags().StringSliceVar(&cfg.DriftDetection.AutoCorrect, "drift-auto-correct", nil, "the APISIX resource types (route, upstream, ssl, stream_route, plugin_config and global_rule) whose drifted objects are restored to the desired state")
+	cmd.PersistentFlags().StringSliceVar(&cfg.DriftDetection.GarbageCollect, "drift-garbage-collect", nil, "the APISIX resource types whose orphaned objects labelled as managed by the controller are deleted")
 	cmd.PersistentFlags().StringVar(&cfg.PluginMetadataConfigMap, "plugin-metadata-cm", "plugin-metadata-config-map", "ConfigMap name of plugin metadata.")
 
 	return cmd
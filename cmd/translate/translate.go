@@ -0,0 +1,473 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatewayscheme "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/scheme"
+	gatewaylistersv1beta1 "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	configv2beta3 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2beta3"
+	fakeapisix "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/clientset/versioned/fake"
+	apisixscheme "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/clientset/versioned/scheme"
+	apisixinformers "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/informers/externalversions"
+	apisixtranslation "github.com/apache/apisix-ingress-controller/pkg/providers/apisix/translation"
+	gatewaytranslation "github.com/apache/apisix-ingress-controller/pkg/providers/gateway/translation"
+	ingresstranslation "github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	outputJSON = "json"
+	outputYAML = "yaml"
+)
+
+// Result contains the APISIX resources translated from the given manifests.
+type Result struct {
+	Routes         []*apisixv1.Route         `json:"routes,omitempty"`
+	StreamRoutes   []*apisixv1.StreamRoute   `json:"stream_routes,omitempty"`
+	Upstreams      []*apisixv1.Upstream      `json:"upstreams,omitempty"`
+	SSLs           []*apisixv1.Ssl           `json:"ssls,omitempty"`
+	PluginConfigs  []*apisixv1.PluginConfig  `json:"plugin_configs,omitempty"`
+	Services       []*apisixv1.Service       `json:"services,omitempty"`
+	GlobalRules    []*apisixv1.GlobalRule    `json:"global_rules,omitempty"`
+	Consumers      []*apisixv1.Consumer      `json:"consumers,omitempty"`
+	ConsumerGroups []*apisixv1.ConsumerGroup `json:"consumer_groups,omitempty"`
+	Secrets        []*apisixv1.Secret        `json:"secrets,omitempty"`
+	Protos         []*apisixv1.Proto         `json:"protos,omitempty"`
+}
+
+// NewTranslateCommand creates the translate sub command for apisix-ingress-controller.
+func NewTranslateCommand() *cobra.Command {
+	var (
+		filenames  []string
+		output     string
+		apiVersion string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "translate [flags]",
+		Short: "translate Kubernetes manifests to APISIX resources without a cluster",
+		Long: `translate Kubernetes manifests to APISIX resources without a cluster
+
+ApisixRoute, ApisixService, ApisixTls, ApisixConsumer, ApisixConsumerGroup, ApisixSecretProvider, ApisixProto,
+Ingress and HTTPRoute resources are translated to APISIX resources. Service, Endpoints, Secret, ConfigMap,
+ApisixUpstream, ReferenceGrant, IngressClass and ApisixIngressClassConfig resources are used as fixtures, they are
+looked up by the translators just like the ones in a Kubernetes cluster.
+
+    apisix-ingress-controller translate -f ./manifests -f ./fixtures/services.yaml -o yaml`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if len(filenames) == 0 {
+				return errors.New("at least one file or directory should be specified")
+			}
+			if output != outputJSON && output != outputYAML {
+				return fmt.Errorf("unsupported output format %s", output)
+			}
+			objs, err := readObjects(filenames)
+			if err != nil {
+				return err
+			}
+			result, err := Translate(objs, apiVersion)
+			if err != nil {
+				return err
+			}
+			return printResult(cmd.OutOrStdout(), result, output)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&filenames, "filename", "f", nil, "files or directories containing the manifests to translate, json and yaml are supported")
+	cmd.Flags().StringVarP(&output, "output", "o", outputJSON, "output format, json or yaml")
+	cmd.Flags().StringVar(&apiVersion, "api-version", config.DefaultAPIVersion, config.APIVersionDescribe)
+	return cmd
+}
+
+// Translate translates the objects to APISIX resources, Service, Endpoints,
+// Secret, ConfigMap, ApisixUpstream, ReferenceGrant, IngressClass and
+// ApisixIngressClassConfig objects are used as fixtures of the translators.
+func Translate(objs []runtime.Object, apiVersion string) (*Result, error) {
+	t := newTranslator(apiVersion)
+	var resources []runtime.Object
+	for _, obj := range objs {
+		added, err := t.addFixture(obj)
+		if err != nil {
+			return nil, err
+		}
+		if !added {
+			resources = append(resources, obj)
+		}
+	}
+
+	tctx := translation.DefaultEmptyTranslateContext()
+	result := &Result{}
+	for _, obj := range resources {
+		if err := t.translate(obj, tctx, result); err != nil {
+			return nil, err
+		}
+	}
+	result.Routes = tctx.Routes
+	result.StreamRoutes = tctx.StreamRoutes
+	result.Upstreams = tctx.Upstreams
+	result.SSLs = tctx.SSL
+	result.PluginConfigs = tctx.PluginConfigs
+	result.Services = tctx.Services
+	result.GlobalRules = tctx.GlobalRules
+	return result, nil
+}
+
+type translator struct {
+	kubeFactory   informers.SharedInformerFactory
+	apisixFactory apisixinformers.SharedInformerFactory
+	epInformer    cache.SharedIndexInformer
+	// ReferenceGrants are only used as fixtures, so no informer is needed.
+	referenceGrantIndexer cache.Indexer
+	// Ingresses of all versions with default backends are indexed, so that
+	// the owner of the global default backend route can be found.
+	ingressIndexer cache.Indexer
+
+	apisixTranslator  apisixtranslation.ApisixTranslator
+	ingressTranslator ingresstranslation.IngressTranslator
+	gatewayTranslator gatewaytranslation.Translator
+}
+
+func newTranslator(apiVersion string) *translator {
+	kubeFactory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	apisixFactory := apisixinformers.NewSharedInformerFactory(fakeapisix.NewSimpleClientset(), 0)
+	epLister, epInformer := kube.NewEndpointListerAndInformer(kubeFactory, false)
+	svcLister := kubeFactory.Core().V1().Services().Lister()
+	secretLister := kubeFactory.Core().V1().Secrets().Lister()
+	auLister := kube.NewApisixUpstreamLister(
+		apisixFactory.Apisix().V2beta3().ApisixUpstreams().Lister(),
+		apisixFactory.Apisix().V2().ApisixUpstreams().Lister(),
+	)
+
+	ingressLister := kube.NewIngressLister(
+		kubeFactory.Networking().V1().Ingresses().Lister(),
+		kubeFactory.Networking().V1beta1().Ingresses().Lister(),
+		kubeFactory.Extensions().V1beta1().Ingresses().Lister(),
+	)
+
+	commonTranslator := translation.NewTranslator(&translation.TranslatorOptions{
+		APIVersion:           apiVersion,
+		EndpointLister:       epLister,
+		ServiceLister:        svcLister,
+		SecretLister:         secretLister,
+		PodLister:            kubeFactory.Core().V1().Pods().Lister(),
+		ApisixUpstreamLister: auLister,
+	})
+	apisixTranslator := apisixtranslation.NewApisixTranslator(&apisixtranslation.TranslatorOptions{
+		ApisixUpstreamLister: auLister,
+		ServiceLister:        svcLister,
+		SecretLister:         secretLister,
+		ConfigMapLister:      kubeFactory.Core().V1().ConfigMaps().Lister(),
+	}, commonTranslator)
+
+	referenceGrantIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	ingressIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	utilruntime.Must(ingressIndexer.AddIndexers(ingresstranslation.DefaultBackendIndexers()))
+
+	return &translator{
+		kubeFactory:           kubeFactory,
+		apisixFactory:         apisixFactory,
+		epInformer:            epInformer,
+		referenceGrantIndexer: referenceGrantIndexer,
+		ingressIndexer:        ingressIndexer,
+		apisixTranslator:      apisixTranslator,
+		ingressTranslator: ingresstranslation.NewIngressTranslator(&ingresstranslation.TranslatorOptions{
+			IngressClass:           config.IngressClass,
+			IngressClassController: config.IngressClassController,
+			ServiceLister:          svcLister,
+			IngressLister:          ingressLister,
+			IngressIndexer:         ingressIndexer,
+			IngressClassLister:     kubeFactory.Networking().V1().IngressClasses().Lister(),
+			ApisixIngressClassConfigLister: kube.NewApisixIngressClassConfigLister(apiVersion,
+				apisixFactory.Apisix().V2().ApisixIngressClassConfigs().Lister()),
+		}, commonTranslator, apisixTranslator),
+		gatewayTranslator: gatewaytranslation.NewTranslator(&gatewaytranslation.TranslatorOptions{
+			KubeTranslator:       commonTranslator,
+			SecretLister:         secretLister,
+			ReferenceGrantLister: gatewaylistersv1beta1.NewReferenceGrantLister(referenceGrantIndexer),
+		}),
+	}
+}
+
+// addFixture adds the object to the listers used by the translators,
+// it returns false if the object is not a fixture.
+func (t *translator) addFixture(obj runtime.Object) (bool, error) {
+	var indexer cache.Indexer
+	switch obj.(type) {
+	case *corev1.Service:
+		indexer = t.kubeFactory.Core().V1().Services().Informer().GetIndexer()
+	case *corev1.Endpoints:
+		indexer = t.epInformer.GetIndexer()
+	case *corev1.Secret:
+		indexer = t.kubeFactory.Core().V1().Secrets().Informer().GetIndexer()
+	case *corev1.ConfigMap:
+		indexer = t.kubeFactory.Core().V1().ConfigMaps().Informer().GetIndexer()
+	case *configv2beta3.ApisixUpstream:
+		indexer = t.apisixFactory.Apisix().V2beta3().ApisixUpstreams().Informer().GetIndexer()
+	case *configv2.ApisixUpstream:
+		indexer = t.apisixFactory.Apisix().V2().ApisixUpstreams().Informer().GetIndexer()
+	case *gatewayv1beta1.ReferenceGrant:
+		indexer = t.referenceGrantIndexer
+	case *networkingv1.IngressClass:
+		indexer = t.kubeFactory.Networking().V1().IngressClasses().Informer().GetIndexer()
+	case *configv2.ApisixIngressClassConfig:
+		indexer = t.apisixFactory.Apisix().V2().ApisixIngressClassConfigs().Informer().GetIndexer()
+	// Ingresses are not fixtures, they are indexed so that the canary
+	// Ingresses and the owner of the global default backend route can be
+	// found when translating the primary ones.
+	case *networkingv1.Ingress:
+		return false, t.addIngress(t.kubeFactory.Networking().V1().Ingresses().Informer().GetIndexer(), obj)
+	case *networkingv1beta1.Ingress:
+		return false, t.addIngress(t.kubeFactory.Networking().V1beta1().Ingresses().Informer().GetIndexer(), obj)
+	case *extensionsv1beta1.Ingress:
+		return false, t.addIngress(t.kubeFactory.Extensions().V1beta1().Ingresses().Informer().GetIndexer(), obj)
+	default:
+		return false, nil
+	}
+	if err := indexer.Add(obj); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (t *translator) addIngress(indexer cache.Indexer, obj runtime.Object) error {
+	if err := indexer.Add(obj); err != nil {
+		return err
+	}
+	return t.ingressIndexer.Add(obj)
+}
+
+func (t *translator) translate(obj runtime.Object, tctx *translation.TranslateContext, result *Result) error {
+	var (
+		objCtx *translation.TranslateContext
+		err    error
+	)
+	switch o := obj.(type) {
+	case *configv2beta3.ApisixRoute:
+		objCtx, err = t.apisixTranslator.TranslateRouteV2beta3(o)
+	case *configv2.ApisixRoute:
+		objCtx, err = t.apisixTranslator.TranslateRouteV2(o)
+	case *configv2.ApisixService:
+		objCtx, err = t.apisixTranslator.TranslateApisixServiceV2(o)
+	case *configv2beta3.ApisixTls:
+		var ssl *apisixv1.Ssl
+		if ssl, err = t.apisixTranslator.TranslateSSLV2Beta3(o); err == nil {
+			tctx.AddSSL(ssl)
+		}
+	case *configv2.ApisixTls:
+		var ssl *apisixv1.Ssl
+		if ssl, err = t.apisixTranslator.TranslateSSLV2(o); err == nil {
+			tctx.AddSSL(ssl)
+		}
+	case *configv2beta3.ApisixConsumer:
+		var consumer *apisixv1.Consumer
+		if consumer, err = t.apisixTranslator.TranslateApisixConsumerV2beta3(o); err == nil {
+			result.Consumers = append(result.Consumers, consumer)
+		}
+	case *configv2.ApisixConsumer:
+		var consumer *apisixv1.Consumer
+		if consumer, err = t.apisixTranslator.TranslateApisixConsumerV2(o); err == nil {
+			result.Consumers = append(result.Consumers, consumer)
+		}
+	case *configv2.ApisixConsumerGroup:
+		var cg *apisixv1.ConsumerGroup
+		if cg, err = t.apisixTranslator.TranslateApisixConsumerGroupV2(o); err == nil {
+			result.ConsumerGroups = append(result.ConsumerGroups, cg)
+		}
+	case *configv2.ApisixSecretProvider:
+		var secret *apisixv1.Secret
+		if secret, err = t.apisixTranslator.TranslateApisixSecretProviderV2(o); err == nil {
+			result.Secrets = append(result.Secrets, secret)
+		}
+	case *configv2.ApisixProto:
+		var proto *apisixv1.Proto
+		if proto, err = t.apisixTranslator.TranslateApisixProtoV2(o); err == nil {
+			result.Protos = append(result.Protos, proto)
+		}
+	case *gatewayv1beta1.HTTPRoute:
+		objCtx, err = t.gatewayTranslator.TranslateGatewayHTTPRouteV1beta1(o)
+	default:
+		ing, ingErr := kube.NewIngress(obj)
+		if ingErr != nil {
+			return fmt.Errorf("unsupported resource %s", describe(obj))
+		}
+		objCtx, err = t.ingressTranslator.TranslateIngress(ing)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to translate %s: %s", describe(obj), err)
+	}
+	if objCtx != nil {
+		mergeContext(tctx, objCtx)
+	}
+	return nil
+}
+
+func mergeContext(dst, src *translation.TranslateContext) {
+	for _, r := range src.Routes {
+		dst.AddRoute(r)
+	}
+	for _, sr := range src.StreamRoutes {
+		dst.AddStreamRoute(sr)
+	}
+	for _, u := range src.Upstreams {
+		dst.AddUpstream(u)
+	}
+	for _, ssl := range src.SSL {
+		dst.AddSSL(ssl)
+	}
+	for _, pc := range src.PluginConfigs {
+		dst.AddPluginConfig(pc)
+	}
+	for _, gr := range src.GlobalRules {
+		dst.AddGlobalRule(gr)
+	}
+	for _, svc := range src.Services {
+		dst.AddService(svc)
+	}
+}
+
+func describe(obj runtime.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return kind
+	}
+	return kind + " " + key
+}
+
+func newDecoder() runtime.Decoder {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(apisixscheme.AddToScheme(scheme))
+	utilruntime.Must(gatewayscheme.AddToScheme(scheme))
+	return serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
+// readObjects reads the objects from files, directories are walked
+// and files with .yaml, .yml and .json extension are read.
+func readObjects(paths []string) ([]runtime.Object, error) {
+	decoder := newDecoder()
+	var objs []runtime.Object
+	for _, path := range paths {
+		err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			if file != path {
+				switch filepath.Ext(file) {
+				case ".yaml", ".yml", ".json":
+				default:
+					return nil
+				}
+			}
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			fileObjs, err := decodeObjects(decoder, f)
+			if err != nil {
+				return fmt.Errorf("failed to decode %s: %s", file, err)
+			}
+			objs = append(objs, fileObjs...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return objs, nil
+}
+
+// decodeObjects decodes all the objects in a multi-document yaml or json
+// stream, items of List objects are expanded.
+func decodeObjects(decoder runtime.Decoder, r io.Reader) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	stream := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var raw runtime.RawExtension
+		if err := stream.Decode(&raw); err != nil {
+			if err == io.EOF {
+				return objs, nil
+			}
+			return nil, err
+		}
+		if len(raw.Raw) == 0 || string(raw.Raw) == "null" {
+			continue
+		}
+		obj, _, err := decoder.Decode(raw.Raw, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if list, ok := obj.(*corev1.List); ok {
+			for _, item := range list.Items {
+				itemObj, _, err := decoder.Decode(item.Raw, nil, nil)
+				if err != nil {
+					return nil, err
+				}
+				objs = append(objs, itemObj)
+			}
+			continue
+		}
+		objs = append(objs, obj)
+	}
+}
+
+func printResult(w io.Writer, result *Result, output string) error {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	if output == outputYAML {
+		if data, err = yaml.JSONToYAML(data); err != nil {
+			return err
+		}
+	} else {
+		data = append(data, '\n')
+	}
+	_, err = w.Write(data)
+	return err
+}
@@ -0,0 +1,423 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translate
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const _fixtures = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: httpbin
+  namespace: default
+spec:
+  ports:
+  - name: http
+    port: 80
+    targetPort: 80
+---
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: httpbin
+  namespace: default
+subsets:
+- addresses:
+  - ip: 10.0.0.1
+  - ip: 10.0.0.2
+  ports:
+  - name: http
+    port: 80
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: protos
+  namespace: default
+data:
+  hello.proto: |
+    syntax = "proto3";
+    package helloworld;
+    service Greeter {
+      rpc SayHello (HelloRequest) returns (HelloReply) {}
+    }
+    message HelloRequest {
+      string name = 1;
+    }
+    message HelloReply {
+      string message = 1;
+    }
+---
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: apisix
+spec:
+  controller: apisix.apache.org/apisix-ingress
+  parameters:
+    apiGroup: apisix.apache.org
+    kind: ApisixIngressClassConfig
+    name: defaults
+---
+apiVersion: apisix.apache.org/v2
+kind: ApisixIngressClassConfig
+metadata:
+  name: defaults
+spec:
+  plugins:
+  - name: cors
+    enable: true
+    config: {}
+`
+
+const _manifests = `
+apiVersion: apisix.apache.org/v2
+kind: ApisixRoute
+metadata:
+  name: httpbin
+  namespace: default
+spec:
+  http:
+  - name: rule1
+    match:
+      hosts:
+      - httpbin.org
+      paths:
+      - /ip
+    backends:
+    - serviceName: httpbin
+      servicePort: 80
+  - name: rule2
+    match:
+      paths:
+      - /get
+    apisix_service_name: httpbin
+---
+apiVersion: apisix.apache.org/v2
+kind: ApisixService
+metadata:
+  name: httpbin
+  namespace: default
+spec:
+  hosts:
+  - httpbin.org
+  backend:
+    serviceName: httpbin
+    servicePort: 80
+---
+apiVersion: apisix.apache.org/v2
+kind: ApisixConsumer
+metadata:
+  name: jack
+  namespace: default
+spec:
+  authParameter:
+    keyAuth:
+      value:
+        key: jack-key
+  groupRef: gold
+---
+apiVersion: apisix.apache.org/v2
+kind: ApisixSecretProvider
+metadata:
+  name: vault
+  namespace: default
+spec:
+  vault:
+    uri: http://vault.default.svc:8200
+    prefix: kv/apisix
+    token: $env://VAULT_TOKEN
+---
+apiVersion: apisix.apache.org/v2
+kind: ApisixConsumerGroup
+metadata:
+  name: gold
+  namespace: default
+spec:
+  plugins:
+  - name: limit-count
+    enable: true
+    config:
+      count: 1000
+      time_window: 60
+---
+apiVersion: apisix.apache.org/v2
+kind: ApisixProto
+metadata:
+  name: hello
+  namespace: default
+spec:
+  configMapRef:
+    name: protos
+    key: hello.proto
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: httpbin
+  namespace: default
+spec:
+  ingressClassName: apisix
+  rules:
+  - host: httpbin.com
+    http:
+      paths:
+      - path: /headers
+        pathType: Exact
+        backend:
+          service:
+            name: httpbin
+            port:
+              number: 80
+`
+
+func TestTranslateCommand(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "fixtures.yaml"), []byte(_fixtures), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "manifests.yaml"), []byte(_manifests), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a manifest"), 0600))
+
+	var out bytes.Buffer
+	cmd := NewTranslateCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-f", dir})
+	assert.Nil(t, cmd.Execute())
+
+	var result Result
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &result))
+	assert.Len(t, result.Routes, 3)
+	assert.Equal(t, []string{"/ip"}, result.Routes[0].Uris)
+	assert.Equal(t, []string{"/get"}, result.Routes[1].Uris)
+	assert.Equal(t, []string{"/headers"}, result.Routes[2].Uris)
+	// routes and the service refer to the same upstream
+	assert.Len(t, result.Upstreams, 1)
+	assert.Len(t, result.Upstreams[0].Nodes, 2)
+	assert.Equal(t, result.Upstreams[0].ID, result.Routes[0].UpstreamId)
+	assert.Equal(t, result.Upstreams[0].ID, result.Routes[2].UpstreamId)
+	// the Ingress gets the plugins of its IngressClass
+	assert.Contains(t, result.Routes[2].Plugins, "cors")
+	assert.Len(t, result.Services, 1)
+	assert.Equal(t, result.Upstreams[0].ID, result.Services[0].UpstreamId)
+	assert.Equal(t, result.Services[0].ID, result.Routes[1].ServiceId)
+	assert.Empty(t, result.Routes[1].UpstreamId)
+	assert.Len(t, result.Consumers, 1)
+	assert.Equal(t, "default_jack", result.Consumers[0].Username)
+	assert.Len(t, result.ConsumerGroups, 1)
+	assert.Equal(t, result.ConsumerGroups[0].ID, result.Consumers[0].GroupID)
+	assert.Len(t, result.Secrets, 1)
+	assert.Equal(t, "$env://VAULT_TOKEN", result.Secrets[0].Token)
+	assert.Len(t, result.Protos, 1)
+	assert.Contains(t, result.Protos[0].Content, "service Greeter")
+}
+
+func TestTranslateMissingService(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "manifests.yaml")
+	assert.Nil(t, os.WriteFile(file, []byte(_manifests), 0600))
+
+	cmd := NewTranslateCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"-f", file, "-o", "yaml"})
+	err := cmd.Execute()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "failed to translate ApisixRoute default/httpbin")
+}
+
+const _canaryManifests = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: httpbin-canary
+  namespace: default
+spec:
+  ports:
+  - name: http
+    port: 80
+    targetPort: 80
+---
+apiVersion: v1
+kind: Endpoints
+metadata:
+  name: httpbin-canary
+  namespace: default
+subsets:
+- addresses:
+  - ip: 10.0.0.3
+  ports:
+  - name: http
+    port: 80
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: httpbin-canary
+  namespace: default
+  annotations:
+    k8s.apisix.apache.org/canary: "true"
+    k8s.apisix.apache.org/canary-weight: "20"
+    k8s.apisix.apache.org/canary-by-header: X-Canary
+    k8s.apisix.apache.org/canary-by-header-value: "yes"
+    k8s.apisix.apache.org/canary-by-cookie: canary
+spec:
+  ingressClassName: apisix
+  rules:
+  - host: httpbin.com
+    http:
+      paths:
+      - path: /headers
+        pathType: Exact
+        backend:
+          service:
+            name: httpbin-canary
+            port:
+              name: http
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: httpbin
+  namespace: default
+spec:
+  ingressClassName: apisix
+  rules:
+  - host: httpbin.com
+    http:
+      paths:
+      - path: /headers
+        pathType: Exact
+        backend:
+          service:
+            name: httpbin
+            port:
+              number: 80
+`
+
+func TestTranslateCanaryIngress(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "fixtures.yaml"), []byte(_fixtures), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "manifests.yaml"), []byte(_canaryManifests), 0600))
+
+	var out bytes.Buffer
+	cmd := NewTranslateCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-f", dir})
+	assert.Nil(t, cmd.Execute())
+
+	var result Result
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &result))
+	// the canary Ingress is merged onto the route of the primary one
+	assert.Len(t, result.Routes, 1)
+	assert.Len(t, result.Upstreams, 2)
+	primary, canary := result.Upstreams[0].ID, result.Upstreams[1].ID
+	assert.Equal(t, primary, result.Routes[0].UpstreamId)
+
+	data, err := json.Marshal(result.Routes[0].Plugins["traffic-split"])
+	assert.Nil(t, err)
+	var ts apisixv1.TrafficSplitConfig
+	assert.Nil(t, json.Unmarshal(data, &ts))
+	assert.Len(t, ts.Rules, 4)
+	// canary-by-header
+	assert.Equal(t, apisixv1.Vars{{{StrVal: "http_x_canary"}, {StrVal: "=="}, {StrVal: "yes"}}}, ts.Rules[0].Match[0].Vars)
+	assert.Equal(t, canary, ts.Rules[0].WeightedUpstreams[0].UpstreamID)
+	// canary-by-cookie
+	assert.Equal(t, apisixv1.Vars{{{StrVal: "cookie_canary"}, {StrVal: "=="}, {StrVal: "always"}}}, ts.Rules[1].Match[0].Vars)
+	assert.Equal(t, canary, ts.Rules[1].WeightedUpstreams[0].UpstreamID)
+	assert.Equal(t, apisixv1.Vars{{{StrVal: "cookie_canary"}, {StrVal: "=="}, {StrVal: "never"}}}, ts.Rules[2].Match[0].Vars)
+	assert.Empty(t, ts.Rules[2].WeightedUpstreams[0].UpstreamID)
+	// canary-weight
+	assert.Empty(t, ts.Rules[3].Match)
+	assert.Equal(t, []apisixv1.TrafficSplitConfigRuleWeightedUpstream{
+		{UpstreamID: canary, Weight: 20},
+		{Weight: 80},
+	}, ts.Rules[3].WeightedUpstreams)
+}
+
+const _defaultBackendManifests = `
+apiVersion: apisix.apache.org/v2
+kind: ApisixUpstream
+metadata:
+  name: httpbin-external
+  namespace: default
+spec:
+  externalNodes:
+  - type: Domain
+    name: httpbin.org
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: httpbin
+  namespace: default
+spec:
+  ingressClassName: apisix
+  defaultBackend:
+    service:
+      name: httpbin
+      port:
+        number: 80
+  rules:
+  - host: httpbin.com
+    http:
+      paths:
+      - path: /ip
+        pathType: Exact
+        backend:
+          resource:
+            apiGroup: apisix.apache.org
+            kind: ApisixUpstream
+            name: httpbin-external
+`
+
+func TestTranslateIngressDefaultBackend(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "fixtures.yaml"), []byte(_fixtures), 0600))
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "manifests.yaml"), []byte(_defaultBackendManifests), 0600))
+
+	var out bytes.Buffer
+	cmd := NewTranslateCommand()
+	cmd.SetOut(&out)
+	cmd.SetArgs([]string{"-f", dir})
+	assert.Nil(t, cmd.Execute())
+
+	var result Result
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &result))
+	assert.Len(t, result.Upstreams, 2)
+	external, svc := result.Upstreams[0], result.Upstreams[1]
+	assert.Equal(t, apisixv1.ComposeExternalUpstreamName("default", "httpbin-external"), external.Name)
+	assert.Equal(t, apisixv1.UpstreamNodes{{Host: "httpbin.org", Port: 80, Weight: 100}}, external.Nodes)
+	assert.Len(t, svc.Nodes, 2)
+
+	// the rule route, then the global and the per host catch-all routes
+	assert.Len(t, result.Routes, 3)
+	assert.Equal(t, []string{"/ip"}, result.Routes[0].Uris)
+	assert.Equal(t, external.ID, result.Routes[0].UpstreamId)
+	for i, host := range []string{"", "httpbin.com"} {
+		route := result.Routes[i+1]
+		assert.Equal(t, host, route.Host)
+		assert.Equal(t, []string{"/*"}, route.Uris)
+		assert.Equal(t, -1, route.Priority)
+		assert.Equal(t, svc.ID, route.UpstreamId)
+	}
+}
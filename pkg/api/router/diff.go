@@ -0,0 +1,58 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package router
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+)
+
+// Differ computes the pending changes of APISIX resources without applying them.
+type Differ interface {
+	Diff(ctx context.Context, cluster, kind, key string) (*utils.ManifestDiff, error)
+}
+
+type diffErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// MountDiff mounts the route which shows the pending changes, the resource
+// kind, key ("namespace/name") and the APISIX cluster are optional query
+// parameters.
+func MountDiff(r *gin.Engine, differ Differ) {
+	r.GET("/debug/diff", diff(differ))
+}
+
+func diff(differ Differ) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		result, err := differ.Diff(c.Request.Context(), c.Query("cluster"), c.Query("kind"), c.Query("key"))
+		if err != nil {
+			code := http.StatusInternalServerError
+			if errors.Is(err, utils.ErrNotLeader) {
+				code = http.StatusServiceUnavailable
+			} else if errors.Is(err, utils.ErrBadDiffRequest) {
+				code = http.StatusBadRequest
+			}
+			c.AbortWithStatusJSON(code, diffErrorResponse{Error: err.Error()})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusOK, result)
+	}
+}
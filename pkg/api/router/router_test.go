@@ -15,7 +15,9 @@
 package router
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -24,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
 )
 
 func TestHealthz(t *testing.T) {
@@ -75,3 +78,44 @@ func TestWebhooks(t *testing.T) {
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
+
+type fakeDiffer struct {
+	err error
+}
+
+func (d *fakeDiffer) Diff(_ context.Context, cluster, kind, key string) (*utils.ManifestDiff, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	return &utils.ManifestDiff{
+		Cluster: cluster,
+		Objects: []utils.ObjectDiff{
+			{Kind: "route", ID: "1", Name: kind + "/" + key, Action: utils.DiffActionAdd},
+		},
+	}, nil
+}
+
+func TestDiff(t *testing.T) {
+	w := httptest.NewRecorder()
+	_, r := gin.CreateTestContext(w)
+	MountDiff(r, &fakeDiffer{})
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/debug/diff?cluster=c1&kind=ApisixRoute&key=default/httpbin", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp utils.ManifestDiff
+	assert.Nil(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, "c1", resp.Cluster)
+	assert.Equal(t, "ApisixRoute/default/httpbin", resp.Objects[0].Name)
+
+	for err, code := range map[error]int{
+		utils.ErrNotLeader: http.StatusServiceUnavailable,
+		fmt.Errorf("%w: unsupported resource kind Foo", utils.ErrBadDiffRequest): http.StatusBadRequest,
+		fmt.Errorf("unknown"): http.StatusInternalServerError,
+	} {
+		w := httptest.NewRecorder()
+		_, r := gin.CreateTestContext(w)
+		MountDiff(r, &fakeDiffer{err: err})
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/debug/diff", nil))
+		assert.Equal(t, code, w.Code)
+	}
+}
@@ -34,5 +34,6 @@ func MountWebhooks(r *gin.Engine, co *apisix.ClusterOptions) {
 		validationGroup.POST("/apisixupstreams", validation.NewHandlerFunc("ApisixUpstream", validation.ApisixUpstreamValidator))
 		validationGroup.POST("/apisixconsumers", validation.NewHandlerFunc("ApisixConsumer", validation.ApisixConsumerValidator))
 		validationGroup.POST("/apisixtlses", validation.NewHandlerFunc("ApisixTls", validation.ApisixTlsValidator))
+		validationGroup.POST("/ingresses", validation.NewHandlerFunc("Ingress", validation.IngressValidator))
 	}
 }
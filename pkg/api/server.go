@@ -27,10 +27,10 @@ import (
 	"go.uber.org/zap"
 
 	apirouter "github.com/apache/apisix-ingress-controller/pkg/api/router"
-	"github.com/apache/apisix-ingress-controller/pkg/apisix"
 	"github.com/apache/apisix-ingress-controller/pkg/config"
 	"github.com/apache/apisix-ingress-controller/pkg/log"
 	"github.com/apache/apisix-ingress-controller/pkg/metrics"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
 	"github.com/apache/apisix-ingress-controller/pkg/types"
 )
 
@@ -72,22 +72,24 @@ func NewServer(cfg *config.Config) (*Server, error) {
 	}
 
 	cert, err := tls.LoadX509KeyPair(cfg.CertFilePath, cfg.KeyFilePath)
-	if err != nil {
+	if cfg.APISIX.ConfigProvider == config.ConfigProviderYAML {
+		// Schemas are fetched from the Admin API, which is unavailable
+		// in standalone mode.
+		log.Warn("config provider is yaml, will not start admission server")
+	} else if err != nil {
 		log.Warnw("failed to load x509 key pair, will not start admission server",
 			zap.String("Error", err.Error()),
 			zap.String("CertFilePath", cfg.CertFilePath),
 			zap.String("KeyFilePath", cfg.KeyFilePath),
 		)
 	} else {
+		clusterOpts, err := utils.DefaultClusterOptions(&cfg.APISIX, metrics.NewPrometheusCollector())
+		if err != nil {
+			return nil, err
+		}
 		admission := gin.New()
 		admission.Use(gin.Recovery(), gin.Logger())
-		apirouter.MountWebhooks(admission, &apisix.ClusterOptions{
-			AdminAPIVersion:  cfg.APISIX.AdminAPIVersion,
-			Name:             cfg.APISIX.DefaultClusterName,
-			AdminKey:         cfg.APISIX.DefaultClusterAdminKey,
-			BaseURL:          cfg.APISIX.DefaultClusterBaseURL,
-			MetricsCollector: metrics.NewPrometheusCollector(),
-		})
+		apirouter.MountWebhooks(admission, clusterOpts)
 
 		srv.admissionServer = &http.Server{
 			Addr:    cfg.HTTPSListen,
@@ -101,6 +103,12 @@ func NewServer(cfg *config.Config) (*Server, error) {
 	return srv, nil
 }
 
+// MountDiff mounts the route which shows the pending changes of APISIX
+// resources, it should be called before the API Server runs.
+func (srv *Server) MountDiff(differ apirouter.Differ) {
+	apirouter.MountDiff(srv.httpServer, differ)
+}
+
 // Run launches the API Server.
 func (srv *Server) Run(stopCh <-chan struct{}) error {
 	go func() {
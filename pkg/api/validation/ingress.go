@@ -0,0 +1,85 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	kwhmodel "github.com/slok/kubewebhook/v2/pkg/model"
+	kwhvalidating "github.com/slok/kubewebhook/v2/pkg/webhook/validating"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	ingresstranslation "github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation"
+)
+
+// errNotIngress will be used when the validating object is not Ingress.
+var errNotIngress = errors.New("object is not Ingress")
+
+// IngressValidator validates the annotations of Ingress and the plugins
+// converted from them.
+var IngressValidator = kwhvalidating.ValidatorFunc(
+	func(ctx context.Context, review *kwhmodel.AdmissionReview, object metav1.Object) (result *kwhvalidating.ValidatorResult, err error) {
+		log.Debug("arrive Ingress validator webhook")
+
+		switch object.(type) {
+		case *networkingv1.Ingress, *networkingv1beta1.Ingress, *extensionsv1beta1.Ingress:
+		default:
+			return &kwhvalidating.ValidatorResult{Valid: false, Message: errNotIngress.Error()}, errNotIngress
+		}
+
+		valid := true
+		var msgs []string
+		plugins, err := ingresstranslation.ValidateAnnotations(object.GetAnnotations())
+		if err != nil {
+			valid = false
+			msgs = append(msgs, err.Error())
+			log.Warnf("failed to validate the annotations of Ingress: %s", err)
+		}
+		if len(plugins) == 0 {
+			return &kwhvalidating.ValidatorResult{Valid: valid, Message: strings.Join(msgs, "\n")}, nil
+		}
+
+		client, err := GetSchemaClient(&apisix.ClusterOptions{})
+		if err != nil {
+			msg := "failed to get the schema client"
+			log.Errorf("%s: %s", msg, err)
+			return &kwhvalidating.ValidatorResult{Valid: false, Message: msg}, err
+		}
+
+		names := make([]string, 0, len(plugins))
+		for name := range plugins {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if v, err := validatePlugin(client, name, plugins[name]); !v {
+				valid = false
+				msgs = append(msgs, err.Error())
+				log.Warnf("failed to validate plugin %s: %s", name, err)
+			}
+		}
+
+		return &kwhvalidating.ValidatorResult{Valid: valid, Message: strings.Join(msgs, "\n")}, nil
+	},
+)
@@ -0,0 +1,54 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+)
+
+func TestIngressValidatorAnnotations(t *testing.T) {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ing",
+			Namespace: "default",
+			Annotations: map[string]string{
+				annotations.AnnotationsUpstreamReadTimeout: "10",
+				annotations.AnnotationsUpstreamRetries:     "3",
+			},
+		},
+	}
+	result, err := IngressValidator.Validate(context.Background(), nil, ing)
+	assert.Nil(t, err)
+	assert.True(t, result.Valid)
+
+	ing.Annotations[annotations.AnnotationsUpstreamRetries] = "-1"
+	ing.Annotations[annotations.AnnotationsLimitCount] = "abc"
+	result, err = IngressValidator.Validate(context.Background(), nil, ing)
+	assert.Nil(t, err)
+	assert.False(t, result.Valid)
+	assert.Contains(t, result.Message, "invalid upstream retries -1")
+	assert.Contains(t, result.Message, annotations.AnnotationsLimitCount)
+
+	_, err = IngressValidator.Validate(context.Background(), nil, &metav1.ObjectMeta{})
+	assert.Equal(t, errNotIngress, err)
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
 	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
@@ -55,18 +56,29 @@ type Cluster interface {
 	HasSynced(context.Context) error
 	// Consumer returns a Consumer interface that can operate Consumer resources.
 	Consumer() Consumer
+	// ConsumerGroup returns a ConsumerGroup interface that can operate ConsumerGroup resources.
+	ConsumerGroup() ConsumerGroup
+	// Proto returns a Proto interface that can operate Proto resources.
+	Proto() Proto
+	// Secret returns a Secret interface that can operate Secret resources.
+	Secret() Secret
 	// HealthCheck checks apisix cluster health in realtime.
 	HealthCheck(context.Context) error
 	// Plugin returns a Plugin interface that can operate Plugin resources.
 	Plugin() Plugin
 	// PluginConfig returns a PluginConfig interface that can operate PluginConfig resources.
 	PluginConfig() PluginConfig
+	// Service returns a Service interface that can operate Service resources.
+	Service() Service
 	// Schema returns a Schema interface that can fetch schema of APISIX objects.
 	Schema() Schema
 
 	PluginMetadata() PluginMetadata
 	// UpstreamServiceRelation returns a UpstreamServiceRelation interface that can fetch UpstreamServiceRelation of APISIX objects.
 	UpstreamServiceRelation() UpstreamServiceRelation
+	// Cache returns the cache of APISIX objects in the cluster, it's read-only
+	// for callers outside this package.
+	Cache() cache.Cache
 }
 
 // Route is the specific client interface to take over the create, update,
@@ -129,6 +141,36 @@ type Consumer interface {
 	Update(context.Context, *v1.Consumer) (*v1.Consumer, error)
 }
 
+// ConsumerGroup is the specific client interface to take over the create, update,
+// list and delete for APISIX ConsumerGroup resource.
+type ConsumerGroup interface {
+	Get(context.Context, string) (*v1.ConsumerGroup, error)
+	List(context.Context) ([]*v1.ConsumerGroup, error)
+	Create(context.Context, *v1.ConsumerGroup) (*v1.ConsumerGroup, error)
+	Delete(context.Context, *v1.ConsumerGroup) error
+	Update(context.Context, *v1.ConsumerGroup) (*v1.ConsumerGroup, error)
+}
+
+// Secret is the specific client interface to take over the create, update,
+// list and delete for APISIX Secret resource.
+type Secret interface {
+	Get(context.Context, string) (*v1.Secret, error)
+	List(context.Context) ([]*v1.Secret, error)
+	Create(context.Context, *v1.Secret) (*v1.Secret, error)
+	Delete(context.Context, *v1.Secret) error
+	Update(context.Context, *v1.Secret) (*v1.Secret, error)
+}
+
+// Proto is the specific client interface to take over the create, update,
+// list and delete for APISIX Proto resource.
+type Proto interface {
+	Get(context.Context, string) (*v1.Proto, error)
+	List(context.Context) ([]*v1.Proto, error)
+	Create(context.Context, *v1.Proto) (*v1.Proto, error)
+	Delete(context.Context, *v1.Proto) error
+	Update(context.Context, *v1.Proto) (*v1.Proto, error)
+}
+
 // Plugin is the specific client interface to fetch APISIX Plugin resource.
 type Plugin interface {
 	List(context.Context) ([]string, error)
@@ -144,6 +186,16 @@ type Schema interface {
 	GetPluginConfigSchema(ctx context.Context) (*v1.Schema, error)
 }
 
+// Service is the specific client interface to take over the create, update,
+// list and delete for APISIX Service resource.
+type Service interface {
+	Get(context.Context, string) (*v1.Service, error)
+	List(context.Context) ([]*v1.Service, error)
+	Create(context.Context, *v1.Service) (*v1.Service, error)
+	Delete(context.Context, *v1.Service) error
+	Update(context.Context, *v1.Service) (*v1.Service, error)
+}
+
 // PluginConfig is the specific client interface to take over the create, update,
 // list and delete for APISIX PluginConfig resource.
 type PluginConfig interface {
@@ -35,10 +35,18 @@ type Cache interface {
 	InsertGlobalRule(*v1.GlobalRule) error
 	// InsertConsumer adds or updates consumer to cache.
 	InsertConsumer(*v1.Consumer) error
+	// InsertConsumerGroup adds or updates consumer_group to cache.
+	InsertConsumerGroup(*v1.ConsumerGroup) error
+	// InsertProto adds or updates proto to cache.
+	InsertProto(*v1.Proto) error
+	// InsertSecret adds or updates secret to cache.
+	InsertSecret(*v1.Secret) error
 	// InsertSchema adds or updates schema to cache.
 	InsertSchema(*v1.Schema) error
 	// InsertPluginConfig adds or updates plugin_config to cache.
 	InsertPluginConfig(*v1.PluginConfig) error
+	// InsertService adds or updates service to cache.
+	InsertService(*v1.Service) error
 
 	InsertUpstreamServiceRelation(*v1.UpstreamServiceRelation) error
 
@@ -54,10 +62,18 @@ type Cache interface {
 	GetGlobalRule(string) (*v1.GlobalRule, error)
 	// GetConsumer finds the consumer from cache according to the primary index (id).
 	GetConsumer(string) (*v1.Consumer, error)
+	// GetConsumerGroup finds the consumer_group from cache according to the primary index (id).
+	GetConsumerGroup(string) (*v1.ConsumerGroup, error)
+	// GetProto finds the proto from cache according to the primary index (id).
+	GetProto(string) (*v1.Proto, error)
+	// GetSecret finds the secret from cache according to the primary index (id).
+	GetSecret(string) (*v1.Secret, error)
 	// GetSchema finds the scheme from cache according to the primary index (id).
 	GetSchema(string) (*v1.Schema, error)
 	// GetPluginConfig finds the plugin_config from cache according to the primary index (id).
 	GetPluginConfig(string) (*v1.PluginConfig, error)
+	// GetService finds the service from cache according to the primary index (id).
+	GetService(string) (*v1.Service, error)
 
 	GetUpstreamServiceRelation(string) (*v1.UpstreamServiceRelation, error)
 
@@ -73,10 +89,18 @@ type Cache interface {
 	ListGlobalRules() ([]*v1.GlobalRule, error)
 	// ListConsumers lists all consumer objects in cache.
 	ListConsumers() ([]*v1.Consumer, error)
+	// ListConsumerGroups lists all consumer_group objects in cache.
+	ListConsumerGroups() ([]*v1.ConsumerGroup, error)
+	// ListProtos lists all proto objects in cache.
+	ListProtos() ([]*v1.Proto, error)
+	// ListSecrets lists all secret objects in cache.
+	ListSecrets() ([]*v1.Secret, error)
 	// ListSchema lists all schema in cache.
 	ListSchema() ([]*v1.Schema, error)
 	// ListPluginConfigs lists all plugin_config in cache.
 	ListPluginConfigs() ([]*v1.PluginConfig, error)
+	// ListServices lists all service objects in cache.
+	ListServices() ([]*v1.Service, error)
 
 	ListUpstreamServiceRelation() ([]*v1.UpstreamServiceRelation, error)
 
@@ -92,10 +116,18 @@ type Cache interface {
 	DeleteGlobalRule(*v1.GlobalRule) error
 	// DeleteConsumer deletes the specified consumer in cache.
 	DeleteConsumer(*v1.Consumer) error
+	// DeleteConsumerGroup deletes the specified consumer_group in cache.
+	DeleteConsumerGroup(*v1.ConsumerGroup) error
+	// DeleteProto deletes the specified proto in cache.
+	DeleteProto(*v1.Proto) error
+	// DeleteSecret deletes the specified secret in cache.
+	DeleteSecret(*v1.Secret) error
 	// DeleteSchema deletes the specified schema in cache.
 	DeleteSchema(*v1.Schema) error
 	// DeletePluginConfig deletes the specified plugin_config in cache.
 	DeletePluginConfig(*v1.PluginConfig) error
+	// DeleteService deletes the specified service in cache.
+	DeleteService(*v1.Service) error
 
 	DeleteUpstreamServiceRelation(*v1.UpstreamServiceRelation) error
 }
@@ -70,6 +70,18 @@ func (c *dbCache) InsertConsumer(consumer *v1.Consumer) error {
 	return c.insert("consumer", consumer.DeepCopy())
 }
 
+func (c *dbCache) InsertConsumerGroup(cg *v1.ConsumerGroup) error {
+	return c.insert("consumer_group", cg.DeepCopy())
+}
+
+func (c *dbCache) InsertSecret(secret *v1.Secret) error {
+	return c.insert("secret", secret.DeepCopy())
+}
+
+func (c *dbCache) InsertProto(proto *v1.Proto) error {
+	return c.insert("proto", proto.DeepCopy())
+}
+
 func (c *dbCache) InsertSchema(schema *v1.Schema) error {
 	return c.insert("schema", schema.DeepCopy())
 }
@@ -78,6 +90,10 @@ func (c *dbCache) InsertPluginConfig(pc *v1.PluginConfig) error {
 	return c.insert("plugin_config", pc.DeepCopy())
 }
 
+func (c *dbCache) InsertService(svc *v1.Service) error {
+	return c.insert("service", svc.DeepCopy())
+}
+
 func (c *dbCache) InsertUpstreamServiceRelation(us *v1.UpstreamServiceRelation) error {
 	return c.insert("upstream_service", us.DeepCopy())
 }
@@ -140,6 +156,30 @@ func (c *dbCache) GetConsumer(username string) (*v1.Consumer, error) {
 	return obj.(*v1.Consumer).DeepCopy(), nil
 }
 
+func (c *dbCache) GetConsumerGroup(id string) (*v1.ConsumerGroup, error) {
+	obj, err := c.get("consumer_group", id)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ConsumerGroup).DeepCopy(), nil
+}
+
+func (c *dbCache) GetSecret(id string) (*v1.Secret, error) {
+	obj, err := c.get("secret", id)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Secret).DeepCopy(), nil
+}
+
+func (c *dbCache) GetProto(id string) (*v1.Proto, error) {
+	obj, err := c.get("proto", id)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Proto).DeepCopy(), nil
+}
+
 func (c *dbCache) GetSchema(name string) (*v1.Schema, error) {
 	obj, err := c.get("schema", name)
 	if err != nil {
@@ -156,6 +196,14 @@ func (c *dbCache) GetPluginConfig(name string) (*v1.PluginConfig, error) {
 	return obj.(*v1.PluginConfig).DeepCopy(), nil
 }
 
+func (c *dbCache) GetService(id string) (*v1.Service, error) {
+	obj, err := c.get("service", id)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Service).DeepCopy(), nil
+}
+
 func (c *dbCache) GetUpstreamServiceRelation(serviceName string) (*v1.UpstreamServiceRelation, error) {
 	obj, err := c.get("upstream_service", serviceName)
 	if err != nil {
@@ -252,6 +300,42 @@ func (c *dbCache) ListConsumers() ([]*v1.Consumer, error) {
 	return consumers, nil
 }
 
+func (c *dbCache) ListConsumerGroups() ([]*v1.ConsumerGroup, error) {
+	raws, err := c.list("consumer_group")
+	if err != nil {
+		return nil, err
+	}
+	consumerGroups := make([]*v1.ConsumerGroup, 0, len(raws))
+	for _, raw := range raws {
+		consumerGroups = append(consumerGroups, raw.(*v1.ConsumerGroup).DeepCopy())
+	}
+	return consumerGroups, nil
+}
+
+func (c *dbCache) ListSecrets() ([]*v1.Secret, error) {
+	raws, err := c.list("secret")
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]*v1.Secret, 0, len(raws))
+	for _, raw := range raws {
+		secrets = append(secrets, raw.(*v1.Secret).DeepCopy())
+	}
+	return secrets, nil
+}
+
+func (c *dbCache) ListProtos() ([]*v1.Proto, error) {
+	raws, err := c.list("proto")
+	if err != nil {
+		return nil, err
+	}
+	protos := make([]*v1.Proto, 0, len(raws))
+	for _, raw := range raws {
+		protos = append(protos, raw.(*v1.Proto).DeepCopy())
+	}
+	return protos, nil
+}
+
 func (c *dbCache) ListSchema() ([]*v1.Schema, error) {
 	raws, err := c.list("schema")
 	if err != nil {
@@ -276,6 +360,18 @@ func (c *dbCache) ListPluginConfigs() ([]*v1.PluginConfig, error) {
 	return pluginConfigs, nil
 }
 
+func (c *dbCache) ListServices() ([]*v1.Service, error) {
+	raws, err := c.list("service")
+	if err != nil {
+		return nil, err
+	}
+	services := make([]*v1.Service, 0, len(raws))
+	for _, raw := range raws {
+		services = append(services, raw.(*v1.Service).DeepCopy())
+	}
+	return services, nil
+}
+
 func (c *dbCache) ListUpstreamServiceRelation() ([]*v1.UpstreamServiceRelation, error) {
 	raws, err := c.list("upstream_service")
 	if err != nil {
@@ -329,6 +425,18 @@ func (c *dbCache) DeleteConsumer(consumer *v1.Consumer) error {
 	return c.delete("consumer", consumer)
 }
 
+func (c *dbCache) DeleteConsumerGroup(cg *v1.ConsumerGroup) error {
+	return c.delete("consumer_group", cg)
+}
+
+func (c *dbCache) DeleteSecret(secret *v1.Secret) error {
+	return c.delete("secret", secret)
+}
+
+func (c *dbCache) DeleteProto(proto *v1.Proto) error {
+	return c.delete("proto", proto)
+}
+
 func (c *dbCache) DeleteSchema(schema *v1.Schema) error {
 	return c.delete("schema", schema)
 }
@@ -340,6 +448,13 @@ func (c *dbCache) DeletePluginConfig(pc *v1.PluginConfig) error {
 	return c.delete("plugin_config", pc)
 }
 
+func (c *dbCache) DeleteService(svc *v1.Service) error {
+	if err := c.checkServiceReference(svc); err != nil {
+		return err
+	}
+	return c.delete("service", svc)
+}
+
 func (c *dbCache) DeleteUpstreamServiceRelation(us *v1.UpstreamServiceRelation) error {
 	return c.delete("upstream_service", us)
 }
@@ -358,7 +473,7 @@ func (c *dbCache) delete(table string, obj interface{}) error {
 }
 
 func (c *dbCache) checkUpstreamReference(u *v1.Upstream) error {
-	// Upstream is referenced by Route.
+	// Upstream is referenced by Route, StreamRoute and Service.
 	txn := c.db.Txn(false)
 	defer txn.Abort()
 	obj, err := txn.First("route", "upstream_id", u.ID)
@@ -376,6 +491,14 @@ func (c *dbCache) checkUpstreamReference(u *v1.Upstream) error {
 	if obj != nil {
 		return ErrStillInUse
 	}
+
+	obj, err = txn.First("service", "upstream_id", u.ID)
+	if err != nil && err != memdb.ErrNotFound {
+		return err
+	}
+	if obj != nil {
+		return ErrStillInUse
+	}
 	return nil
 }
 
@@ -392,3 +515,17 @@ func (c *dbCache) checkPluginConfigReference(u *v1.PluginConfig) error {
 	}
 	return nil
 }
+
+func (c *dbCache) checkServiceReference(svc *v1.Service) error {
+	// Service is referenced by Route.
+	txn := c.db.Txn(false)
+	defer txn.Abort()
+	obj, err := txn.First("route", "service_id", svc.ID)
+	if err != nil && err != memdb.ErrNotFound {
+		return err
+	}
+	if obj != nil {
+		return ErrStillInUse
+	}
+	return nil
+}
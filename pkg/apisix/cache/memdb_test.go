@@ -364,6 +364,135 @@ func TestMemDBCacheConsumer(t *testing.T) {
 	assert.Error(t, ErrNotFound, c.DeleteConsumer(c4))
 }
 
+func TestMemDBCacheConsumerGroup(t *testing.T) {
+	c, err := NewMemDBCache()
+	assert.Nil(t, err, "NewMemDBCache")
+
+	cg1 := &v1.ConsumerGroup{
+		ID: "1",
+	}
+	assert.Nil(t, c.InsertConsumerGroup(cg1), "inserting consumer group 1")
+
+	cg, err := c.GetConsumerGroup("1")
+	assert.Nil(t, err)
+	assert.Equal(t, cg1, cg)
+
+	cg2 := &v1.ConsumerGroup{
+		ID: "2",
+	}
+	cg3 := &v1.ConsumerGroup{
+		ID: "3",
+	}
+	assert.Nil(t, c.InsertConsumerGroup(cg2), "inserting consumer_group r2")
+	assert.Nil(t, c.InsertConsumerGroup(cg3), "inserting consumer_group r3")
+
+	cg, err = c.GetConsumerGroup("3")
+	assert.Nil(t, err)
+	assert.Equal(t, cg3, cg)
+
+	assert.Nil(t, c.DeleteConsumerGroup(cg), "delete consumer_group r3")
+
+	cgs, err := c.ListConsumerGroups()
+	assert.Nil(t, err, "listing consumer groups")
+
+	if cgs[0].ID > cgs[1].ID {
+		cgs[0], cgs[1] = cgs[1], cgs[0]
+	}
+	assert.Equal(t, cg1, cgs[0])
+	assert.Equal(t, cg2, cgs[1])
+
+	cg4 := &v1.ConsumerGroup{
+		ID: "4",
+	}
+	assert.Error(t, ErrNotFound, c.DeleteConsumerGroup(cg4))
+}
+
+func TestMemDBCacheProto(t *testing.T) {
+	c, err := NewMemDBCache()
+	assert.Nil(t, err, "NewMemDBCache")
+
+	p1 := &v1.Proto{
+		ID: "1",
+	}
+	assert.Nil(t, c.InsertProto(p1), "inserting proto 1")
+
+	p, err := c.GetProto("1")
+	assert.Nil(t, err)
+	assert.Equal(t, p1, p)
+
+	p2 := &v1.Proto{
+		ID: "2",
+	}
+	p3 := &v1.Proto{
+		ID: "3",
+	}
+	assert.Nil(t, c.InsertProto(p2), "inserting proto r2")
+	assert.Nil(t, c.InsertProto(p3), "inserting proto r3")
+
+	p, err = c.GetProto("3")
+	assert.Nil(t, err)
+	assert.Equal(t, p3, p)
+
+	assert.Nil(t, c.DeleteProto(p), "delete proto r3")
+
+	protos, err := c.ListProtos()
+	assert.Nil(t, err, "listing protos")
+
+	if protos[0].ID > protos[1].ID {
+		protos[0], protos[1] = protos[1], protos[0]
+	}
+	assert.Equal(t, p1, protos[0])
+	assert.Equal(t, p2, protos[1])
+
+	p4 := &v1.Proto{
+		ID: "4",
+	}
+	assert.Error(t, ErrNotFound, c.DeleteProto(p4))
+}
+
+func TestMemDBCacheSecret(t *testing.T) {
+	c, err := NewMemDBCache()
+	assert.Nil(t, err, "NewMemDBCache")
+
+	s1 := &v1.Secret{
+		ID: "vault/1",
+	}
+	assert.Nil(t, c.InsertSecret(s1), "inserting secret 1")
+
+	s, err := c.GetSecret("vault/1")
+	assert.Nil(t, err)
+	assert.Equal(t, s1, s)
+
+	s2 := &v1.Secret{
+		ID: "vault/2",
+	}
+	s3 := &v1.Secret{
+		ID: "vault/3",
+	}
+	assert.Nil(t, c.InsertSecret(s2), "inserting secret r2")
+	assert.Nil(t, c.InsertSecret(s3), "inserting secret r3")
+
+	s, err = c.GetSecret("vault/3")
+	assert.Nil(t, err)
+	assert.Equal(t, s3, s)
+
+	assert.Nil(t, c.DeleteSecret(s), "delete secret r3")
+
+	secrets, err := c.ListSecrets()
+	assert.Nil(t, err, "listing secrets")
+
+	if secrets[0].ID > secrets[1].ID {
+		secrets[0], secrets[1] = secrets[1], secrets[0]
+	}
+	assert.Equal(t, s1, secrets[0])
+	assert.Equal(t, s2, secrets[1])
+
+	s4 := &v1.Secret{
+		ID: "vault/4",
+	}
+	assert.Error(t, ErrNotFound, c.DeleteSecret(s4))
+}
+
 func TestMemDBCacheSchema(t *testing.T) {
 	c, err := NewMemDBCache()
 	assert.Nil(t, err, "NewMemDBCache")
@@ -463,6 +592,97 @@ func TestMemDBCachePluginConfig(t *testing.T) {
 	assert.Error(t, ErrNotFound, c.DeletePluginConfig(pc4))
 }
 
+func TestMemDBCacheService(t *testing.T) {
+	c, err := NewMemDBCache()
+	assert.Nil(t, err, "NewMemDBCache")
+
+	svc1 := &v1.Service{
+		Metadata: v1.Metadata{
+			ID:   "1",
+			Name: "name1",
+		},
+		UpstreamId: "1",
+	}
+	assert.Nil(t, c.InsertService(svc1), "inserting service svc1")
+
+	svc11, err := c.GetService("1")
+	assert.Nil(t, err)
+	assert.Equal(t, svc1, svc11)
+
+	svc2 := &v1.Service{
+		Metadata: v1.Metadata{
+			ID:   "2",
+			Name: "name2",
+		},
+	}
+	svc3 := &v1.Service{
+		Metadata: v1.Metadata{
+			ID:   "3",
+			Name: "name3",
+		},
+	}
+	assert.Nil(t, c.InsertService(svc2), "inserting service svc2")
+	assert.Nil(t, c.InsertService(svc3), "inserting service svc3")
+
+	svc22, err := c.GetService("2")
+	assert.Nil(t, err)
+	assert.Equal(t, svc2, svc22)
+
+	assert.Nil(t, c.DeleteService(svc3), "delete service svc3")
+
+	services, err := c.ListServices()
+	assert.Nil(t, err, "listing services")
+
+	if services[0].Name > services[1].Name {
+		services[0], services[1] = services[1], services[0]
+	}
+	assert.Equal(t, services[0], svc1)
+	assert.Equal(t, services[1], svc2)
+
+	svc4 := &v1.Service{
+		Metadata: v1.Metadata{
+			ID:   "4",
+			Name: "name4",
+		},
+	}
+	assert.Error(t, ErrNotFound, c.DeleteService(svc4))
+}
+
+func TestMemDBCacheServiceReference(t *testing.T) {
+	r := &v1.Route{
+		Metadata: v1.Metadata{
+			Name: "route",
+			ID:   "1",
+		},
+		ServiceId: "1",
+	}
+	svc := &v1.Service{
+		Metadata: v1.Metadata{
+			ID:   "1",
+			Name: "service",
+		},
+		UpstreamId: "1",
+	}
+	u := &v1.Upstream{
+		Metadata: v1.Metadata{
+			ID:   "1",
+			Name: "upstream",
+		},
+	}
+
+	db, err := NewMemDBCache()
+	assert.Nil(t, err, "NewMemDBCache")
+	assert.Nil(t, db.InsertRoute(r))
+	assert.Nil(t, db.InsertService(svc))
+	assert.Nil(t, db.InsertUpstream(u))
+
+	assert.Equal(t, ErrStillInUse, db.DeleteService(svc))
+	assert.Equal(t, ErrStillInUse, db.DeleteUpstream(u))
+	assert.Nil(t, db.DeleteRoute(r))
+	assert.Nil(t, db.DeleteService(svc))
+	assert.Nil(t, db.DeleteUpstream(u))
+}
+
 func TestMemDBCacheUpstreamServiceRelation(t *testing.T) {
 	c, err := NewMemDBCache()
 	assert.Nil(t, err, "NewMemDBCache")
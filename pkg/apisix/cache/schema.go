@@ -48,6 +48,12 @@ var (
 						Indexer:      &memdb.StringFieldIndex{Field: "PluginConfigId"},
 						AllowMissing: true,
 					},
+					"service_id": {
+						Name:         "service_id",
+						Unique:       false,
+						Indexer:      &memdb.StringFieldIndex{Field: "ServiceId"},
+						AllowMissing: true,
+					},
 				},
 			},
 			"upstream": {
@@ -112,6 +118,36 @@ var (
 					},
 				},
 			},
+			"consumer_group": {
+				Name: "consumer_group",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+				},
+			},
+			"secret": {
+				Name: "secret",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+				},
+			},
+			"proto": {
+				Name: "proto",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+				},
+			},
 			"schema": {
 				Name: "schema",
 				Indexes: map[string]*memdb.IndexSchema{
@@ -138,6 +174,28 @@ var (
 					},
 				},
 			},
+			"service": {
+				Name: "service",
+				Indexes: map[string]*memdb.IndexSchema{
+					"id": {
+						Name:    "id",
+						Unique:  true,
+						Indexer: &memdb.StringFieldIndex{Field: "ID"},
+					},
+					"name": {
+						Name:         "name",
+						Unique:       true,
+						Indexer:      &memdb.StringFieldIndex{Field: "Name"},
+						AllowMissing: true,
+					},
+					"upstream_id": {
+						Name:         "upstream_id",
+						Unique:       false,
+						Indexer:      &memdb.StringFieldIndex{Field: "UpstreamId"},
+						AllowMissing: true,
+					},
+				},
+			},
 			"upstream_service": {
 				Name: "upstream_service",
 				Indexes: map[string]*memdb.IndexSchema{
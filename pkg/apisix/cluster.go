@@ -18,6 +18,8 @@ package apisix
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -82,6 +84,45 @@ type ClusterOptions struct {
 	// SyncInterval is the interval to sync schema.
 	SyncInterval     types.TimeDuration
 	MetricsCollector metrics.Collector
+	// CACert is the PEM encoded CA bundle to verify the Admin API
+	// certificate, the system roots are used if it's empty.
+	CACert []byte
+	// ClientCert and ClientKey are the PEM encoded certificate and
+	// private key presented to the Admin API for mutual TLS.
+	ClientCert []byte
+	ClientKey  []byte
+	// ServerName is used to verify the hostname of the Admin API
+	// certificate, the host of BaseURL is used if it's empty.
+	ServerName string
+	// StandaloneRenderer, if set, makes the cluster run in standalone mode,
+	// the desired state is kept in memory and rendered into the APISIX
+	// standalone config by it, instead of calling the Admin API.
+	StandaloneRenderer StandaloneRenderer
+}
+
+func (o *ClusterOptions) tlsConfig() (*tls.Config, error) {
+	if len(o.CACert) == 0 && len(o.ClientCert) == 0 && len(o.ClientKey) == 0 && o.ServerName == "" {
+		return nil, nil
+	}
+	cfg := &tls.Config{
+		ServerName: o.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if len(o.CACert) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(o.CACert) {
+			return nil, errors.New("invalid CA certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	if len(o.ClientCert) > 0 || len(o.ClientKey) > 0 {
+		cert, err := tls.X509KeyPair(o.ClientCert, o.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %s", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
 }
 
 type cluster struct {
@@ -101,15 +142,22 @@ type cluster struct {
 	streamRoute             StreamRoute
 	globalRules             GlobalRule
 	consumer                Consumer
+	consumerGroup           ConsumerGroup
+	secret                  Secret
 	plugin                  Plugin
 	schema                  Schema
 	pluginConfig            PluginConfig
+	service                 Service
+	proto                   Proto
 	metricsCollector        metrics.Collector
 	upstreamServiceRelation UpstreamServiceRelation
 	pluginMetadata          PluginMetadata
 }
 
 func newCluster(ctx context.Context, o *ClusterOptions) (Cluster, error) {
+	if o.StandaloneRenderer != nil {
+		return newStandaloneCluster(ctx, o)
+	}
 	if o.BaseURL == "" {
 		return nil, errors.New("empty base url")
 	}
@@ -126,6 +174,16 @@ func newCluster(ctx context.Context, o *ClusterOptions) (Cluster, error) {
 		return nil, err
 	}
 
+	tlsConfig, err := o.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	transport := _defaultTransport
+	if tlsConfig != nil {
+		transport = _defaultTransport.Clone()
+		transport.TLSClientConfig = tlsConfig
+	}
+
 	// if the version is not v3, then fallback to v2
 	adminVersion := o.AdminAPIVersion
 	if adminVersion != "v3" {
@@ -139,7 +197,7 @@ func newCluster(ctx context.Context, o *ClusterOptions) (Cluster, error) {
 		adminKey:     o.AdminKey,
 		cli: &http.Client{
 			Timeout:   o.Timeout,
-			Transport: _defaultTransport,
+			Transport: transport,
 		},
 		cacheState:       _cacheSyncing, // default state
 		cacheSynced:      make(chan struct{}),
@@ -151,9 +209,13 @@ func newCluster(ctx context.Context, o *ClusterOptions) (Cluster, error) {
 	c.streamRoute = newStreamRouteClient(c)
 	c.globalRules = newGlobalRuleClient(c)
 	c.consumer = newConsumerClient(c)
+	c.consumerGroup = newConsumerGroupClient(c)
+	c.secret = newSecretClient(c)
 	c.plugin = newPluginClient(c)
 	c.schema = newSchemaClient(c)
 	c.pluginConfig = newPluginConfigClient(c)
+	c.service = newServiceClient(c)
+	c.proto = newProtoClient(c)
 	c.upstreamServiceRelation = newUpstreamServiceRelation(c)
 	c.pluginMetadata = newPluginMetadataClient(c)
 
@@ -247,11 +309,32 @@ func (c *cluster) syncCacheOnce(ctx context.Context) (bool, error) {
 		log.Errorf("failed to list consumers in APISIX: %s", err)
 		return false, err
 	}
+	// consumer_group is only supported since APISIX 3.0, don't fail the
+	// cache synchronization for the elder versions.
+	consumerGroups, err := c.consumerGroup.List(ctx)
+	if err != nil {
+		log.Warnf("failed to list consumer_groups in APISIX: %s", err)
+	}
+	// secret is only supported since APISIX 3.1.
+	secrets, err := c.secret.List(ctx)
+	if err != nil {
+		log.Warnf("failed to list secrets in APISIX: %s", err)
+	}
 	pluginConfigs, err := c.pluginConfig.List(ctx)
 	if err != nil {
 		log.Errorf("failed to list plugin_configs in APISIX: %s", err)
 		return false, err
 	}
+	services, err := c.service.List(ctx)
+	if err != nil {
+		log.Errorf("failed to list services in APISIX: %s", err)
+		return false, err
+	}
+	protos, err := c.proto.List(ctx)
+	if err != nil {
+		log.Errorf("failed to list protos in APISIX: %s", err)
+		return false, err
+	}
 
 	for _, r := range routes {
 		if err := c.cache.InsertRoute(r); err != nil {
@@ -312,6 +395,26 @@ func (c *cluster) syncCacheOnce(ctx context.Context) (bool, error) {
 			)
 		}
 	}
+	for _, cg := range consumerGroups {
+		if err := c.cache.InsertConsumerGroup(cg); err != nil {
+			log.Errorw("failed to insert consumer_group to cache",
+				zap.String("consumer_group", cg.ID),
+				zap.String("cluster", c.name),
+				zap.String("error", err.Error()),
+			)
+			return false, err
+		}
+	}
+	for _, secret := range secrets {
+		if err := c.cache.InsertSecret(secret); err != nil {
+			log.Errorw("failed to insert secret to cache",
+				zap.String("secret", secret.ID),
+				zap.String("cluster", c.name),
+				zap.String("error", err.Error()),
+			)
+			return false, err
+		}
+	}
 	for _, u := range pluginConfigs {
 		if err := c.cache.InsertPluginConfig(u); err != nil {
 			log.Errorw("failed to insert pluginConfig to cache",
@@ -322,6 +425,26 @@ func (c *cluster) syncCacheOnce(ctx context.Context) (bool, error) {
 			return false, err
 		}
 	}
+	for _, svc := range services {
+		if err := c.cache.InsertService(svc); err != nil {
+			log.Errorw("failed to insert service to cache",
+				zap.String("service", svc.ID),
+				zap.String("cluster", c.name),
+				zap.String("error", err.Error()),
+			)
+			return false, err
+		}
+	}
+	for _, proto := range protos {
+		if err := c.cache.InsertProto(proto); err != nil {
+			log.Errorw("failed to insert proto to cache",
+				zap.String("proto", proto.ID),
+				zap.String("cluster", c.name),
+				zap.String("error", err.Error()),
+			)
+			return false, err
+		}
+	}
 	return true, nil
 }
 
@@ -457,6 +580,16 @@ func (c *cluster) Consumer() Consumer {
 	return c.consumer
 }
 
+// ConsumerGroup implements Cluster.ConsumerGroup method.
+func (c *cluster) ConsumerGroup() ConsumerGroup {
+	return c.consumerGroup
+}
+
+// Secret implements Cluster.Secret method.
+func (c *cluster) Secret() Secret {
+	return c.secret
+}
+
 // Plugin implements Cluster.Plugin method.
 func (c *cluster) Plugin() Plugin {
 	return c.plugin
@@ -467,6 +600,16 @@ func (c *cluster) PluginConfig() PluginConfig {
 	return c.pluginConfig
 }
 
+// Service implements Cluster.Service method.
+func (c *cluster) Service() Service {
+	return c.service
+}
+
+// Proto implements Cluster.Proto method.
+func (c *cluster) Proto() Proto {
+	return c.proto
+}
+
 // Schema implements Cluster.Schema method.
 func (c *cluster) Schema() Schema {
 	return c.schema
@@ -480,6 +623,11 @@ func (c *cluster) UpstreamServiceRelation() UpstreamServiceRelation {
 	return c.upstreamServiceRelation
 }
 
+// Cache implements Cluster.Cache method.
+func (c *cluster) Cache() cache.Cache {
+	return c.cache
+}
+
 // HealthCheck implements Cluster.HealthCheck method.
 func (c *cluster) HealthCheck(ctx context.Context) (err error) {
 	if c.cacheSyncErr != nil {
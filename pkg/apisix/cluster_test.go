@@ -17,6 +17,9 @@ package apisix
 
 import (
 	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -102,3 +105,54 @@ func TestNonExistentCluster(t *testing.T) {
 	err = apisix.Cluster("non-existent-cluster").PluginConfig().Delete(context.Background(), &v1.PluginConfig{})
 	assert.Equal(t, ErrClusterNotExist, err)
 }
+
+func TestClusterTLSOptions(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	caCert := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: srv.Certificate().Raw,
+	})
+	c, err := newCluster(ctx, &ClusterOptions{
+		Name:             "tls",
+		BaseURL:          srv.URL + "/apisix/admin",
+		CACert:           caCert,
+		ServerName:       "example.com",
+		MetricsCollector: metrics.NewPrometheusCollector(),
+	})
+	assert.Nil(t, err)
+	resp, err := c.(*cluster).cli.Get(srv.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// the certificate isn't signed by a trusted CA
+	c, err = newCluster(ctx, &ClusterOptions{
+		Name:             "no-tls",
+		BaseURL:          srv.URL + "/apisix/admin",
+		MetricsCollector: metrics.NewPrometheusCollector(),
+	})
+	assert.Nil(t, err)
+	_, err = c.(*cluster).cli.Get(srv.URL)
+	assert.NotNil(t, err)
+
+	_, err = newCluster(ctx, &ClusterOptions{
+		BaseURL:          srv.URL + "/apisix/admin",
+		CACert:           []byte("bad ca"),
+		MetricsCollector: metrics.NewPrometheusCollector(),
+	})
+	assert.Equal(t, "invalid CA certificate", err.Error())
+
+	_, err = newCluster(ctx, &ClusterOptions{
+		BaseURL:          srv.URL + "/apisix/admin",
+		ClientCert:       caCert,
+		MetricsCollector: metrics.NewPrometheusCollector(),
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,230 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type consumerGroupClient struct {
+	url     string
+	cluster *cluster
+}
+
+func newConsumerGroupClient(c *cluster) ConsumerGroup {
+	return &consumerGroupClient{
+		url:     c.baseURL + "/consumer_groups",
+		cluster: c,
+	}
+}
+
+// Get returns the ConsumerGroup.
+// FIXME, currently if caller pass a non-existent resource, the Get always passes
+// through cache.
+func (r *consumerGroupClient) Get(ctx context.Context, name string) (*v1.ConsumerGroup, error) {
+	log.Debugw("try to look up consumer_group",
+		zap.String("name", name),
+		zap.String("url", r.url),
+		zap.String("cluster", r.cluster.name),
+	)
+	rid := id.GenID(name)
+	consumerGroup, err := r.cluster.cache.GetConsumerGroup(rid)
+	if err == nil {
+		return consumerGroup, nil
+	}
+	if err != cache.ErrNotFound {
+		log.Errorw("failed to find consumer_group in cache, will try to lookup from APISIX",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+	} else {
+		log.Debugw("failed to find consumer_group in cache, will try to lookup from APISIX",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+	}
+
+	// TODO Add mutex here to avoid dog-pile effect.
+	url := r.url + "/" + rid
+	resp, err := r.cluster.getResource(ctx, url, "consumerGroup")
+	r.cluster.metricsCollector.IncrAPISIXRequest("consumerGroup")
+	if err != nil {
+		if err == cache.ErrNotFound {
+			log.Warnw("consumer_group not found",
+				zap.String("name", name),
+				zap.String("url", url),
+				zap.String("cluster", r.cluster.name),
+			)
+		} else {
+			log.Errorw("failed to get consumer_group from APISIX",
+				zap.String("name", name),
+				zap.String("url", url),
+				zap.String("cluster", r.cluster.name),
+				zap.Error(err),
+			)
+		}
+		return nil, err
+	}
+
+	consumerGroup, err = resp.consumerGroup()
+	if err != nil {
+		log.Errorw("failed to convert consumer_group item",
+			zap.String("url", r.url),
+			zap.String("consumer_group_key", resp.Key),
+			zap.String("consumer_group_value", string(resp.Value)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if err := r.cluster.cache.InsertConsumerGroup(consumerGroup); err != nil {
+		log.Errorf("failed to reflect consumer_group create to cache: %s", err)
+		return nil, err
+	}
+	return consumerGroup, nil
+}
+
+// List is only used in cache warming up. So here just pass through
+// to APISIX.
+func (r *consumerGroupClient) List(ctx context.Context) ([]*v1.ConsumerGroup, error) {
+	log.Debugw("try to list consumer_groups in APISIX",
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	consumerGroupItems, err := r.cluster.listResource(ctx, r.url, "consumerGroup")
+	r.cluster.metricsCollector.IncrAPISIXRequest("consumerGroup")
+	if err != nil {
+		log.Errorf("failed to list consumer_groups: %s", err)
+		return nil, err
+	}
+
+	var items []*v1.ConsumerGroup
+	for i, item := range consumerGroupItems {
+		consumerGroup, err := item.consumerGroup()
+		if err != nil {
+			log.Errorw("failed to convert consumer_group item",
+				zap.String("url", r.url),
+				zap.String("consumer_group_key", item.Key),
+				zap.String("consumer_group_value", string(item.Value)),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+
+		items = append(items, consumerGroup)
+		log.Debugf("list consumer_group #%d, body: %s", i, string(item.Value))
+	}
+
+	return items, nil
+}
+
+func (r *consumerGroupClient) Create(ctx context.Context, obj *v1.ConsumerGroup) (*v1.ConsumerGroup, error) {
+	log.Debugw("try to create consumer_group",
+		zap.String("id", obj.ID),
+		zap.Any("plugins", obj.Plugins),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	url := r.url + "/" + obj.ID
+	log.Debugw("creating consumer_group", zap.ByteString("body", data), zap.String("url", url))
+	resp, err := r.cluster.createResource(ctx, url, "consumerGroup", data)
+	r.cluster.metricsCollector.IncrAPISIXRequest("consumerGroup")
+	if err != nil {
+		log.Errorf("failed to create consumer_group: %s", err)
+		return nil, err
+	}
+
+	consumerGroup, err := resp.consumerGroup()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cluster.cache.InsertConsumerGroup(consumerGroup); err != nil {
+		log.Errorf("failed to reflect consumer_group create to cache: %s", err)
+		return nil, err
+	}
+	return consumerGroup, nil
+}
+
+func (r *consumerGroupClient) Delete(ctx context.Context, obj *v1.ConsumerGroup) error {
+	log.Debugw("try to delete consumer_group",
+		zap.String("id", obj.ID),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return err
+	}
+	url := r.url + "/" + obj.ID
+	if err := r.cluster.deleteResource(ctx, url, "consumerGroup"); err != nil {
+		r.cluster.metricsCollector.IncrAPISIXRequest("consumerGroup")
+		return err
+	}
+	r.cluster.metricsCollector.IncrAPISIXRequest("consumerGroup")
+	if err := r.cluster.cache.DeleteConsumerGroup(obj); err != nil {
+		log.Errorf("failed to reflect consumer_group delete to cache: %s", err)
+		if err != cache.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *consumerGroupClient) Update(ctx context.Context, obj *v1.ConsumerGroup) (*v1.ConsumerGroup, error) {
+	log.Debugw("try to update consumer_group",
+		zap.String("id", obj.ID),
+		zap.Any("plugins", obj.Plugins),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	url := r.url + "/" + obj.ID
+	resp, err := r.cluster.updateResource(ctx, url, "consumerGroup", body)
+	r.cluster.metricsCollector.IncrAPISIXRequest("consumerGroup")
+	if err != nil {
+		return nil, err
+	}
+	consumerGroup, err := resp.consumerGroup()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cluster.cache.InsertConsumerGroup(consumerGroup); err != nil {
+		log.Errorf("failed to reflect consumer_group update to cache: %s", err)
+		return nil, err
+	}
+	return consumerGroup, nil
+}
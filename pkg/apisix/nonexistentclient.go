@@ -35,9 +35,13 @@ func newNonExistentCluster() *nonExistentCluster {
 			streamRoute:             &dummyStreamRoute{},
 			globalRule:              &dummyGlobalRule{},
 			consumer:                &dummyConsumer{},
+			consumerGroup:           &dummyConsumerGroup{},
+			secret:                  &dummySecret{},
 			plugin:                  &dummyPlugin{},
 			schema:                  &dummySchema{},
 			pluginConfig:            &dummyPluginConfig{},
+			service:                 &dummyService{},
+			proto:                   &dummyProto{},
 			upstreamServiceRelation: &dummyUpstreamServiceRelation{},
 			pluginMetadata:          &dummyPluginMetadata{},
 		},
@@ -51,9 +55,13 @@ type embedDummyResourceImplementer struct {
 	streamRoute             StreamRoute
 	globalRule              GlobalRule
 	consumer                Consumer
+	consumerGroup           ConsumerGroup
+	secret                  Secret
 	plugin                  Plugin
 	schema                  Schema
 	pluginConfig            PluginConfig
+	service                 Service
+	proto                   Proto
 	upstreamServiceRelation UpstreamServiceRelation
 	pluginMetadata          PluginMetadata
 }
@@ -190,6 +198,50 @@ func (f *dummyConsumer) Update(_ context.Context, _ *v1.Consumer) (*v1.Consumer,
 	return nil, ErrClusterNotExist
 }
 
+type dummyConsumerGroup struct{}
+
+func (f *dummyConsumerGroup) Get(_ context.Context, _ string) (*v1.ConsumerGroup, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyConsumerGroup) List(_ context.Context) ([]*v1.ConsumerGroup, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyConsumerGroup) Create(_ context.Context, _ *v1.ConsumerGroup) (*v1.ConsumerGroup, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyConsumerGroup) Delete(_ context.Context, _ *v1.ConsumerGroup) error {
+	return ErrClusterNotExist
+}
+
+func (f *dummyConsumerGroup) Update(_ context.Context, _ *v1.ConsumerGroup) (*v1.ConsumerGroup, error) {
+	return nil, ErrClusterNotExist
+}
+
+type dummySecret struct{}
+
+func (f *dummySecret) Get(_ context.Context, _ string) (*v1.Secret, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummySecret) List(_ context.Context) ([]*v1.Secret, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummySecret) Create(_ context.Context, _ *v1.Secret) (*v1.Secret, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummySecret) Delete(_ context.Context, _ *v1.Secret) error {
+	return ErrClusterNotExist
+}
+
+func (f *dummySecret) Update(_ context.Context, _ *v1.Secret) (*v1.Secret, error) {
+	return nil, ErrClusterNotExist
+}
+
 type dummyPlugin struct{}
 
 func (f *dummyPlugin) List(_ context.Context) ([]string, error) {
@@ -244,6 +296,50 @@ func (f *dummyPluginConfig) Update(_ context.Context, _ *v1.PluginConfig) (*v1.P
 	return nil, ErrClusterNotExist
 }
 
+type dummyService struct{}
+
+func (f *dummyService) Get(_ context.Context, _ string) (*v1.Service, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyService) List(_ context.Context) ([]*v1.Service, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyService) Create(_ context.Context, _ *v1.Service) (*v1.Service, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyService) Delete(_ context.Context, _ *v1.Service) error {
+	return ErrClusterNotExist
+}
+
+func (f *dummyService) Update(_ context.Context, _ *v1.Service) (*v1.Service, error) {
+	return nil, ErrClusterNotExist
+}
+
+type dummyProto struct{}
+
+func (f *dummyProto) Get(_ context.Context, _ string) (*v1.Proto, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyProto) List(_ context.Context) ([]*v1.Proto, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyProto) Create(_ context.Context, _ *v1.Proto) (*v1.Proto, error) {
+	return nil, ErrClusterNotExist
+}
+
+func (f *dummyProto) Delete(_ context.Context, _ *v1.Proto) error {
+	return ErrClusterNotExist
+}
+
+func (f *dummyProto) Update(_ context.Context, _ *v1.Proto) (*v1.Proto, error) {
+	return nil, ErrClusterNotExist
+}
+
 type dummyUpstreamServiceRelation struct {
 }
 
@@ -301,6 +397,14 @@ func (nc *nonExistentCluster) Consumer() Consumer {
 	return nc.consumer
 }
 
+func (nc *nonExistentCluster) ConsumerGroup() ConsumerGroup {
+	return nc.consumerGroup
+}
+
+func (nc *nonExistentCluster) Secret() Secret {
+	return nc.secret
+}
+
 func (nc *nonExistentCluster) Plugin() Plugin {
 	return nc.plugin
 }
@@ -309,6 +413,14 @@ func (nc *nonExistentCluster) PluginConfig() PluginConfig {
 	return nc.pluginConfig
 }
 
+func (nc *nonExistentCluster) Service() Service {
+	return nc.service
+}
+
+func (nc *nonExistentCluster) Proto() Proto {
+	return nc.proto
+}
+
 func (nc *nonExistentCluster) Schema() Schema {
 	return nc.schema
 }
@@ -320,6 +432,10 @@ func (nc *nonExistentCluster) UpstreamServiceRelation() UpstreamServiceRelation
 	return nc.upstreamServiceRelation
 }
 
+func (nc *nonExistentCluster) Cache() cache.Cache {
+	return &dummyCache{}
+}
+
 func (nc *nonExistentCluster) HasSynced(_ context.Context) error {
 	return nil
 }
@@ -342,8 +458,12 @@ func (c *dummyCache) InsertUpstream(_ *v1.Upstream) error
 func (c *dummyCache) InsertStreamRoute(_ *v1.StreamRoute) error                         { return nil }
 func (c *dummyCache) InsertGlobalRule(_ *v1.GlobalRule) error                           { return nil }
 func (c *dummyCache) InsertConsumer(_ *v1.Consumer) error                               { return nil }
+func (c *dummyCache) InsertConsumerGroup(_ *v1.ConsumerGroup) error                     { return nil }
+func (c *dummyCache) InsertSecret(_ *v1.Secret) error                                   { return nil }
 func (c *dummyCache) InsertSchema(_ *v1.Schema) error                                   { return nil }
 func (c *dummyCache) InsertPluginConfig(_ *v1.PluginConfig) error                       { return nil }
+func (c *dummyCache) InsertService(_ *v1.Service) error                                 { return nil }
+func (c *dummyCache) InsertProto(_ *v1.Proto) error                                     { return nil }
 func (c *dummyCache) InsertUpstreamServiceRelation(_ *v1.UpstreamServiceRelation) error { return nil }
 func (c *dummyCache) GetRoute(_ string) (*v1.Route, error)                              { return nil, cache.ErrNotFound }
 func (c *dummyCache) GetSSL(_ string) (*v1.Ssl, error)                                  { return nil, cache.ErrNotFound }
@@ -351,21 +471,31 @@ func (c *dummyCache) GetUpstream(_ string) (*v1.Upstream, error)
 func (c *dummyCache) GetStreamRoute(_ string) (*v1.StreamRoute, error)                  { return nil, cache.ErrNotFound }
 func (c *dummyCache) GetGlobalRule(_ string) (*v1.GlobalRule, error)                    { return nil, cache.ErrNotFound }
 func (c *dummyCache) GetConsumer(_ string) (*v1.Consumer, error)                        { return nil, cache.ErrNotFound }
-func (c *dummyCache) GetSchema(_ string) (*v1.Schema, error)                            { return nil, cache.ErrNotFound }
+func (c *dummyCache) GetConsumerGroup(_ string) (*v1.ConsumerGroup, error) {
+	return nil, cache.ErrNotFound
+}
+func (c *dummyCache) GetSecret(_ string) (*v1.Secret, error) { return nil, cache.ErrNotFound }
+func (c *dummyCache) GetSchema(_ string) (*v1.Schema, error) { return nil, cache.ErrNotFound }
 func (c *dummyCache) GetPluginConfig(_ string) (*v1.PluginConfig, error) {
 	return nil, cache.ErrNotFound
 }
+func (c *dummyCache) GetService(_ string) (*v1.Service, error) { return nil, cache.ErrNotFound }
+func (c *dummyCache) GetProto(_ string) (*v1.Proto, error)     { return nil, cache.ErrNotFound }
 func (c *dummyCache) GetUpstreamServiceRelation(_ string) (*v1.UpstreamServiceRelation, error) {
 	return nil, cache.ErrNotFound
 }
-func (c *dummyCache) ListRoutes() ([]*v1.Route, error)               { return nil, nil }
-func (c *dummyCache) ListSSL() ([]*v1.Ssl, error)                    { return nil, nil }
-func (c *dummyCache) ListUpstreams() ([]*v1.Upstream, error)         { return nil, nil }
-func (c *dummyCache) ListStreamRoutes() ([]*v1.StreamRoute, error)   { return nil, nil }
-func (c *dummyCache) ListGlobalRules() ([]*v1.GlobalRule, error)     { return nil, nil }
-func (c *dummyCache) ListConsumers() ([]*v1.Consumer, error)         { return nil, nil }
-func (c *dummyCache) ListSchema() ([]*v1.Schema, error)              { return nil, nil }
-func (c *dummyCache) ListPluginConfigs() ([]*v1.PluginConfig, error) { return nil, nil }
+func (c *dummyCache) ListRoutes() ([]*v1.Route, error)                 { return nil, nil }
+func (c *dummyCache) ListSSL() ([]*v1.Ssl, error)                      { return nil, nil }
+func (c *dummyCache) ListUpstreams() ([]*v1.Upstream, error)           { return nil, nil }
+func (c *dummyCache) ListStreamRoutes() ([]*v1.StreamRoute, error)     { return nil, nil }
+func (c *dummyCache) ListGlobalRules() ([]*v1.GlobalRule, error)       { return nil, nil }
+func (c *dummyCache) ListConsumers() ([]*v1.Consumer, error)           { return nil, nil }
+func (c *dummyCache) ListConsumerGroups() ([]*v1.ConsumerGroup, error) { return nil, nil }
+func (c *dummyCache) ListSecrets() ([]*v1.Secret, error)               { return nil, nil }
+func (c *dummyCache) ListSchema() ([]*v1.Schema, error)                { return nil, nil }
+func (c *dummyCache) ListPluginConfigs() ([]*v1.PluginConfig, error)   { return nil, nil }
+func (c *dummyCache) ListServices() ([]*v1.Service, error)             { return nil, nil }
+func (c *dummyCache) ListProtos() ([]*v1.Proto, error)                 { return nil, nil }
 func (c *dummyCache) ListUpstreamServiceRelation() ([]*v1.UpstreamServiceRelation, error) {
 	return nil, nil
 }
@@ -375,6 +505,10 @@ func (c *dummyCache) DeleteUpstream(_ *v1.Upstream) error
 func (c *dummyCache) DeleteStreamRoute(_ *v1.StreamRoute) error                         { return nil }
 func (c *dummyCache) DeleteGlobalRule(_ *v1.GlobalRule) error                           { return nil }
 func (c *dummyCache) DeleteConsumer(_ *v1.Consumer) error                               { return nil }
+func (c *dummyCache) DeleteConsumerGroup(_ *v1.ConsumerGroup) error                     { return nil }
+func (c *dummyCache) DeleteSecret(_ *v1.Secret) error                                   { return nil }
 func (c *dummyCache) DeleteSchema(_ *v1.Schema) error                                   { return nil }
 func (c *dummyCache) DeletePluginConfig(_ *v1.PluginConfig) error                       { return nil }
+func (c *dummyCache) DeleteService(_ *v1.Service) error                                 { return nil }
+func (c *dummyCache) DeleteProto(_ *v1.Proto) error                                     { return nil }
 func (c *dummyCache) DeleteUpstreamServiceRelation(_ *v1.UpstreamServiceRelation) error { return nil }
@@ -0,0 +1,230 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type protoClient struct {
+	url     string
+	cluster *cluster
+}
+
+func newProtoClient(c *cluster) Proto {
+	return &protoClient{
+		url:     c.baseURL + "/protos",
+		cluster: c,
+	}
+}
+
+// Get returns the Proto.
+// FIXME, currently if caller pass a non-existent resource, the Get always passes
+// through cache.
+func (r *protoClient) Get(ctx context.Context, name string) (*v1.Proto, error) {
+	log.Debugw("try to look up proto",
+		zap.String("name", name),
+		zap.String("url", r.url),
+		zap.String("cluster", r.cluster.name),
+	)
+	rid := id.GenID(name)
+	proto, err := r.cluster.cache.GetProto(rid)
+	if err == nil {
+		return proto, nil
+	}
+	if err != cache.ErrNotFound {
+		log.Errorw("failed to find proto in cache, will try to lookup from APISIX",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+	} else {
+		log.Debugw("failed to find proto in cache, will try to lookup from APISIX",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+	}
+
+	// TODO Add mutex here to avoid dog-pile effect.
+	url := r.url + "/" + rid
+	resp, err := r.cluster.getResource(ctx, url, "proto")
+	r.cluster.metricsCollector.IncrAPISIXRequest("proto")
+	if err != nil {
+		if err == cache.ErrNotFound {
+			log.Warnw("proto not found",
+				zap.String("name", name),
+				zap.String("url", url),
+				zap.String("cluster", r.cluster.name),
+			)
+		} else {
+			log.Errorw("failed to get proto from APISIX",
+				zap.String("name", name),
+				zap.String("url", url),
+				zap.String("cluster", r.cluster.name),
+				zap.Error(err),
+			)
+		}
+		return nil, err
+	}
+
+	proto, err = resp.proto()
+	if err != nil {
+		log.Errorw("failed to convert proto item",
+			zap.String("url", r.url),
+			zap.String("proto_key", resp.Key),
+			zap.String("proto_value", string(resp.Value)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if err := r.cluster.cache.InsertProto(proto); err != nil {
+		log.Errorf("failed to reflect proto create to cache: %s", err)
+		return nil, err
+	}
+	return proto, nil
+}
+
+// List is only used in cache warming up. So here just pass through
+// to APISIX.
+func (r *protoClient) List(ctx context.Context) ([]*v1.Proto, error) {
+	log.Debugw("try to list protos in APISIX",
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	protoItems, err := r.cluster.listResource(ctx, r.url, "proto")
+	r.cluster.metricsCollector.IncrAPISIXRequest("proto")
+	if err != nil {
+		log.Errorf("failed to list protos: %s", err)
+		return nil, err
+	}
+
+	var items []*v1.Proto
+	for i, item := range protoItems {
+		proto, err := item.proto()
+		if err != nil {
+			log.Errorw("failed to convert proto item",
+				zap.String("url", r.url),
+				zap.String("proto_key", item.Key),
+				zap.String("proto_value", string(item.Value)),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+
+		items = append(items, proto)
+		log.Debugf("list proto #%d, body: %s", i, string(item.Value))
+	}
+
+	return items, nil
+}
+
+func (r *protoClient) Create(ctx context.Context, obj *v1.Proto) (*v1.Proto, error) {
+	log.Debugw("try to create proto",
+		zap.String("id", obj.ID),
+		zap.Int("content_length", len(obj.Content)),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	url := r.url + "/" + obj.ID
+	log.Debugw("creating proto", zap.ByteString("body", data), zap.String("url", url))
+	resp, err := r.cluster.createResource(ctx, url, "proto", data)
+	r.cluster.metricsCollector.IncrAPISIXRequest("proto")
+	if err != nil {
+		log.Errorf("failed to create proto: %s", err)
+		return nil, err
+	}
+
+	proto, err := resp.proto()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cluster.cache.InsertProto(proto); err != nil {
+		log.Errorf("failed to reflect proto create to cache: %s", err)
+		return nil, err
+	}
+	return proto, nil
+}
+
+func (r *protoClient) Delete(ctx context.Context, obj *v1.Proto) error {
+	log.Debugw("try to delete proto",
+		zap.String("id", obj.ID),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return err
+	}
+	url := r.url + "/" + obj.ID
+	if err := r.cluster.deleteResource(ctx, url, "proto"); err != nil {
+		r.cluster.metricsCollector.IncrAPISIXRequest("proto")
+		return err
+	}
+	r.cluster.metricsCollector.IncrAPISIXRequest("proto")
+	if err := r.cluster.cache.DeleteProto(obj); err != nil {
+		log.Errorf("failed to reflect proto delete to cache: %s", err)
+		if err != cache.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *protoClient) Update(ctx context.Context, obj *v1.Proto) (*v1.Proto, error) {
+	log.Debugw("try to update proto",
+		zap.String("id", obj.ID),
+		zap.Int("content_length", len(obj.Content)),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	url := r.url + "/" + obj.ID
+	resp, err := r.cluster.updateResource(ctx, url, "proto", body)
+	r.cluster.metricsCollector.IncrAPISIXRequest("proto")
+	if err != nil {
+		return nil, err
+	}
+	proto, err := resp.proto()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cluster.cache.InsertProto(proto); err != nil {
+		log.Errorf("failed to reflect proto update to cache: %s", err)
+		return nil, err
+	}
+	return proto, nil
+}
@@ -186,6 +186,32 @@ func (i *item) consumer() (*v1.Consumer, error) {
 	return &consumer, nil
 }
 
+// consumerGroup decodes item.Value and converts it to v1.ConsumerGroup.
+func (i *item) consumerGroup() (*v1.ConsumerGroup, error) {
+	log.Debugf("got consumer_group: %s", string(i.Value))
+	var consumerGroup v1.ConsumerGroup
+	if err := json.Unmarshal(i.Value, &consumerGroup); err != nil {
+		return nil, err
+	}
+	return &consumerGroup, nil
+}
+
+// secret decodes item.Value and converts it to v1.Secret.
+func (i *item) secret() (*v1.Secret, error) {
+	var secret v1.Secret
+	if err := json.Unmarshal(i.Value, &secret); err != nil {
+		return nil, err
+	}
+	// The id of secret is composed by the secret manager and the identity,
+	// which may be only carried by the key, like /apisix/secrets/vault/1.
+	if secret.ID == "" {
+		if idx := strings.Index(i.Key, "/secrets/"); idx >= 0 {
+			secret.ID = i.Key[idx+len("/secrets/"):]
+		}
+	}
+	return &secret, nil
+}
+
 func (i *item) pluginMetadata() (*v1.PluginMetadata, error) {
 	log.Debugf("got pluginMetadata: %s", string(i.Value))
 	var pluginMetadata v1.PluginMetadata
@@ -197,6 +223,26 @@ func (i *item) pluginMetadata() (*v1.PluginMetadata, error) {
 	return &pluginMetadata, nil
 }
 
+// service decodes item.Value and converts it to v1.Service.
+func (i *item) service() (*v1.Service, error) {
+	log.Debugf("got service: %s", string(i.Value))
+	var service v1.Service
+	if err := json.Unmarshal(i.Value, &service); err != nil {
+		return nil, err
+	}
+	return &service, nil
+}
+
+// proto decodes item.Value and converts it to v1.Proto.
+func (i *item) proto() (*v1.Proto, error) {
+	log.Debugf("got proto: %s", string(i.Value))
+	var proto v1.Proto
+	if err := json.Unmarshal(i.Value, &proto); err != nil {
+		return nil, err
+	}
+	return &proto, nil
+}
+
 // pluginConfig decodes item.Value and converts it to v1.PluginConfig.
 func (i *item) pluginConfig() (*v1.PluginConfig, error) {
 	log.Debugf("got pluginConfig: %s", string(i.Value))
@@ -0,0 +1,228 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type secretClient struct {
+	url     string
+	cluster *cluster
+}
+
+func newSecretClient(c *cluster) Secret {
+	return &secretClient{
+		url:     c.baseURL + "/secrets",
+		cluster: c,
+	}
+}
+
+// Get returns the Secret, the id is composed by the secret manager and the
+// identity of the object, like "vault/1".
+// FIXME, currently if caller pass a non-existent resource, the Get always passes
+// through cache.
+func (r *secretClient) Get(ctx context.Context, secretID string) (*v1.Secret, error) {
+	log.Debugw("try to look up secret",
+		zap.String("id", secretID),
+		zap.String("url", r.url),
+		zap.String("cluster", r.cluster.name),
+	)
+	secret, err := r.cluster.cache.GetSecret(secretID)
+	if err == nil {
+		return secret, nil
+	}
+	if err != cache.ErrNotFound {
+		log.Errorw("failed to find secret in cache, will try to lookup from APISIX",
+			zap.String("id", secretID),
+			zap.Error(err),
+		)
+	} else {
+		log.Debugw("failed to find secret in cache, will try to lookup from APISIX",
+			zap.String("id", secretID),
+			zap.Error(err),
+		)
+	}
+
+	// TODO Add mutex here to avoid dog-pile effect.
+	url := r.url + "/" + secretID
+	resp, err := r.cluster.getResource(ctx, url, "secret")
+	r.cluster.metricsCollector.IncrAPISIXRequest("secret")
+	if err != nil {
+		if err == cache.ErrNotFound {
+			log.Warnw("secret not found",
+				zap.String("id", secretID),
+				zap.String("url", url),
+				zap.String("cluster", r.cluster.name),
+			)
+		} else {
+			log.Errorw("failed to get secret from APISIX",
+				zap.String("id", secretID),
+				zap.String("url", url),
+				zap.String("cluster", r.cluster.name),
+				zap.Error(err),
+			)
+		}
+		return nil, err
+	}
+
+	secret, err = resp.secret()
+	if err != nil {
+		log.Errorw("failed to convert secret item",
+			zap.String("url", r.url),
+			zap.String("secret_key", resp.Key),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if err := r.cluster.cache.InsertSecret(secret); err != nil {
+		log.Errorf("failed to reflect secret create to cache: %s", err)
+		return nil, err
+	}
+	return secret, nil
+}
+
+// List is only used in cache warming up. So here just pass through
+// to APISIX.
+func (r *secretClient) List(ctx context.Context) ([]*v1.Secret, error) {
+	log.Debugw("try to list secrets in APISIX",
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	secretItems, err := r.cluster.listResource(ctx, r.url, "secret")
+	r.cluster.metricsCollector.IncrAPISIXRequest("secret")
+	if err != nil {
+		log.Errorf("failed to list secrets: %s", err)
+		return nil, err
+	}
+
+	var items []*v1.Secret
+	for i, item := range secretItems {
+		secret, err := item.secret()
+		if err != nil {
+			log.Errorw("failed to convert secret item",
+				zap.String("url", r.url),
+				zap.String("secret_key", item.Key),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+
+		items = append(items, secret)
+		log.Debugf("list secret #%d, id: %s", i, secret.ID)
+	}
+
+	return items, nil
+}
+
+func (r *secretClient) Create(ctx context.Context, obj *v1.Secret) (*v1.Secret, error) {
+	log.Debugw("try to create secret",
+		zap.String("id", obj.ID),
+		zap.String("uri", obj.URI),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	url := r.url + "/" + obj.ID
+	// The body is not logged since it carries the token of the secret manager.
+	log.Debugw("creating secret", zap.String("url", url))
+	resp, err := r.cluster.createResource(ctx, url, "secret", data)
+	r.cluster.metricsCollector.IncrAPISIXRequest("secret")
+	if err != nil {
+		log.Errorf("failed to create secret: %s", err)
+		return nil, err
+	}
+
+	secret, err := resp.secret()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cluster.cache.InsertSecret(secret); err != nil {
+		log.Errorf("failed to reflect secret create to cache: %s", err)
+		return nil, err
+	}
+	return secret, nil
+}
+
+func (r *secretClient) Delete(ctx context.Context, obj *v1.Secret) error {
+	log.Debugw("try to delete secret",
+		zap.String("id", obj.ID),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return err
+	}
+	url := r.url + "/" + obj.ID
+	if err := r.cluster.deleteResource(ctx, url, "secret"); err != nil {
+		r.cluster.metricsCollector.IncrAPISIXRequest("secret")
+		return err
+	}
+	r.cluster.metricsCollector.IncrAPISIXRequest("secret")
+	if err := r.cluster.cache.DeleteSecret(obj); err != nil {
+		log.Errorf("failed to reflect secret delete to cache: %s", err)
+		if err != cache.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *secretClient) Update(ctx context.Context, obj *v1.Secret) (*v1.Secret, error) {
+	log.Debugw("try to update secret",
+		zap.String("id", obj.ID),
+		zap.String("uri", obj.URI),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	url := r.url + "/" + obj.ID
+	resp, err := r.cluster.updateResource(ctx, url, "secret", body)
+	r.cluster.metricsCollector.IncrAPISIXRequest("secret")
+	if err != nil {
+		return nil, err
+	}
+	secret, err := resp.secret()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cluster.cache.InsertSecret(secret); err != nil {
+		log.Errorf("failed to reflect secret update to cache: %s", err)
+		return nil, err
+	}
+	return secret, nil
+}
@@ -0,0 +1,218 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/nettest"
+
+	"github.com/apache/apisix-ingress-controller/pkg/metrics"
+	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type fakeAPISIXSecretSrv struct {
+	secret map[string]json.RawMessage
+}
+
+func (srv *fakeAPISIXSecretSrv) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+
+	if !strings.HasPrefix(r.URL.Path, "/apisix/admin/secrets") {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		resp := fakeListResp{
+			Count: strconv.Itoa(len(srv.secret)),
+			Node: fakeNode{
+				Key: "/apisix/secrets",
+			},
+		}
+		var keys []string
+		for key := range srv.secret {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			resp.Node.Items = append(resp.Node.Items, fakeItem{
+				Key:   key,
+				Value: srv.secret[key],
+			})
+		}
+		w.WriteHeader(http.StatusOK)
+		data, _ := json.Marshal(resp)
+		_, _ = w.Write(data)
+		return
+	}
+
+	if r.Method == http.MethodDelete {
+		id := strings.TrimPrefix(r.URL.Path, "/apisix/admin/secrets/")
+		id = "/apisix/admin/secrets/" + id
+		code := http.StatusNotFound
+		if _, ok := srv.secret[id]; ok {
+			delete(srv.secret, id)
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+	}
+
+	if r.Method == http.MethodPut {
+		key := "/apisix/admin/secrets/" + strings.TrimPrefix(r.URL.Path, "/apisix/admin/secrets/")
+		data, _ := io.ReadAll(r.Body)
+		srv.secret[key] = data
+		w.WriteHeader(http.StatusCreated)
+		resp := fakeCreateResp{
+			Action: "create",
+			Node: fakeItem{
+				Key:   key,
+				Value: json.RawMessage(data),
+			},
+		}
+		data, _ = json.Marshal(resp)
+		_, _ = w.Write(data)
+		return
+	}
+
+	if r.Method == http.MethodPatch {
+		id := strings.TrimPrefix(r.URL.Path, "/apisix/admin/secrets/")
+		id = "/apisix/secrets/" + id
+		if _, ok := srv.secret[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		srv.secret[id] = data
+
+		w.WriteHeader(http.StatusOK)
+		output := fmt.Sprintf(`{"action": "compareAndSwap", "node": {"key": "%s", "value": %s}}`, id, string(data))
+		_, _ = w.Write([]byte(output))
+		return
+	}
+}
+
+func runFakeSecretSrv(t *testing.T) *http.Server {
+	srv := &fakeAPISIXSecretSrv{
+		secret: make(map[string]json.RawMessage),
+	}
+
+	ln, _ := nettest.NewLocalListener("tcp")
+
+	httpSrv := &http.Server{
+		Addr:    ln.Addr().String(),
+		Handler: srv,
+	}
+
+	go func() {
+		if err := httpSrv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			t.Errorf("failed to run http server: %s", err)
+		}
+	}()
+
+	return httpSrv
+}
+
+func TestSecretClient(t *testing.T) {
+	srv := runFakeSecretSrv(t)
+	defer func() {
+		assert.Nil(t, srv.Shutdown(context.Background()))
+	}()
+
+	u := url.URL{
+		Scheme: "http",
+		Host:   srv.Addr,
+		Path:   "/apisix/admin",
+	}
+
+	closedCh := make(chan struct{})
+	close(closedCh)
+	cli := newSecretClient(&cluster{
+		baseURL:          u.String(),
+		cli:              http.DefaultClient,
+		cache:            &dummyCache{},
+		cacheSynced:      closedCh,
+		metricsCollector: metrics.NewPrometheusCollector(),
+	})
+
+	// Create
+	obj, err := cli.Create(context.Background(), &v1.Secret{
+		ID:     "vault/1",
+		URI:    "http://127.0.0.1:8200",
+		Prefix: "kv/apisix",
+		Token:  "root",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "vault/1", obj.ID)
+
+	obj, err = cli.Create(context.Background(), &v1.Secret{
+		ID:     "vault/2",
+		URI:    "http://127.0.0.1:8200",
+		Prefix: "kv/apisix",
+		Token:  "root",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "vault/2", obj.ID)
+
+	// List
+	objs, err := cli.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, objs, 2)
+	assert.Equal(t, "vault/1", objs[0].ID)
+	assert.Equal(t, "vault/2", objs[1].ID)
+
+	// Delete then List
+	assert.Nil(t, cli.Delete(context.Background(), objs[0]))
+	objs, err = cli.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, objs, 1)
+	assert.Equal(t, "vault/2", objs[0].ID)
+
+	// Patch then List
+	_, err = cli.Update(context.Background(), &v1.Secret{
+		ID:     "vault/2",
+		URI:    "http://127.0.0.1:8200",
+		Prefix: "kv/apisix",
+		Token:  "s.token",
+	})
+	assert.Nil(t, err)
+	objs, err = cli.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, objs, 1)
+	assert.Equal(t, "vault/2", objs[0].ID)
+	assert.Equal(t, "s.token", objs[0].Token)
+}
+
+func TestItemSecretWithoutID(t *testing.T) {
+	it := &item{
+		Key:   "/apisix/secrets/vault/1",
+		Value: json.RawMessage(`{"uri":"http://127.0.0.1:8200","prefix":"kv/apisix","token":"root"}`),
+	}
+	secret, err := it.secret()
+	assert.Nil(t, err)
+	assert.Equal(t, "vault/1", secret.ID)
+	assert.Equal(t, "kv/apisix", secret.Prefix)
+}
@@ -0,0 +1,234 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type serviceClient struct {
+	url     string
+	cluster *cluster
+}
+
+func newServiceClient(c *cluster) Service {
+	return &serviceClient{
+		url:     c.baseURL + "/services",
+		cluster: c,
+	}
+}
+
+// Get returns the Service.
+// FIXME, currently if caller pass a non-existent resource, the Get always passes
+// through cache.
+func (r *serviceClient) Get(ctx context.Context, name string) (*v1.Service, error) {
+	log.Debugw("try to look up service",
+		zap.String("name", name),
+		zap.String("url", r.url),
+		zap.String("cluster", r.cluster.name),
+	)
+	rid := id.GenID(name)
+	service, err := r.cluster.cache.GetService(rid)
+	if err == nil {
+		return service, nil
+	}
+	if err != cache.ErrNotFound {
+		log.Errorw("failed to find service in cache, will try to lookup from APISIX",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+	} else {
+		log.Debugw("failed to find service in cache, will try to lookup from APISIX",
+			zap.String("name", name),
+			zap.Error(err),
+		)
+	}
+
+	// TODO Add mutex here to avoid dog-pile effect.
+	url := r.url + "/" + rid
+	resp, err := r.cluster.getResource(ctx, url, "service")
+	r.cluster.metricsCollector.IncrAPISIXRequest("service")
+	if err != nil {
+		if err == cache.ErrNotFound {
+			log.Warnw("service not found",
+				zap.String("name", name),
+				zap.String("url", url),
+				zap.String("cluster", r.cluster.name),
+			)
+		} else {
+			log.Errorw("failed to get service from APISIX",
+				zap.String("name", name),
+				zap.String("url", url),
+				zap.String("cluster", r.cluster.name),
+				zap.Error(err),
+			)
+		}
+		return nil, err
+	}
+
+	service, err = resp.service()
+	if err != nil {
+		log.Errorw("failed to convert service item",
+			zap.String("url", r.url),
+			zap.String("service_key", resp.Key),
+			zap.String("service_value", string(resp.Value)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if err := r.cluster.cache.InsertService(service); err != nil {
+		log.Errorf("failed to reflect service create to cache: %s", err)
+		return nil, err
+	}
+	return service, nil
+}
+
+// List is only used in cache warming up. So here just pass through
+// to APISIX.
+func (r *serviceClient) List(ctx context.Context) ([]*v1.Service, error) {
+	log.Debugw("try to list services in APISIX",
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	serviceItems, err := r.cluster.listResource(ctx, r.url, "service")
+	r.cluster.metricsCollector.IncrAPISIXRequest("service")
+	if err != nil {
+		log.Errorf("failed to list services: %s", err)
+		return nil, err
+	}
+
+	var items []*v1.Service
+	for i, item := range serviceItems {
+		service, err := item.service()
+		if err != nil {
+			log.Errorw("failed to convert service item",
+				zap.String("url", r.url),
+				zap.String("service_key", item.Key),
+				zap.String("service_value", string(item.Value)),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+
+		items = append(items, service)
+		log.Debugf("list service #%d, body: %s", i, string(item.Value))
+	}
+
+	return items, nil
+}
+
+func (r *serviceClient) Create(ctx context.Context, obj *v1.Service) (*v1.Service, error) {
+	log.Debugw("try to create service",
+		zap.String("id", obj.ID),
+		zap.String("name", obj.Name),
+		zap.String("upstream_id", obj.UpstreamId),
+		zap.Any("plugins", obj.Plugins),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	url := r.url + "/" + obj.ID
+	log.Debugw("creating service", zap.ByteString("body", data), zap.String("url", url))
+	resp, err := r.cluster.createResource(ctx, url, "service", data)
+	r.cluster.metricsCollector.IncrAPISIXRequest("service")
+	if err != nil {
+		log.Errorf("failed to create service: %s", err)
+		return nil, err
+	}
+
+	service, err := resp.service()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cluster.cache.InsertService(service); err != nil {
+		log.Errorf("failed to reflect service create to cache: %s", err)
+		return nil, err
+	}
+	return service, nil
+}
+
+func (r *serviceClient) Delete(ctx context.Context, obj *v1.Service) error {
+	log.Debugw("try to delete service",
+		zap.String("id", obj.ID),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return err
+	}
+	url := r.url + "/" + obj.ID
+	if err := r.cluster.deleteResource(ctx, url, "service"); err != nil {
+		r.cluster.metricsCollector.IncrAPISIXRequest("service")
+		return err
+	}
+	r.cluster.metricsCollector.IncrAPISIXRequest("service")
+	if err := r.cluster.cache.DeleteService(obj); err != nil {
+		log.Errorf("failed to reflect service delete to cache: %s", err)
+		if err != cache.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *serviceClient) Update(ctx context.Context, obj *v1.Service) (*v1.Service, error) {
+	log.Debugw("try to update service",
+		zap.String("id", obj.ID),
+		zap.String("name", obj.Name),
+		zap.String("upstream_id", obj.UpstreamId),
+		zap.Any("plugins", obj.Plugins),
+		zap.String("cluster", r.cluster.name),
+		zap.String("url", r.url),
+	)
+	if err := r.cluster.HasSynced(ctx); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	url := r.url + "/" + obj.ID
+	resp, err := r.cluster.updateResource(ctx, url, "service", body)
+	r.cluster.metricsCollector.IncrAPISIXRequest("service")
+	if err != nil {
+		return nil, err
+	}
+	service, err := resp.service()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cluster.cache.InsertService(service); err != nil {
+		log.Errorf("failed to reflect service update to cache: %s", err)
+		return nil, err
+	}
+	return service, nil
+}
@@ -0,0 +1,823 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apisix
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	// _standaloneConfigEnd is the mark which tells APISIX that the
+	// standalone config file is completely written.
+	_standaloneConfigEnd = "#END\n"
+	// _standaloneRenderRetryInterval is the interval to retry a failed render.
+	_standaloneRenderRetryInterval = 2 * time.Second
+)
+
+// StandaloneRenderer persists the APISIX standalone config (apisix.yaml).
+type StandaloneRenderer interface {
+	// Load returns the config rendered last time, nil is returned if the
+	// config was never rendered.
+	Load(context.Context) ([]byte, error)
+	// Render replaces the config atomically.
+	Render(context.Context, []byte) error
+	// String exposes the renderer information in human-readable format.
+	String() string
+}
+
+type fileRenderer struct {
+	path string
+}
+
+// NewFileRenderer returns a StandaloneRenderer which writes the config
+// into the file.
+func NewFileRenderer(path string) StandaloneRenderer {
+	return &fileRenderer{path: path}
+}
+
+func (r *fileRenderer) Load(_ context.Context) ([]byte, error) {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *fileRenderer) Render(_ context.Context, data []byte) error {
+	// Write to a temporary file and rename it, so that APISIX never
+	// reads a partially written config.
+	f, err := os.CreateTemp(filepath.Dir(r.path), "."+filepath.Base(r.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), r.path)
+}
+
+func (r *fileRenderer) String() string {
+	return "file=" + r.path
+}
+
+type configMapRenderer struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	key       string
+}
+
+// NewConfigMapRenderer returns a StandaloneRenderer which writes the config
+// into the key of the ConfigMap, the ConfigMap is created if it doesn't exist.
+func NewConfigMapRenderer(client kubernetes.Interface, namespace, name, key string) StandaloneRenderer {
+	return &configMapRenderer{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		key:       key,
+	}
+}
+
+func (r *configMapRenderer) Load(ctx context.Context) ([]byte, error) {
+	cm, err := r.client.CoreV1().ConfigMaps(r.namespace).Get(ctx, r.name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	data, ok := cm.Data[r.key]
+	if !ok {
+		return nil, nil
+	}
+	return []byte(data), nil
+}
+
+func (r *configMapRenderer) Render(ctx context.Context, data []byte) error {
+	cm, err := r.client.CoreV1().ConfigMaps(r.namespace).Get(ctx, r.name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
+		_, err = r.client.CoreV1().ConfigMaps(r.namespace).Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: r.namespace,
+				Name:      r.name,
+			},
+			Data: map[string]string{
+				r.key: string(data),
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[r.key] = string(data)
+	_, err = r.client.CoreV1().ConfigMaps(r.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+func (r *configMapRenderer) String() string {
+	return fmt.Sprintf("configmap=%s/%s; key=%s", r.namespace, r.name, r.key)
+}
+
+// standaloneConfig is the layout of apisix.yaml.
+type standaloneConfig struct {
+	Routes         []*v1.Route         `json:"routes,omitempty"`
+	Upstreams      []*v1.Upstream      `json:"upstreams,omitempty"`
+	SSLs           []*v1.Ssl           `json:"ssls,omitempty"`
+	StreamRoutes   []*v1.StreamRoute   `json:"stream_routes,omitempty"`
+	GlobalRules    []*v1.GlobalRule    `json:"global_rules,omitempty"`
+	Consumers      []*v1.Consumer      `json:"consumers,omitempty"`
+	ConsumerGroups []*v1.ConsumerGroup `json:"consumer_groups,omitempty"`
+	Secrets        []*v1.Secret        `json:"secrets,omitempty"`
+	PluginConfigs  []*v1.PluginConfig  `json:"plugin_configs,omitempty"`
+	Services       []*v1.Service       `json:"services,omitempty"`
+	Protos         []*v1.Proto         `json:"protos,omitempty"`
+	PluginMetadata []map[string]any    `json:"plugin_metadata,omitempty"`
+}
+
+// standaloneCluster keeps the desired state in memory and renders it
+// into the APISIX standalone config on each change, instead of calling
+// the Admin API.
+type standaloneCluster struct {
+	name     string
+	renderer StandaloneRenderer
+	cache    cache.Cache
+
+	pluginMetadataLock sync.RWMutex
+	pluginMetadatas    map[string]*v1.PluginMetadata
+
+	renderErrLock sync.RWMutex
+	renderErr     error
+	changed       chan struct{}
+
+	route                   Route
+	upstream                Upstream
+	ssl                     SSL
+	streamRoute             StreamRoute
+	globalRules             GlobalRule
+	consumer                Consumer
+	consumerGroup           ConsumerGroup
+	secret                  Secret
+	pluginConfig            PluginConfig
+	service                 Service
+	proto                   Proto
+	pluginMetadata          PluginMetadata
+	upstreamServiceRelation UpstreamServiceRelation
+}
+
+func newStandaloneCluster(ctx context.Context, o *ClusterOptions) (Cluster, error) {
+	db, err := cache.NewMemDBCache()
+	if err != nil {
+		return nil, err
+	}
+	c := &standaloneCluster{
+		name:            o.Name,
+		renderer:        o.StandaloneRenderer,
+		cache:           db,
+		pluginMetadatas: make(map[string]*v1.PluginMetadata),
+		changed:         make(chan struct{}, 1),
+	}
+	c.route = &standaloneResource[*v1.Route]{
+		cluster: c,
+		key:     id.GenID,
+		get:     db.GetRoute,
+		list:    db.ListRoutes,
+		insert:  db.InsertRoute,
+		remove:  db.DeleteRoute,
+	}
+	c.upstream = &standaloneUpstream{
+		standaloneResource: &standaloneResource[*v1.Upstream]{
+			cluster: c,
+			key:     id.GenID,
+			get:     db.GetUpstream,
+			list:    db.ListUpstreams,
+			insert:  db.InsertUpstream,
+			remove:  db.DeleteUpstream,
+		},
+	}
+	c.ssl = &standaloneResource[*v1.Ssl]{
+		cluster: c,
+		key:     id.GenID,
+		get:     db.GetSSL,
+		list:    db.ListSSL,
+		insert:  db.InsertSSL,
+		remove:  db.DeleteSSL,
+	}
+	c.streamRoute = &standaloneResource[*v1.StreamRoute]{
+		cluster: c,
+		key:     id.GenID,
+		get:     db.GetStreamRoute,
+		list:    db.ListStreamRoutes,
+		insert:  db.InsertStreamRoute,
+		remove:  db.DeleteStreamRoute,
+	}
+	c.globalRules = &standaloneResource[*v1.GlobalRule]{
+		cluster: c,
+		key:     id.GenID,
+		get:     db.GetGlobalRule,
+		list:    db.ListGlobalRules,
+		insert:  db.InsertGlobalRule,
+		remove:  db.DeleteGlobalRule,
+	}
+	c.consumer = &standaloneResource[*v1.Consumer]{
+		cluster: c,
+		key:     func(name string) string { return name },
+		get:     db.GetConsumer,
+		list:    db.ListConsumers,
+		insert:  db.InsertConsumer,
+		remove:  db.DeleteConsumer,
+	}
+	c.consumerGroup = &standaloneResource[*v1.ConsumerGroup]{
+		cluster: c,
+		key:     id.GenID,
+		get:     db.GetConsumerGroup,
+		list:    db.ListConsumerGroups,
+		insert:  db.InsertConsumerGroup,
+		remove:  db.DeleteConsumerGroup,
+	}
+	c.secret = &standaloneResource[*v1.Secret]{
+		cluster: c,
+		// The secret is looked up by its id directly.
+		key:    func(secretID string) string { return secretID },
+		get:    db.GetSecret,
+		list:   db.ListSecrets,
+		insert: db.InsertSecret,
+		remove: db.DeleteSecret,
+	}
+	c.pluginConfig = &standaloneResource[*v1.PluginConfig]{
+		cluster: c,
+		key:     id.GenID,
+		get:     db.GetPluginConfig,
+		list:    db.ListPluginConfigs,
+		insert:  db.InsertPluginConfig,
+		remove:  db.DeletePluginConfig,
+	}
+	c.service = &standaloneResource[*v1.Service]{
+		cluster: c,
+		key:     id.GenID,
+		get:     db.GetService,
+		list:    db.ListServices,
+		insert:  db.InsertService,
+		remove:  db.DeleteService,
+	}
+	c.proto = &standaloneResource[*v1.Proto]{
+		cluster: c,
+		key:     id.GenID,
+		get:     db.GetProto,
+		list:    db.ListProtos,
+		insert:  db.InsertProto,
+		remove:  db.DeleteProto,
+	}
+	c.pluginMetadata = &standalonePluginMetadata{cluster: c}
+	c.upstreamServiceRelation = &standaloneUpstreamServiceRelation{cluster: c}
+
+	if err := c.load(ctx); err != nil {
+		return nil, err
+	}
+
+	go c.renderLoop(ctx)
+
+	return c, nil
+}
+
+// load restores the state rendered last time, so that the config isn't
+// truncated before all resources are synced after restarting.
+func (c *standaloneCluster) load(ctx context.Context) error {
+	data, err := c.renderer.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load standalone config: %s", err)
+	}
+	data = bytes.TrimSuffix(bytes.TrimSpace(data), []byte("#END"))
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	var cfg standaloneConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("failed to parse standalone config: %s", err)
+	}
+	for _, u := range cfg.Upstreams {
+		if err := c.cache.InsertUpstream(u); err != nil {
+			return err
+		}
+		if err := c.upstreamServiceRelation.Create(ctx, u.Name); err != nil {
+			log.Warnw("failed to build upstream service relation",
+				zap.String("upstream", u.Name),
+				zap.Error(err),
+			)
+		}
+	}
+	for _, pc := range cfg.PluginConfigs {
+		if err := c.cache.InsertPluginConfig(pc); err != nil {
+			return err
+		}
+	}
+	for _, svc := range cfg.Services {
+		if err := c.cache.InsertService(svc); err != nil {
+			return err
+		}
+	}
+	for _, proto := range cfg.Protos {
+		if err := c.cache.InsertProto(proto); err != nil {
+			return err
+		}
+	}
+	for _, r := range cfg.Routes {
+		if err := c.cache.InsertRoute(r); err != nil {
+			return err
+		}
+	}
+	for _, ssl := range cfg.SSLs {
+		if err := c.cache.InsertSSL(ssl); err != nil {
+			return err
+		}
+	}
+	for _, sr := range cfg.StreamRoutes {
+		if err := c.cache.InsertStreamRoute(sr); err != nil {
+			return err
+		}
+	}
+	for _, gr := range cfg.GlobalRules {
+		if err := c.cache.InsertGlobalRule(gr); err != nil {
+			return err
+		}
+	}
+	for _, consumer := range cfg.Consumers {
+		if err := c.cache.InsertConsumer(consumer); err != nil {
+			return err
+		}
+	}
+	for _, cg := range cfg.ConsumerGroups {
+		if err := c.cache.InsertConsumerGroup(cg); err != nil {
+			return err
+		}
+	}
+	for _, secret := range cfg.Secrets {
+		if err := c.cache.InsertSecret(secret); err != nil {
+			return err
+		}
+	}
+	for _, item := range cfg.PluginMetadata {
+		name, _ := item["id"].(string)
+		if name == "" {
+			continue
+		}
+		metadata := make(map[string]any, len(item))
+		for k, v := range item {
+			if k != "id" {
+				metadata[k] = v
+			}
+		}
+		c.pluginMetadatas[name] = &v1.PluginMetadata{
+			Name:     name,
+			Metadata: metadata,
+		}
+	}
+	log.Infow("standalone config loaded",
+		zap.String("cluster", c.name),
+		zap.String("renderer", c.renderer.String()),
+	)
+	return nil
+}
+
+// notify marks the state as changed, changes in a short time are
+// rendered together.
+func (c *standaloneCluster) notify() {
+	select {
+	case c.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (c *standaloneCluster) renderLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.changed:
+		}
+
+		err := c.render(ctx)
+		c.renderErrLock.Lock()
+		c.renderErr = err
+		c.renderErrLock.Unlock()
+		if err != nil {
+			log.Errorw("failed to render standalone config, will retry",
+				zap.String("cluster", c.name),
+				zap.String("renderer", c.renderer.String()),
+				zap.Error(err),
+			)
+			time.AfterFunc(_standaloneRenderRetryInterval, c.notify)
+		}
+	}
+}
+
+func (c *standaloneCluster) render(ctx context.Context) error {
+	data, err := c.marshal()
+	if err != nil {
+		return err
+	}
+	return c.renderer.Render(ctx, data)
+}
+
+// marshal renders the state into apisix.yaml, objects are sorted so
+// that the output is stable.
+func (c *standaloneCluster) marshal() ([]byte, error) {
+	var (
+		cfg standaloneConfig
+		err error
+	)
+	if cfg.Routes, err = c.cache.ListRoutes(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.Routes, func(i, j int) bool { return cfg.Routes[i].ID < cfg.Routes[j].ID })
+	if cfg.Upstreams, err = c.cache.ListUpstreams(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.Upstreams, func(i, j int) bool { return cfg.Upstreams[i].ID < cfg.Upstreams[j].ID })
+	if cfg.SSLs, err = c.cache.ListSSL(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.SSLs, func(i, j int) bool { return cfg.SSLs[i].ID < cfg.SSLs[j].ID })
+	if cfg.StreamRoutes, err = c.cache.ListStreamRoutes(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.StreamRoutes, func(i, j int) bool { return cfg.StreamRoutes[i].ID < cfg.StreamRoutes[j].ID })
+	if cfg.GlobalRules, err = c.cache.ListGlobalRules(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.GlobalRules, func(i, j int) bool { return cfg.GlobalRules[i].ID < cfg.GlobalRules[j].ID })
+	if cfg.Consumers, err = c.cache.ListConsumers(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.Consumers, func(i, j int) bool { return cfg.Consumers[i].Username < cfg.Consumers[j].Username })
+	if cfg.ConsumerGroups, err = c.cache.ListConsumerGroups(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.ConsumerGroups, func(i, j int) bool { return cfg.ConsumerGroups[i].ID < cfg.ConsumerGroups[j].ID })
+	if cfg.Secrets, err = c.cache.ListSecrets(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.Secrets, func(i, j int) bool { return cfg.Secrets[i].ID < cfg.Secrets[j].ID })
+	if cfg.PluginConfigs, err = c.cache.ListPluginConfigs(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.PluginConfigs, func(i, j int) bool { return cfg.PluginConfigs[i].ID < cfg.PluginConfigs[j].ID })
+	if cfg.Services, err = c.cache.ListServices(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.Services, func(i, j int) bool { return cfg.Services[i].ID < cfg.Services[j].ID })
+	if cfg.Protos, err = c.cache.ListProtos(); err != nil {
+		return nil, err
+	}
+	sort.Slice(cfg.Protos, func(i, j int) bool { return cfg.Protos[i].ID < cfg.Protos[j].ID })
+
+	c.pluginMetadataLock.RLock()
+	for name, pm := range c.pluginMetadatas {
+		item := make(map[string]any, len(pm.Metadata)+1)
+		for k, v := range pm.Metadata {
+			item[k] = v
+		}
+		item["id"] = name
+		cfg.PluginMetadata = append(cfg.PluginMetadata, item)
+	}
+	c.pluginMetadataLock.RUnlock()
+	sort.Slice(cfg.PluginMetadata, func(i, j int) bool {
+		return cfg.PluginMetadata[i]["id"].(string) < cfg.PluginMetadata[j]["id"].(string)
+	})
+
+	data, err := yaml.Marshal(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, _standaloneConfigEnd...), nil
+}
+
+// Route implements Cluster.Route method.
+func (c *standaloneCluster) Route() Route {
+	return c.route
+}
+
+// Upstream implements Cluster.Upstream method.
+func (c *standaloneCluster) Upstream() Upstream {
+	return c.upstream
+}
+
+// SSL implements Cluster.SSL method.
+func (c *standaloneCluster) SSL() SSL {
+	return c.ssl
+}
+
+// StreamRoute implements Cluster.StreamRoute method.
+func (c *standaloneCluster) StreamRoute() StreamRoute {
+	return c.streamRoute
+}
+
+// GlobalRule implements Cluster.GlobalRule method.
+func (c *standaloneCluster) GlobalRule() GlobalRule {
+	return c.globalRules
+}
+
+// Consumer implements Cluster.Consumer method.
+func (c *standaloneCluster) Consumer() Consumer {
+	return c.consumer
+}
+
+// ConsumerGroup implements Cluster.ConsumerGroup method.
+func (c *standaloneCluster) ConsumerGroup() ConsumerGroup {
+	return c.consumerGroup
+}
+
+// Secret implements Cluster.Secret method.
+func (c *standaloneCluster) Secret() Secret {
+	return c.secret
+}
+
+// Plugin implements Cluster.Plugin method, plugins can't be listed
+// without the Admin API.
+func (c *standaloneCluster) Plugin() Plugin {
+	return &standaloneDisabled{}
+}
+
+// PluginConfig implements Cluster.PluginConfig method.
+func (c *standaloneCluster) PluginConfig() PluginConfig {
+	return c.pluginConfig
+}
+
+// Service implements Cluster.Service method.
+func (c *standaloneCluster) Service() Service {
+	return c.service
+}
+
+// Proto implements Cluster.Proto method.
+func (c *standaloneCluster) Proto() Proto {
+	return c.proto
+}
+
+// Schema implements Cluster.Schema method, schemas can't be fetched
+// without the Admin API.
+func (c *standaloneCluster) Schema() Schema {
+	return &standaloneDisabled{}
+}
+
+// PluginMetadata implements Cluster.PluginMetadata method.
+func (c *standaloneCluster) PluginMetadata() PluginMetadata {
+	return c.pluginMetadata
+}
+
+// UpstreamServiceRelation implements Cluster.UpstreamServiceRelation method.
+func (c *standaloneCluster) UpstreamServiceRelation() UpstreamServiceRelation {
+	return c.upstreamServiceRelation
+}
+
+// Cache implements Cluster.Cache method.
+func (c *standaloneCluster) Cache() cache.Cache {
+	return c.cache
+}
+
+// HasSynced implements Cluster.HasSynced method, the state is loaded
+// while creating the cluster.
+func (c *standaloneCluster) HasSynced(_ context.Context) error {
+	return nil
+}
+
+// HealthCheck implements Cluster.HealthCheck method, it reports the
+// error of the last render.
+func (c *standaloneCluster) HealthCheck(_ context.Context) error {
+	c.renderErrLock.RLock()
+	defer c.renderErrLock.RUnlock()
+	return c.renderErr
+}
+
+// String implements Cluster.String method.
+func (c *standaloneCluster) String() string {
+	return fmt.Sprintf("name=%s; standalone %s", c.name, c.renderer.String())
+}
+
+// standaloneResource operates objects in the state of standalone cluster.
+type standaloneResource[T any] struct {
+	cluster *standaloneCluster
+	// key returns the primary index of the object with the given name.
+	key    func(string) string
+	get    func(string) (T, error)
+	list   func() ([]T, error)
+	insert func(T) error
+	remove func(T) error
+}
+
+func (r *standaloneResource[T]) Get(_ context.Context, name string) (T, error) {
+	return r.get(r.key(name))
+}
+
+func (r *standaloneResource[T]) List(_ context.Context) ([]T, error) {
+	return r.list()
+}
+
+func (r *standaloneResource[T]) Create(ctx context.Context, obj T) (T, error) {
+	return r.Update(ctx, obj)
+}
+
+func (r *standaloneResource[T]) Update(_ context.Context, obj T) (T, error) {
+	if err := r.insert(obj); err != nil {
+		var zero T
+		return zero, err
+	}
+	r.cluster.notify()
+	return obj, nil
+}
+
+func (r *standaloneResource[T]) Delete(_ context.Context, obj T) error {
+	if err := r.remove(obj); err != nil {
+		if err != cache.ErrNotFound {
+			return err
+		}
+		return nil
+	}
+	r.cluster.notify()
+	return nil
+}
+
+type standaloneUpstream struct {
+	*standaloneResource[*v1.Upstream]
+}
+
+func (u *standaloneUpstream) Create(ctx context.Context, obj *v1.Upstream) (*v1.Upstream, error) {
+	return u.Update(ctx, obj)
+}
+
+func (u *standaloneUpstream) Update(ctx context.Context, obj *v1.Upstream) (*v1.Upstream, error) {
+	if err := u.cluster.upstreamServiceRelation.Create(ctx, obj.Name); err != nil {
+		log.Errorf("failed to reflect upstreamService create to cache: %s", err)
+	}
+	return u.standaloneResource.Update(ctx, obj)
+}
+
+type standalonePluginMetadata struct {
+	cluster *standaloneCluster
+}
+
+func (r *standalonePluginMetadata) Get(_ context.Context, name string) (*v1.PluginMetadata, error) {
+	r.cluster.pluginMetadataLock.RLock()
+	defer r.cluster.pluginMetadataLock.RUnlock()
+	pm, ok := r.cluster.pluginMetadatas[name]
+	if !ok {
+		return nil, cache.ErrNotFound
+	}
+	return pm, nil
+}
+
+func (r *standalonePluginMetadata) List(_ context.Context) ([]*v1.PluginMetadata, error) {
+	r.cluster.pluginMetadataLock.RLock()
+	defer r.cluster.pluginMetadataLock.RUnlock()
+	items := make([]*v1.PluginMetadata, 0, len(r.cluster.pluginMetadatas))
+	for _, pm := range r.cluster.pluginMetadatas {
+		items = append(items, pm)
+	}
+	return items, nil
+}
+
+func (r *standalonePluginMetadata) Delete(_ context.Context, obj *v1.PluginMetadata) error {
+	r.cluster.pluginMetadataLock.Lock()
+	delete(r.cluster.pluginMetadatas, obj.Name)
+	r.cluster.pluginMetadataLock.Unlock()
+	r.cluster.notify()
+	return nil
+}
+
+func (r *standalonePluginMetadata) Update(_ context.Context, obj *v1.PluginMetadata) (*v1.PluginMetadata, error) {
+	pm := *obj
+	r.cluster.pluginMetadataLock.Lock()
+	r.cluster.pluginMetadatas[obj.Name] = &pm
+	r.cluster.pluginMetadataLock.Unlock()
+	r.cluster.notify()
+	return obj, nil
+}
+
+type standaloneUpstreamServiceRelation struct {
+	cluster *standaloneCluster
+}
+
+func (u *standaloneUpstreamServiceRelation) Get(_ context.Context, serviceName string) (*v1.UpstreamServiceRelation, error) {
+	return u.cluster.cache.GetUpstreamServiceRelation(serviceName)
+}
+
+func (u *standaloneUpstreamServiceRelation) List(_ context.Context) ([]*v1.UpstreamServiceRelation, error) {
+	return u.cluster.cache.ListUpstreamServiceRelation()
+}
+
+func (u *standaloneUpstreamServiceRelation) Delete(ctx context.Context, serviceName string) error {
+	relation, err := u.cluster.cache.GetUpstreamServiceRelation(serviceName)
+	if err != nil {
+		if err == cache.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	_ = u.cluster.cache.DeleteUpstreamServiceRelation(relation)
+	for upsName := range relation.UpstreamNames {
+		ups, err := u.cluster.upstream.Get(ctx, upsName)
+		if err != nil {
+			continue
+		}
+		ups.Nodes = make(v1.UpstreamNodes, 0)
+		if _, err := u.cluster.upstream.Update(ctx, ups); err != nil {
+			log.Error(err)
+		}
+	}
+	return nil
+}
+
+func (u *standaloneUpstreamServiceRelation) Create(_ context.Context, upstreamName string) error {
+	serviceName, ok, err := upstreamServiceName(upstreamName)
+	if err != nil || !ok {
+		return err
+	}
+	relation, err := u.cluster.cache.GetUpstreamServiceRelation(serviceName)
+	if err != nil && err != cache.ErrNotFound {
+		return err
+	}
+	if relation == nil {
+		relation = &v1.UpstreamServiceRelation{
+			ServiceName:   serviceName,
+			UpstreamNames: map[string]struct{}{},
+		}
+	}
+	relation.UpstreamNames[upstreamName] = struct{}{}
+	return u.cluster.cache.InsertUpstreamServiceRelation(relation)
+}
+
+// standaloneDisabled implements the interfaces which rely on the Admin API.
+type standaloneDisabled struct{}
+
+func (d *standaloneDisabled) List(_ context.Context) ([]string, error) {
+	return nil, ErrFunctionDisabled
+}
+
+func (d *standaloneDisabled) GetPluginSchema(_ context.Context, _ string) (*v1.Schema, error) {
+	return nil, ErrFunctionDisabled
+}
+
+func (d *standaloneDisabled) GetRouteSchema(_ context.Context) (*v1.Schema, error) {
+	return nil, ErrFunctionDisabled
+}
+
+func (d *standaloneDisabled) GetUpstreamSchema(_ context.Context) (*v1.Schema, error) {
+	return nil, ErrFunctionDisabled
+}
+
+func (d *standaloneDisabled) GetConsumerSchema(_ context.Context) (*v1.Schema, error) {
+	return nil, ErrFunctionDisabled
+}
+
+func (d *standaloneDisabled) GetSslSchema(_ context.Context) (*v1.Schema, error) {
+	return nil, ErrFunctionDisabled
+}
+
+func (d *standaloneDisabled) GetPluginConfigSchema(_ context.Context) (*v1.Schema, error) {
+	return nil, ErrFunctionDisabled
+}
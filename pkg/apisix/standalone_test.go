@@ -0,0 +1,150 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apisix
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func waitRendered(t *testing.T, renderer StandaloneRenderer, substr string) string {
+	var data string
+	assert.Eventually(t, func() bool {
+		raw, err := renderer.Load(context.Background())
+		if err != nil {
+			return false
+		}
+		data = string(raw)
+		return strings.Contains(data, substr)
+	}, 5*time.Second, 50*time.Millisecond)
+	return data
+}
+
+func TestStandaloneClusterFileRenderer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	renderer := NewFileRenderer(filepath.Join(t.TempDir(), "apisix.yaml"))
+	c, err := newCluster(ctx, &ClusterOptions{
+		Name:               "default",
+		StandaloneRenderer: renderer,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, c.HasSynced(ctx))
+
+	ups := &v1.Upstream{
+		Metadata: v1.Metadata{
+			ID:   id.GenID("default_httpbin_80"),
+			Name: "default_httpbin_80",
+		},
+		Type: "roundrobin",
+		Nodes: v1.UpstreamNodes{
+			{Host: "10.0.0.1", Port: 80, Weight: 100},
+		},
+	}
+	_, err = c.Upstream().Create(ctx, ups)
+	assert.Nil(t, err)
+	route := &v1.Route{
+		Metadata: v1.Metadata{
+			ID:   id.GenID("default_httpbin_rule1"),
+			Name: "default_httpbin_rule1",
+		},
+		Uri:        "/ip",
+		UpstreamId: ups.ID,
+	}
+	_, err = c.Route().Create(ctx, route)
+	assert.Nil(t, err)
+	_, err = c.PluginMetadata().Update(ctx, &v1.PluginMetadata{
+		Name: "http-logger",
+		Metadata: map[string]any{
+			"log_format": map[string]any{"host": "$host"},
+		},
+	})
+	assert.Nil(t, err)
+
+	data := waitRendered(t, renderer, "http-logger")
+	assert.True(t, strings.HasSuffix(data, "#END\n"))
+	assert.Contains(t, data, "uri: /ip")
+	assert.Contains(t, data, "10.0.0.1")
+
+	// upstream is still referenced by the route
+	assert.Equal(t, cache.ErrStillInUse, c.Upstream().Delete(ctx, ups))
+
+	relation, err := c.UpstreamServiceRelation().Get(ctx, "default_httpbin")
+	assert.Nil(t, err)
+	assert.Contains(t, relation.UpstreamNames, "default_httpbin_80")
+
+	// the state is restored from the rendered config
+	c2, err := newCluster(ctx, &ClusterOptions{
+		Name:               "default",
+		StandaloneRenderer: renderer,
+	})
+	assert.Nil(t, err)
+	r, err := c2.Route().Get(ctx, "default_httpbin_rule1")
+	assert.Nil(t, err)
+	assert.Equal(t, "/ip", r.Uri)
+	u, err := c2.Upstream().Get(ctx, "default_httpbin_80")
+	assert.Nil(t, err)
+	assert.Len(t, u.Nodes, 1)
+	pm, err := c2.PluginMetadata().Get(ctx, "http-logger")
+	assert.Nil(t, err)
+	assert.Contains(t, pm.Metadata, "log_format")
+
+	assert.Nil(t, c2.Route().Delete(ctx, r))
+	assert.Nil(t, c2.Upstream().Delete(ctx, u))
+	_, err = c2.Route().Get(ctx, "default_httpbin_rule1")
+	assert.Equal(t, cache.ErrNotFound, err)
+
+	_, err = c2.Schema().GetRouteSchema(ctx)
+	assert.Equal(t, ErrFunctionDisabled, err)
+}
+
+func TestStandaloneClusterConfigMapRenderer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := fake.NewSimpleClientset()
+	renderer := NewConfigMapRenderer(client, "ingress-apisix", "apisix-standalone", "apisix.yaml")
+	c, err := newCluster(ctx, &ClusterOptions{
+		Name:               "default",
+		StandaloneRenderer: renderer,
+	})
+	assert.Nil(t, err)
+
+	_, err = c.Consumer().Create(ctx, &v1.Consumer{
+		Username: "jack",
+		Plugins: v1.Plugins{
+			"key-auth": map[string]any{"key": "jack-key"},
+		},
+	})
+	assert.Nil(t, err)
+	waitRendered(t, renderer, "username: jack")
+
+	cm, err := client.CoreV1().ConfigMaps("ingress-apisix").Get(ctx, "apisix-standalone", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Contains(t, cm.Data["apisix.yaml"], "jack-key")
+}
@@ -92,18 +92,10 @@ func (u *upstreamService) Create(ctx context.Context, upstreamName string) error
 		zap.String("cluster", u.cluster.name),
 	)
 
-	args := strings.Split(upstreamName, "_")
-	if len(args) < 2 {
-		return fmt.Errorf("wrong upstream name %s, must contains namespace_name", upstreamName)
-	}
-	// The last part of upstreanName should be a port number.
-	// Please refer to apisixv1.ComposeUpstreamName to see the detailed format.
-	_, err := strconv.Atoi(args[len(args)-1])
-	if err != nil {
-		return nil
+	serviceName, ok, err := upstreamServiceName(upstreamName)
+	if err != nil || !ok {
+		return err
 	}
-
-	serviceName := args[0] + "_" + args[1]
 	relation, err := u.Get(ctx, serviceName)
 	if err != nil && err != cache.ErrNotFound {
 		return err
@@ -138,3 +130,18 @@ func (u *upstreamService) List(ctx context.Context) ([]*v1.UpstreamServiceRelati
 	}
 	return usrs, nil
 }
+
+// upstreamServiceName returns the service name (namespace_name) of the upstream,
+// ok is false if the upstream isn't composed from a service port.
+func upstreamServiceName(upstreamName string) (serviceName string, ok bool, err error) {
+	args := strings.Split(upstreamName, "_")
+	if len(args) < 2 {
+		return "", false, fmt.Errorf("wrong upstream name %s, must contains namespace_name", upstreamName)
+	}
+	// The last part of upstreanName should be a port number.
+	// Please refer to apisixv1.ComposeUpstreamName to see the detailed format.
+	if _, err := strconv.Atoi(args[len(args)-1]); err != nil {
+		return "", false, nil
+	}
+	return args[0] + "_" + args[1], true, nil
+}
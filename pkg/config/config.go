@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync/atomic"
 	"text/template"
 	"time"
 
@@ -38,6 +39,9 @@ const (
 	// object's IngressClassName field in Kubernetes clusters version v1.18.0
 	// or higher, or the annotation "kubernetes.io/ingress.class" (deprecated).
 	IngressClass = "apisix"
+	// IngressClassController is the default controller value of the
+	// IngressClass resources served by apisix-ingress-controller.
+	IngressClassController = "apisix.apache.org/apisix-ingress"
 
 	// IngressNetworkingV1 represents ingress.networking/v1
 	IngressNetworkingV1 = "networking/v1"
@@ -59,47 +63,67 @@ const (
 	// ControllerName is the name of the controller used to identify
 	// the controller of the GatewayClass.
 	ControllerName = "apisix.apache.org/gateway-controller"
+
+	// DefaultAdminKeySecretKey is the default key in the Secret data
+	// whose value is the admin key of APISIX cluster.
+	DefaultAdminKeySecretKey = "admin-key"
+
+	// ConfigProviderAdminAPI means resources are pushed to APISIX through
+	// the Admin API.
+	ConfigProviderAdminAPI = "admin_api"
+	// ConfigProviderYAML means resources are rendered into the APISIX
+	// standalone config (apisix.yaml).
+	ConfigProviderYAML = "yaml"
+	// DefaultStandaloneConfigMapKey is the default key in the ConfigMap
+	// data whose value is the APISIX standalone config.
+	DefaultStandaloneConfigMapKey = "apisix.yaml"
 )
 
 var (
 	// Description information of API version, including default values and supported API version.
 	APIVersionDescribe = fmt.Sprintf(`the default value of API version is "%s", support "%s" and "%s".`, DefaultAPIVersion, ApisixV2beta3, ApisixV2)
+	// DriftResourceTypes are the APISIX resource types which drift detection
+	// supports to auto-correct and garbage collect.
+	DriftResourceTypes = []string{"route", "upstream", "ssl", "stream_route", "plugin_config", "global_rule", "service"}
 )
 
 // Config contains all config items which are necessary for
 // apisix-ingress-controller's running.
 type Config struct {
-	CertFilePath               string             `json:"cert_file" yaml:"cert_file"`
-	KeyFilePath                string             `json:"key_file" yaml:"key_file"`
-	LogLevel                   string             `json:"log_level" yaml:"log_level"`
-	LogOutput                  string             `json:"log_output" yaml:"log_output"`
-	LogRotateOutputPath        string             `json:"log_rotate_output_path" yaml:"log_rotate_output_path"`
-	LogRotationMaxSize         int                `json:"log_rotation_max_size" yaml:"log_rotation_max_size"`
-	LogRotationMaxAge          int                `json:"log_rotation_max_age" yaml:"log_rotation_max_age"`
-	LogRotationMaxBackups      int                `json:"log_rotation_max_backups" yaml:"log_rotation_max_backups"`
-	HTTPListen                 string             `json:"http_listen" yaml:"http_listen"`
-	HTTPSListen                string             `json:"https_listen" yaml:"https_listen"`
-	IngressPublishService      string             `json:"ingress_publish_service" yaml:"ingress_publish_service"`
-	IngressStatusAddress       []string           `json:"ingress_status_address" yaml:"ingress_status_address"`
-	EnableProfiling            bool               `json:"enable_profiling" yaml:"enable_profiling"`
-	Kubernetes                 KubernetesConfig   `json:"kubernetes" yaml:"kubernetes"`
-	APISIX                     APISIXConfig       `json:"apisix" yaml:"apisix"`
-	ApisixResourceSyncInterval types.TimeDuration `json:"apisix-resource-sync-interval" yaml:"apisix-resource-sync-interval"`
-	PluginMetadataConfigMap    string             `json:"plugin_metadata_cm" yaml:"plugin_metadata_cm"`
+	CertFilePath               string               `json:"cert_file" yaml:"cert_file"`
+	KeyFilePath                string               `json:"key_file" yaml:"key_file"`
+	LogLevel                   string               `json:"log_level" yaml:"log_level"`
+	LogOutput                  string               `json:"log_output" yaml:"log_output"`
+	LogRotateOutputPath        string               `json:"log_rotate_output_path" yaml:"log_rotate_output_path"`
+	LogRotationMaxSize         int                  `json:"log_rotation_max_size" yaml:"log_rotation_max_size"`
+	LogRotationMaxAge          int                  `json:"log_rotation_max_age" yaml:"log_rotation_max_age"`
+	LogRotationMaxBackups      int                  `json:"log_rotation_max_backups" yaml:"log_rotation_max_backups"`
+	HTTPListen                 string               `json:"http_listen" yaml:"http_listen"`
+	HTTPSListen                string               `json:"https_listen" yaml:"https_listen"`
+	IngressPublishService      string               `json:"ingress_publish_service" yaml:"ingress_publish_service"`
+	IngressStatusAddress       []string             `json:"ingress_status_address" yaml:"ingress_status_address"`
+	EnableProfiling            bool                 `json:"enable_profiling" yaml:"enable_profiling"`
+	EnableDiff                 bool                 `json:"enable_diff" yaml:"enable_diff"`
+	Kubernetes                 KubernetesConfig     `json:"kubernetes" yaml:"kubernetes"`
+	APISIX                     APISIXConfig         `json:"apisix" yaml:"apisix"`
+	ApisixResourceSyncInterval types.TimeDuration   `json:"apisix-resource-sync-interval" yaml:"apisix-resource-sync-interval"`
+	PluginMetadataConfigMap    string               `json:"plugin_metadata_cm" yaml:"plugin_metadata_cm"`
+	DriftDetection             DriftDetectionConfig `json:"drift_detection" yaml:"drift_detection"`
 }
 
 // KubernetesConfig contains all Kubernetes related config items.
 type KubernetesConfig struct {
-	Kubeconfig           string             `json:"kubeconfig" yaml:"kubeconfig"`
-	ResyncInterval       types.TimeDuration `json:"resync_interval" yaml:"resync_interval"`
-	NamespaceSelector    []string           `json:"namespace_selector" yaml:"namespace_selector"`
-	ElectionID           string             `json:"election_id" yaml:"election_id"`
-	IngressClass         string             `json:"ingress_class" yaml:"ingress_class"`
-	IngressVersion       string             `json:"ingress_version" yaml:"ingress_version"`
-	WatchEndpointSlices  bool               `json:"watch_endpoint_slices" yaml:"watch_endpoint_slices"`
-	APIVersion           string             `json:"api_version" yaml:"api_version"`
-	EnableGatewayAPI     bool               `json:"enable_gateway_api" yaml:"enable_gateway_api"`
-	DisableStatusUpdates bool               `json:"disable_status_updates" yaml:"disable_status_updates"`
+	Kubeconfig             string             `json:"kubeconfig" yaml:"kubeconfig"`
+	ResyncInterval         types.TimeDuration `json:"resync_interval" yaml:"resync_interval"`
+	NamespaceSelector      []string           `json:"namespace_selector" yaml:"namespace_selector"`
+	ElectionID             string             `json:"election_id" yaml:"election_id"`
+	IngressClass           string             `json:"ingress_class" yaml:"ingress_class"`
+	IngressClassController string             `json:"ingress_class_controller" yaml:"ingress_class_controller"`
+	IngressVersion         string             `json:"ingress_version" yaml:"ingress_version"`
+	WatchEndpointSlices    bool               `json:"watch_endpoint_slices" yaml:"watch_endpoint_slices"`
+	APIVersion             string             `json:"api_version" yaml:"api_version"`
+	EnableGatewayAPI       bool               `json:"enable_gateway_api" yaml:"enable_gateway_api"`
+	DisableStatusUpdates   bool               `json:"disable_status_updates" yaml:"disable_status_updates"`
 }
 
 // APISIXConfig contains all APISIX related config items.
@@ -111,8 +135,82 @@ type APISIXConfig struct {
 	// DefaultClusterBaseURL is the base url configuration for the default cluster.
 	DefaultClusterBaseURL string `json:"default_cluster_base_url" yaml:"default_cluster_base_url"`
 	// DefaultClusterAdminKey is the admin key for the default cluster.
-	// TODO: Obsolete the plain way to specify admin_key, which is insecure.
+	// Deprecated: use DefaultClusterAdminKeySecret instead, the plain way
+	// to specify admin_key is insecure.
+	// Since it's rotated along with DefaultClusterAdminKeySecret, access it
+	// by GetDefaultClusterAdminKey and SetDefaultClusterAdminKey once the
+	// controller is running.
 	DefaultClusterAdminKey string `json:"default_cluster_admin_key" yaml:"default_cluster_admin_key"`
+	// DefaultClusterAdminKeySecret is the Secret, in the format of "namespace/name",
+	// which holds the admin key for the default cluster. It takes precedence over
+	// DefaultClusterAdminKey.
+	DefaultClusterAdminKeySecret string `json:"default_cluster_admin_key_secret" yaml:"default_cluster_admin_key_secret"`
+	// DefaultClusterAdminKeySecretKey is the key in DefaultClusterAdminKeySecret
+	// whose value is the admin key.
+	DefaultClusterAdminKeySecretKey string `json:"default_cluster_admin_key_secret_key" yaml:"default_cluster_admin_key_secret_key"`
+	// DefaultClusterCACertFile is the path of the PEM encoded CA bundle which
+	// is used to verify the certificate of the default cluster admin api.
+	DefaultClusterCACertFile string `json:"default_cluster_ca_cert_file" yaml:"default_cluster_ca_cert_file"`
+	// DefaultClusterClientCertFile and DefaultClusterClientKeyFile are the paths
+	// of the client certificate and private key for mutual TLS with the default
+	// cluster admin api.
+	DefaultClusterClientCertFile string `json:"default_cluster_client_cert_file" yaml:"default_cluster_client_cert_file"`
+	DefaultClusterClientKeyFile  string `json:"default_cluster_client_key_file" yaml:"default_cluster_client_key_file"`
+	// DefaultClusterTLSServerName is the server name used to verify the
+	// certificate of the default cluster admin api.
+	DefaultClusterTLSServerName string `json:"default_cluster_tls_server_name" yaml:"default_cluster_tls_server_name"`
+	// TransactionalSync makes the objects translated from a resource be applied
+	// as a whole, once an object failed to be applied, the already applied ones
+	// are rolled back.
+	TransactionalSync bool `json:"transactional_sync" yaml:"transactional_sync"`
+	// ConfigProvider is the way to deliver resources to the default cluster,
+	// can be "admin_api" or "yaml", "admin_api" is used if it's empty.
+	ConfigProvider string `json:"config_provider" yaml:"config_provider"`
+	// StandaloneConfigFile is the path of apisix.yaml to render, it's used
+	// when ConfigProvider is "yaml".
+	StandaloneConfigFile string `json:"standalone_config_file" yaml:"standalone_config_file"`
+	// StandaloneConfigMap is the ConfigMap, in the format of "namespace/name",
+	// to render apisix.yaml into, it takes precedence over StandaloneConfigFile.
+	StandaloneConfigMap string `json:"standalone_config_map" yaml:"standalone_config_map"`
+	// StandaloneConfigMapKey is the key in StandaloneConfigMap.
+	StandaloneConfigMapKey string `json:"standalone_config_map_key" yaml:"standalone_config_map_key"`
+	// DisableGarbageCollection disables deleting the objects which are owned
+	// by the controller instance (identified by the election id) but whose
+	// owner resources no longer exist.
+	DisableGarbageCollection bool `json:"disable_garbage_collection" yaml:"disable_garbage_collection"`
+
+	// adminKey is the rotated DefaultClusterAdminKey, it's stored atomically
+	// since the Secret controller rotates it while other goroutines are
+	// reading it.
+	adminKey atomic.Value
+}
+
+// DriftDetectionConfig contains the config items of detecting the APISIX
+// objects which are changed out-of-band (e.g. via the Admin API or dashboard).
+type DriftDetectionConfig struct {
+	// Interval is the interval of listing objects from APISIX and comparing
+	// them to the desired state, 0 disables drift detection.
+	Interval types.TimeDuration `json:"interval" yaml:"interval"`
+	// AutoCorrect is the resource types whose drifted objects are restored
+	// to the desired state, see DriftResourceTypes.
+	AutoCorrect []string `json:"auto_correct" yaml:"auto_correct"`
+	// GarbageCollect is the resource types whose orphaned objects, i.e. the
+	// ones labelled as managed by the controller but not translated from any
+	// resource, are deleted, see DriftResourceTypes.
+	GarbageCollect []string `json:"garbage_collect" yaml:"garbage_collect"`
+}
+
+// GetDefaultClusterAdminKey returns the admin key for the default cluster.
+func (cfg *APISIXConfig) GetDefaultClusterAdminKey() string {
+	if adminKey, ok := cfg.adminKey.Load().(string); ok {
+		return adminKey
+	}
+	return cfg.DefaultClusterAdminKey
+}
+
+// SetDefaultClusterAdminKey sets the admin key for the default cluster.
+func (cfg *APISIXConfig) SetDefaultClusterAdminKey(adminKey string) {
+	cfg.adminKey.Store(adminKey)
 }
 
 // NewDefaultConfig creates a Config object which fills all config items with
@@ -134,15 +232,16 @@ func NewDefaultConfig() *Config {
 		EnableProfiling:            true,
 		ApisixResourceSyncInterval: types.TimeDuration{Duration: 300 * time.Second},
 		Kubernetes: KubernetesConfig{
-			Kubeconfig:           "", // Use in-cluster configurations.
-			ResyncInterval:       types.TimeDuration{Duration: 6 * time.Hour},
-			ElectionID:           IngressAPISIXLeader,
-			IngressClass:         IngressClass,
-			IngressVersion:       IngressNetworkingV1,
-			APIVersion:           DefaultAPIVersion,
-			WatchEndpointSlices:  false,
-			EnableGatewayAPI:     false,
-			DisableStatusUpdates: false,
+			Kubeconfig:             "", // Use in-cluster configurations.
+			ResyncInterval:         types.TimeDuration{Duration: 6 * time.Hour},
+			ElectionID:             IngressAPISIXLeader,
+			IngressClass:           IngressClass,
+			IngressClassController: IngressClassController,
+			IngressVersion:         IngressNetworkingV1,
+			APIVersion:             DefaultAPIVersion,
+			WatchEndpointSlices:    false,
+			EnableGatewayAPI:       false,
+			DisableStatusUpdates:   false,
 		},
 		APISIX: APISIXConfig{
 			AdminAPIVersion:    "v2",
@@ -198,8 +297,38 @@ func (cfg *Config) Validate() error {
 	if cfg.APISIX.DefaultClusterName == "" {
 		cfg.APISIX.DefaultClusterName = "default"
 	}
-	if cfg.APISIX.DefaultClusterBaseURL == "" {
-		return errors.New("apisix base url is required")
+	switch cfg.APISIX.ConfigProvider {
+	case "", ConfigProviderAdminAPI:
+		if cfg.APISIX.DefaultClusterBaseURL == "" {
+			return errors.New("apisix base url is required")
+		}
+	case ConfigProviderYAML:
+		if cfg.APISIX.StandaloneConfigMap == "" && cfg.APISIX.StandaloneConfigFile == "" {
+			return errors.New("apisix standalone config file or config map is required")
+		}
+		if cfg.APISIX.StandaloneConfigMap != "" {
+			parts := strings.Split(cfg.APISIX.StandaloneConfigMap, "/")
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return fmt.Errorf("invalid standalone config map %s, should be in the format of namespace/name", cfg.APISIX.StandaloneConfigMap)
+			}
+			if cfg.APISIX.StandaloneConfigMapKey == "" {
+				cfg.APISIX.StandaloneConfigMapKey = DefaultStandaloneConfigMapKey
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported apisix config provider %s", cfg.APISIX.ConfigProvider)
+	}
+	if cfg.APISIX.DefaultClusterAdminKeySecret != "" {
+		parts := strings.Split(cfg.APISIX.DefaultClusterAdminKeySecret, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("invalid admin key secret %s, should be in the format of namespace/name", cfg.APISIX.DefaultClusterAdminKeySecret)
+		}
+		if cfg.APISIX.DefaultClusterAdminKeySecretKey == "" {
+			cfg.APISIX.DefaultClusterAdminKeySecretKey = DefaultAdminKeySecretKey
+		}
+	}
+	if (cfg.APISIX.DefaultClusterClientCertFile == "") != (cfg.APISIX.DefaultClusterClientKeyFile == "") {
+		return errors.New("apisix client cert file and client key file should be specified together")
 	}
 	switch cfg.Kubernetes.IngressVersion {
 	case IngressNetworkingV1, IngressNetworkingV1beta1, IngressExtensionsV1beta1:
@@ -207,6 +336,12 @@ func (cfg *Config) Validate() error {
 	default:
 		return errors.New("unsupported ingress version")
 	}
+	if err := verifyDriftResourceTypes(cfg.DriftDetection.AutoCorrect); err != nil {
+		return err
+	}
+	if err := verifyDriftResourceTypes(cfg.DriftDetection.GarbageCollect); err != nil {
+		return err
+	}
 	ok, err := cfg.verifyNamespaceSelector()
 	if !ok {
 		return err
@@ -214,6 +349,22 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+func verifyDriftResourceTypes(resourceTypes []string) error {
+	for _, rt := range resourceTypes {
+		valid := false
+		for _, supported := range DriftResourceTypes {
+			if rt == supported {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("unsupported drift detection resource type %s", rt)
+		}
+	}
+	return nil
+}
+
 func (cfg *Config) verifyNamespaceSelector() (bool, error) {
 	labels := cfg.Kubernetes.NamespaceSelector
 	// default is [""]
@@ -42,13 +42,14 @@ func TestNewConfigFromFile(t *testing.T) {
 		EnableProfiling:            true,
 		ApisixResourceSyncInterval: types.TimeDuration{Duration: 200 * time.Second},
 		Kubernetes: KubernetesConfig{
-			ResyncInterval:       types.TimeDuration{Duration: time.Hour},
-			Kubeconfig:           "/path/to/foo/baz",
-			ElectionID:           "my-election-id",
-			IngressClass:         IngressClass,
-			IngressVersion:       IngressNetworkingV1,
-			APIVersion:           DefaultAPIVersion,
-			DisableStatusUpdates: true,
+			ResyncInterval:         types.TimeDuration{Duration: time.Hour},
+			Kubeconfig:             "/path/to/foo/baz",
+			ElectionID:             "my-election-id",
+			IngressClass:           IngressClass,
+			IngressClassController: IngressClassController,
+			IngressVersion:         IngressNetworkingV1,
+			APIVersion:             DefaultAPIVersion,
+			DisableStatusUpdates:   true,
 		},
 		APISIX: APISIXConfig{
 			AdminAPIVersion:        "v2",
@@ -134,13 +135,14 @@ func TestConfigWithEnvVar(t *testing.T) {
 		EnableProfiling:            true,
 		ApisixResourceSyncInterval: types.TimeDuration{Duration: 200 * time.Second},
 		Kubernetes: KubernetesConfig{
-			ResyncInterval:       types.TimeDuration{Duration: time.Hour},
-			Kubeconfig:           "",
-			ElectionID:           "my-election-id",
-			IngressClass:         IngressClass,
-			IngressVersion:       IngressNetworkingV1,
-			APIVersion:           DefaultAPIVersion,
-			DisableStatusUpdates: true,
+			ResyncInterval:         types.TimeDuration{Duration: time.Hour},
+			Kubeconfig:             "",
+			ElectionID:             "my-election-id",
+			IngressClass:           IngressClass,
+			IngressClassController: IngressClassController,
+			IngressVersion:         IngressNetworkingV1,
+			APIVersion:             DefaultAPIVersion,
+			DisableStatusUpdates:   true,
 		},
 		APISIX: APISIXConfig{
 			AdminAPIVersion:        "v2",
@@ -306,3 +308,64 @@ apisix:
 	assert.NotNil(t, err)
 	assert.Equal(t, err.Error(), "controller resync interval too small", "bad error: ", err)
 }
+
+func TestConfigAdminKeySecret(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.APISIX.DefaultClusterBaseURL = "http://127.0.0.1:8080/apisix"
+	cfg.APISIX.DefaultClusterAdminKeySecret = "ingress-apisix/admin-key"
+	assert.Nil(t, cfg.Validate(), "failed to validate config")
+	assert.Equal(t, DefaultAdminKeySecretKey, cfg.APISIX.DefaultClusterAdminKeySecretKey)
+
+	cfg.APISIX.DefaultClusterAdminKeySecret = "admin-key"
+	err := cfg.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, "invalid admin key secret admin-key, should be in the format of namespace/name", err.Error())
+}
+
+func TestConfigDefaultClusterAdminKey(t *testing.T) {
+	cfg := NewDefaultConfig()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			cfg.APISIX.SetDefaultClusterAdminKey("rotated")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		key := cfg.APISIX.GetDefaultClusterAdminKey()
+		assert.Contains(t, []string{"", "rotated"}, key)
+	}
+	<-done
+	assert.Equal(t, "rotated", cfg.APISIX.GetDefaultClusterAdminKey())
+}
+
+func TestConfigStandalone(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.APISIX.ConfigProvider = ConfigProviderYAML
+	err := cfg.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, "apisix standalone config file or config map is required", err.Error())
+
+	// base url isn't required in standalone mode
+	cfg.APISIX.StandaloneConfigMap = "ingress-apisix/apisix-standalone"
+	assert.Nil(t, cfg.Validate(), "failed to validate config")
+	assert.Equal(t, DefaultStandaloneConfigMapKey, cfg.APISIX.StandaloneConfigMapKey)
+
+	cfg.APISIX.ConfigProvider = "etcd"
+	err = cfg.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, "unsupported apisix config provider etcd", err.Error())
+}
+
+func TestConfigDriftDetection(t *testing.T) {
+	cfg := NewDefaultConfig()
+	cfg.APISIX.DefaultClusterBaseURL = "http://127.0.0.1:8080/apisix"
+	cfg.DriftDetection.AutoCorrect = []string{"route", "upstream"}
+	cfg.DriftDetection.GarbageCollect = []string{"route"}
+	assert.Nil(t, cfg.Validate(), "failed to validate config")
+
+	cfg.DriftDetection.GarbageCollect = []string{"consumer"}
+	err := cfg.Validate()
+	assert.NotNil(t, err)
+	assert.Equal(t, "unsupported drift detection resource type consumer", err.Error())
+}
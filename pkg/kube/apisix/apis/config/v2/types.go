@@ -71,10 +71,14 @@ type ApisixRouteHTTP struct {
 	// Upstreams refer to ApisixUpstream CRD
 	Upstreams []ApisixRouteUpstreamReference `json:"upstreams,omitempty" yaml:"upstreams,omitempty"`
 
-	Websocket        bool                      `json:"websocket" yaml:"websocket"`
-	PluginConfigName string                    `json:"plugin_config_name,omitempty" yaml:"plugin_config_name,omitempty"`
-	Plugins          []ApisixRoutePlugin       `json:"plugins,omitempty" yaml:"plugins,omitempty"`
-	Authentication   ApisixRouteAuthentication `json:"authentication,omitempty" yaml:"authentication,omitempty"`
+	Websocket        bool   `json:"websocket" yaml:"websocket"`
+	PluginConfigName string `json:"plugin_config_name,omitempty" yaml:"plugin_config_name,omitempty"`
+	// ApisixServiceName is the name of the ApisixService, in the same namespace,
+	// whose upstream and plugins are shared by the route. Backends and Upstreams
+	// can be omitted if it's specified.
+	ApisixServiceName string                    `json:"apisix_service_name,omitempty" yaml:"apisix_service_name,omitempty"`
+	Plugins           []ApisixRoutePlugin       `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	Authentication    ApisixRouteAuthentication `json:"authentication,omitempty" yaml:"authentication,omitempty"`
 }
 
 // ApisixRouteHTTPBackend represents an HTTP backend (a Kubernetes Service).
@@ -176,6 +180,36 @@ type ApisixRoutePlugin struct {
 	Config ApisixRoutePluginConfig `json:"config" yaml:"config"`
 	// Plugin configuration secretRef.
 	SecretRef string `json:"secretRef" yaml:"secretRef"`
+	// SecretKeyRefs injects the values of Secret keys into the plugin
+	// configuration, they take precedence over Config and SecretRef.
+	// +optional
+	SecretKeyRefs []ApisixRoutePluginSecretKeyRef `json:"secretKeyRefs,omitempty" yaml:"secretKeyRefs,omitempty"`
+}
+
+// ApisixRoutePluginSecretKeyRef maps a key of the Secret to a field of the
+// plugin configuration.
+type ApisixRoutePluginSecretKeyRef struct {
+	// Name is the name of the Secret, it must be in the same namespace.
+	Name string `json:"name" yaml:"name"`
+	// Key is the key of the Secret data.
+	Key string `json:"key" yaml:"key"`
+	// Path is the dot separated path of the field in the plugin configuration,
+	// e.g. "session.secret", the missing intermediate objects are created.
+	Path string `json:"path" yaml:"path"`
+	// Type is the type the value is converted to, can be "string", "int",
+	// "bool" or "json", default is "string".
+	// +optional
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Provider is the name of the ApisixSecretProvider, in the same namespace.
+	// When it's set, the value isn't read from the Kubernetes Secret, a
+	// `$secret://` reference to the key Key of the secret Name stored in the
+	// secret manager is set instead, which is resolved by APISIX.
+	// +optional
+	Provider string `json:"provider,omitempty" yaml:"provider,omitempty"`
+	// Env is the name of the environment variable of APISIX. When it's set,
+	// a `$env://` reference is set instead, Name and Key are ignored.
+	// +optional
+	Env string `json:"env,omitempty" yaml:"env,omitempty"`
 }
 
 // ApisixRoutePluginConfig is the configuration for
@@ -330,8 +364,42 @@ type ApisixClusterAdminConfig struct {
 	BaseURL string `json:"baseURL" yaml:"baseURL"`
 	// AdminKey is used to verify the admin API user.
 	AdminKey string `json:"adminKey" yaml:"adminKey"`
+	// AdminKeySecretRef references the Secret which holds the admin key,
+	// it takes precedence over AdminKey.
+	// +optional
+	AdminKeySecretRef *ApisixSecretKeyRef `json:"adminKeySecretRef,omitempty" yaml:"adminKeySecretRef,omitempty"`
 	// ClientTimeout is request timeout for the APISIX Admin API client
 	ClientTimeout types.TimeDuration `json:"clientTimeout" yaml:"clientTimeout"`
+	// TLS is the TLS configuration for the APISIX Admin API client.
+	// +optional
+	TLS *ApisixClusterAdminTLSConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+// ApisixClusterAdminTLSConfig describes how to verify the APISIX Admin API
+// certificate and which client certificate to present.
+type ApisixClusterAdminTLSConfig struct {
+	// CASecret references the Secret which holds the CA bundle used to
+	// verify the Admin API certificate.
+	// +optional
+	CASecret *ApisixSecret `json:"caSecret,omitempty" yaml:"caSecret,omitempty"`
+	// ClientCertSecret references the Secret which holds the client
+	// certificate and private key for mutual TLS.
+	// +optional
+	ClientCertSecret *ApisixSecret `json:"clientCertSecret,omitempty" yaml:"clientCertSecret,omitempty"`
+	// ServerName is used to verify the hostname of the Admin API certificate.
+	// +optional
+	ServerName string `json:"serverName,omitempty" yaml:"serverName,omitempty"`
+}
+
+// ApisixSecretKeyRef references a key of the Secret.
+type ApisixSecretKeyRef struct {
+	// Namespace is the namespace of the Secret.
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Name is the name of the Secret.
+	Name string `json:"name" yaml:"name"`
+	// Key is the key in the Secret data, "admin-key" is used if it's empty.
+	// +optional
+	Key string `json:"key,omitempty" yaml:"key,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -358,6 +426,15 @@ type ApisixConsumer struct {
 // ApisixConsumerSpec defines the desired state of ApisixConsumer.
 type ApisixConsumerSpec struct {
 	AuthParameter ApisixConsumerAuthParameter `json:"authParameter" yaml:"authParameter"`
+	// GroupRef is the name of the ApisixConsumerGroup, in the same namespace,
+	// which the consumer belongs to.
+	GroupRef string `json:"groupRef,omitempty" yaml:"groupRef,omitempty"`
+	// SecretProvider is the name of the ApisixSecretProvider, in the same
+	// namespace. When it's set, the credentials of the authParameter with
+	// secretRef aren't read from the Kubernetes Secret, `$secret://`
+	// references to the secret with the same name stored in the secret
+	// manager are set instead.
+	SecretProvider string `json:"secretProvider,omitempty" yaml:"secretProvider,omitempty"`
 }
 
 type ApisixConsumerAuthParameter struct {
@@ -812,3 +889,236 @@ type ApisixGlobalRuleList struct {
 	metav1.ListMeta `json:"metadata" yaml:"metadata"`
 	Items           []ApisixGlobalRule `json:"items,omitempty" yaml:"items,omitempty"`
 }
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+
+// ApisixConsumerGroup is the Schema for the ApisixConsumerGroup resource.
+// An ApisixConsumerGroup is used to share a group of plugin configs among
+// consumers.
+type ApisixConsumerGroup struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata" yaml:"metadata"`
+
+	// Spec defines the desired state of ApisixConsumerGroupSpec.
+	Spec   ApisixConsumerGroupSpec `json:"spec" yaml:"spec"`
+	Status ApisixStatus            `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// ApisixConsumerGroupSpec defines the desired state of ApisixConsumerGroupSpec.
+type ApisixConsumerGroupSpec struct {
+	// Desc is the description of the consumer group.
+	Desc string `json:"desc,omitempty" yaml:"desc,omitempty"`
+	// Plugins contains a list of ApisixRoutePlugin
+	// +required
+	Plugins []ApisixRoutePlugin `json:"plugins" yaml:"plugins"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:generate=true
+
+// ApisixConsumerGroupList contains a list of ApisixConsumerGroup.
+type ApisixConsumerGroupList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata" yaml:"metadata"`
+	Items           []ApisixConsumerGroup `json:"items,omitempty" yaml:"items,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+
+// ApisixSecretProvider is the Schema for the ApisixSecretProvider resource.
+// An ApisixSecretProvider tells APISIX how to access the secret manager, so
+// that the credentials can be referred by `$secret://` references instead of
+// being inlined into the APISIX objects.
+type ApisixSecretProvider struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata" yaml:"metadata"`
+
+	// Spec defines the desired state of ApisixSecretProviderSpec.
+	Spec   ApisixSecretProviderSpec `json:"spec" yaml:"spec"`
+	Status ApisixStatus             `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// ApisixSecretProviderSpec defines the desired state of ApisixSecretProviderSpec.
+type ApisixSecretProviderSpec struct {
+	// Vault is the configuration of the HashiCorp Vault secret manager.
+	// +required
+	Vault *ApisixSecretProviderVault `json:"vault" yaml:"vault"`
+}
+
+// ApisixSecretProviderVault is the configuration of the HashiCorp Vault
+// secret manager.
+type ApisixSecretProviderVault struct {
+	// URI is the address of the Vault server.
+	URI string `json:"uri" yaml:"uri"`
+	// Prefix is the path prefix of the secrets, e.g. "kv/apisix".
+	Prefix string `json:"prefix" yaml:"prefix"`
+	// Token is the token to access Vault, it can also be a `$env://`
+	// reference.
+	// +optional
+	Token string `json:"token,omitempty" yaml:"token,omitempty"`
+	// TokenSecretRef refers to the key of the Secret, in the same namespace,
+	// which stores the token. It takes precedence over Token.
+	// +optional
+	TokenSecretRef *corev1.SecretKeySelector `json:"tokenSecretRef,omitempty" yaml:"tokenSecretRef,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:generate=true
+
+// ApisixSecretProviderList contains a list of ApisixSecretProvider.
+type ApisixSecretProviderList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata" yaml:"metadata"`
+	Items           []ApisixSecretProvider `json:"items,omitempty" yaml:"items,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+
+// ApisixService is the Schema for the ApisixService resource.
+// An ApisixService is translated into an APISIX service, which shares the
+// upstream and plugins among the routes referring to it.
+type ApisixService struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata" yaml:"metadata"`
+
+	// Spec defines the desired state of ApisixServiceSpec.
+	Spec   ApisixServiceSpec `json:"spec" yaml:"spec"`
+	Status ApisixStatus      `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// ApisixServiceSpec defines the desired state of ApisixServiceSpec.
+type ApisixServiceSpec struct {
+	// Desc is the description of the service.
+	Desc string `json:"desc,omitempty" yaml:"desc,omitempty"`
+	// Hosts are the hosts matched by the routes which don't specify their own.
+	// +optional
+	Hosts []string `json:"hosts,omitempty" yaml:"hosts,omitempty"`
+	// Backend is the Kubernetes Service which the requests are proxied to,
+	// the Weight of it is ignored.
+	// +required
+	Backend ApisixRouteHTTPBackend `json:"backend" yaml:"backend"`
+	// Websocket enables the websocket proxy for the routes.
+	// +optional
+	Websocket bool `json:"websocket,omitempty" yaml:"websocket,omitempty"`
+	// Plugins contains a list of ApisixRoutePlugin, which are merged into
+	// the plugins of the routes.
+	// +optional
+	Plugins []ApisixRoutePlugin `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:generate=true
+
+// ApisixServiceList contains a list of ApisixService.
+type ApisixServiceList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata" yaml:"metadata"`
+	Items           []ApisixService `json:"items,omitempty" yaml:"items,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+
+// ApisixProto is the Schema for the ApisixProto resource.
+// An ApisixProto is translated into an APISIX proto, which is referred by
+// the grpc-transcode plugin.
+type ApisixProto struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata" yaml:"metadata"`
+
+	// Spec defines the desired state of ApisixProtoSpec.
+	Spec   ApisixProtoSpec `json:"spec" yaml:"spec"`
+	Status ApisixStatus    `json:"status,omitempty" yaml:"status,omitempty"`
+}
+
+// ApisixProtoSpec defines the desired state of ApisixProtoSpec.
+type ApisixProtoSpec struct {
+	// Desc is the description of the proto.
+	Desc string `json:"desc,omitempty" yaml:"desc,omitempty"`
+	// Content is the protobuf definition, it's exclusive with ConfigMapRef.
+	// +optional
+	Content string `json:"content,omitempty" yaml:"content,omitempty"`
+	// ConfigMapRef refers to a key of a ConfigMap, in the same namespace,
+	// which contains the protobuf definition.
+	// +optional
+	ConfigMapRef *ApisixProtoConfigMapRef `json:"configMapRef,omitempty" yaml:"configMapRef,omitempty"`
+}
+
+// ApisixProtoConfigMapRef refers to a key of a ConfigMap.
+type ApisixProtoConfigMapRef struct {
+	// Name is the name of the ConfigMap.
+	Name string `json:"name" yaml:"name"`
+	// Key is the key in the ConfigMap data.
+	Key string `json:"key" yaml:"key"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:generate=true
+
+// ApisixProtoList contains a list of ApisixProto.
+type ApisixProtoList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata" yaml:"metadata"`
+	Items           []ApisixProto `json:"items,omitempty" yaml:"items,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ApisixIngressClassConfig is the Schema for the ApisixIngressClassConfig resource.
+// An ApisixIngressClassConfig is referred by the parameters of IngressClasses,
+// it sets the defaults for the Ingresses of these classes. It's a ClusterScoped
+// resource.
+type ApisixIngressClassConfig struct {
+	metav1.TypeMeta   `json:",inline" yaml:",inline"`
+	metav1.ObjectMeta `json:"metadata" yaml:"metadata"`
+
+	// Spec defines the desired state of ApisixIngressClassConfigSpec.
+	Spec ApisixIngressClassConfigSpec `json:"spec" yaml:"spec"`
+}
+
+// ApisixIngressClassConfigSpec defines the desired state of ApisixIngressClassConfigSpec.
+type ApisixIngressClassConfigSpec struct {
+	// Cluster is the name of the APISIX cluster which the Ingresses are synced
+	// to, it should be the default cluster or a cluster registered by an
+	// ApisixClusterConfig. The default cluster is used if it's empty.
+	// +optional
+	Cluster string `json:"cluster,omitempty" yaml:"cluster,omitempty"`
+	// Plugins are enabled on all routes of the Ingresses, the plugins
+	// configured by the annotations of an Ingress take precedence.
+	// +optional
+	Plugins []ApisixIngressClassPlugin `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	// UpstreamScheme is the default scheme of the upstreams, the
+	// "k8s.apisix.apache.org/upstream-scheme" annotation takes precedence.
+	// +optional
+	UpstreamScheme string `json:"upstreamScheme,omitempty" yaml:"upstreamScheme,omitempty"`
+}
+
+// ApisixIngressClassPlugin is a plugin enabled by default.
+type ApisixIngressClassPlugin struct {
+	// The plugin name.
+	Name string `json:"name" yaml:"name"`
+	// Whether this plugin is in use, default is true.
+	Enable bool `json:"enable" yaml:"enable"`
+	// Plugin configuration.
+	Config ApisixRoutePluginConfig `json:"config" yaml:"config"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:generate=true
+
+// ApisixIngressClassConfigList contains a list of ApisixIngressClassConfig.
+type ApisixIngressClassConfigList struct {
+	metav1.TypeMeta `json:",inline" yaml:",inline"`
+	metav1.ListMeta `json:"metadata" yaml:"metadata"`
+	Items           []ApisixIngressClassConfig `json:"items,omitempty" yaml:"items,omitempty"`
+}
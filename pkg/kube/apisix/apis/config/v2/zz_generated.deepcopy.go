@@ -101,7 +101,17 @@ func (in *ActiveHealthCheckUnhealthy) DeepCopy() *ActiveHealthCheckUnhealthy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixClusterAdminConfig) DeepCopyInto(out *ApisixClusterAdminConfig) {
 	*out = *in
+	if in.AdminKeySecretRef != nil {
+		in, out := &in.AdminKeySecretRef, &out.AdminKeySecretRef
+		*out = new(ApisixSecretKeyRef)
+		**out = **in
+	}
 	out.ClientTimeout = in.ClientTimeout
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ApisixClusterAdminTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -115,6 +125,32 @@ func (in *ApisixClusterAdminConfig) DeepCopy() *ApisixClusterAdminConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixClusterAdminTLSConfig) DeepCopyInto(out *ApisixClusterAdminTLSConfig) {
+	*out = *in
+	if in.CASecret != nil {
+		in, out := &in.CASecret, &out.CASecret
+		*out = new(ApisixSecret)
+		**out = **in
+	}
+	if in.ClientCertSecret != nil {
+		in, out := &in.ClientCertSecret, &out.ClientCertSecret
+		*out = new(ApisixSecret)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixClusterAdminTLSConfig.
+func (in *ApisixClusterAdminTLSConfig) DeepCopy() *ApisixClusterAdminTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixClusterAdminTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixClusterConfig) DeepCopyInto(out *ApisixClusterConfig) {
 	*out = *in
@@ -187,7 +223,7 @@ func (in *ApisixClusterConfigSpec) DeepCopyInto(out *ApisixClusterConfigSpec) {
 	if in.Admin != nil {
 		in, out := &in.Admin, &out.Admin
 		*out = new(ApisixClusterAdminConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -368,6 +404,90 @@ func (in *ApisixConsumerBasicAuthValue) DeepCopy() *ApisixConsumerBasicAuthValue
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixConsumerGroup) DeepCopyInto(out *ApisixConsumerGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixConsumerGroup.
+func (in *ApisixConsumerGroup) DeepCopy() *ApisixConsumerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixConsumerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixConsumerGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixConsumerGroupList) DeepCopyInto(out *ApisixConsumerGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApisixConsumerGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixConsumerGroupList.
+func (in *ApisixConsumerGroupList) DeepCopy() *ApisixConsumerGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixConsumerGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixConsumerGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixConsumerGroupSpec) DeepCopyInto(out *ApisixConsumerGroupSpec) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]ApisixRoutePlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixConsumerGroupSpec.
+func (in *ApisixConsumerGroupSpec) DeepCopy() *ApisixConsumerGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixConsumerGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixConsumerHMACAuth) DeepCopyInto(out *ApisixConsumerHMACAuth) {
 	*out = *in
@@ -717,6 +837,106 @@ func (in *ApisixGlobalRuleSpec) DeepCopy() *ApisixGlobalRuleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixIngressClassConfig) DeepCopyInto(out *ApisixIngressClassConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixIngressClassConfig.
+func (in *ApisixIngressClassConfig) DeepCopy() *ApisixIngressClassConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixIngressClassConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixIngressClassConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixIngressClassConfigList) DeepCopyInto(out *ApisixIngressClassConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApisixIngressClassConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixIngressClassConfigList.
+func (in *ApisixIngressClassConfigList) DeepCopy() *ApisixIngressClassConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixIngressClassConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixIngressClassConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixIngressClassConfigSpec) DeepCopyInto(out *ApisixIngressClassConfigSpec) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]ApisixIngressClassPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixIngressClassConfigSpec.
+func (in *ApisixIngressClassConfigSpec) DeepCopy() *ApisixIngressClassConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixIngressClassConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixIngressClassPlugin) DeepCopyInto(out *ApisixIngressClassPlugin) {
+	*out = *in
+	in.Config.DeepCopyInto(&out.Config)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixIngressClassPlugin.
+func (in *ApisixIngressClassPlugin) DeepCopy() *ApisixIngressClassPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixIngressClassPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixMutualTlsClientConfig) DeepCopyInto(out *ApisixMutualTlsClientConfig) {
 	*out = *in
@@ -818,6 +1038,104 @@ func (in *ApisixPluginConfigSpec) DeepCopy() *ApisixPluginConfigSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixProto) DeepCopyInto(out *ApisixProto) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixProto.
+func (in *ApisixProto) DeepCopy() *ApisixProto {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixProto)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixProto) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixProtoConfigMapRef) DeepCopyInto(out *ApisixProtoConfigMapRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixProtoConfigMapRef.
+func (in *ApisixProtoConfigMapRef) DeepCopy() *ApisixProtoConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixProtoConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixProtoList) DeepCopyInto(out *ApisixProtoList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApisixProto, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixProtoList.
+func (in *ApisixProtoList) DeepCopy() *ApisixProtoList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixProtoList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixProtoList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixProtoSpec) DeepCopyInto(out *ApisixProtoSpec) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ApisixProtoConfigMapRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixProtoSpec.
+func (in *ApisixProtoSpec) DeepCopy() *ApisixProtoSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixProtoSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixRoute) DeepCopyInto(out *ApisixRoute) {
 	*out = *in
@@ -1102,6 +1420,11 @@ func (in *ApisixRouteList) DeepCopyObject() runtime.Object {
 func (in *ApisixRoutePlugin) DeepCopyInto(out *ApisixRoutePlugin) {
 	*out = *in
 	in.Config.DeepCopyInto(&out.Config)
+	if in.SecretKeyRefs != nil {
+		in, out := &in.SecretKeyRefs, &out.SecretKeyRefs
+		*out = make([]ApisixRoutePluginSecretKeyRef, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1115,6 +1438,22 @@ func (in *ApisixRoutePlugin) DeepCopy() *ApisixRoutePlugin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixRoutePluginSecretKeyRef) DeepCopyInto(out *ApisixRoutePluginSecretKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixRoutePluginSecretKeyRef.
+func (in *ApisixRoutePluginSecretKeyRef) DeepCopy() *ApisixRoutePluginSecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixRoutePluginSecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixRouteSpec) DeepCopyInto(out *ApisixRouteSpec) {
 	*out = *in
@@ -1240,6 +1579,215 @@ func (in *ApisixSecret) DeepCopy() *ApisixSecret {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixSecretKeyRef) DeepCopyInto(out *ApisixSecretKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixSecretKeyRef.
+func (in *ApisixSecretKeyRef) DeepCopy() *ApisixSecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixSecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixSecretProvider) DeepCopyInto(out *ApisixSecretProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixSecretProvider.
+func (in *ApisixSecretProvider) DeepCopy() *ApisixSecretProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixSecretProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixSecretProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixSecretProviderList) DeepCopyInto(out *ApisixSecretProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApisixSecretProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixSecretProviderList.
+func (in *ApisixSecretProviderList) DeepCopy() *ApisixSecretProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixSecretProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixSecretProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixSecretProviderSpec) DeepCopyInto(out *ApisixSecretProviderSpec) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(ApisixSecretProviderVault)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixSecretProviderSpec.
+func (in *ApisixSecretProviderSpec) DeepCopy() *ApisixSecretProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixSecretProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixSecretProviderVault) DeepCopyInto(out *ApisixSecretProviderVault) {
+	*out = *in
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixSecretProviderVault.
+func (in *ApisixSecretProviderVault) DeepCopy() *ApisixSecretProviderVault {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixSecretProviderVault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixService) DeepCopyInto(out *ApisixService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixService.
+func (in *ApisixService) DeepCopy() *ApisixService {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixServiceList) DeepCopyInto(out *ApisixServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApisixService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixServiceList.
+func (in *ApisixServiceList) DeepCopy() *ApisixServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApisixServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixServiceSpec) DeepCopyInto(out *ApisixServiceSpec) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Backend.DeepCopyInto(&out.Backend)
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]ApisixRoutePlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixServiceSpec.
+func (in *ApisixServiceSpec) DeepCopy() *ApisixServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixStatus) DeepCopyInto(out *ApisixStatus) {
 	*out = *in
@@ -63,13 +63,23 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ApisixClusterConfig{},
 		&ApisixClusterConfigList{},
 		&ApisixConsumer{},
+		&ApisixConsumerGroup{},
+		&ApisixConsumerGroupList{},
 		&ApisixConsumerList{},
 		&ApisixGlobalRule{},
 		&ApisixGlobalRuleList{},
+		&ApisixIngressClassConfig{},
+		&ApisixIngressClassConfigList{},
 		&ApisixPluginConfig{},
 		&ApisixPluginConfigList{},
+		&ApisixProto{},
+		&ApisixProtoList{},
 		&ApisixRoute{},
 		&ApisixRouteList{},
+		&ApisixSecretProvider{},
+		&ApisixSecretProviderList{},
+		&ApisixService{},
+		&ApisixServiceList{},
 		&ApisixTls{},
 		&ApisixTlsList{},
 		&ApisixUpstream{},
@@ -305,8 +305,42 @@ type ApisixClusterAdminConfig struct {
 	BaseURL string `json:"baseURL" yaml:"baseURL"`
 	// AdminKey is used to verify the admin API user.
 	AdminKey string `json:"adminKey" yaml:"adminKey"`
+	// AdminKeySecretRef references the Secret which holds the admin key,
+	// it takes precedence over AdminKey.
+	// +optional
+	AdminKeySecretRef *ApisixSecretKeyRef `json:"adminKeySecretRef,omitempty" yaml:"adminKeySecretRef,omitempty"`
 	// ClientTimeout is request timeout for the APISIX Admin API client
 	ClientTimeout types.TimeDuration `json:"clientTimeout" yaml:"clientTimeout"`
+	// TLS is the TLS configuration for the APISIX Admin API client.
+	// +optional
+	TLS *ApisixClusterAdminTLSConfig `json:"tls,omitempty" yaml:"tls,omitempty"`
+}
+
+// ApisixClusterAdminTLSConfig describes how to verify the APISIX Admin API
+// certificate and which client certificate to present.
+type ApisixClusterAdminTLSConfig struct {
+	// CASecret references the Secret which holds the CA bundle used to
+	// verify the Admin API certificate.
+	// +optional
+	CASecret *ApisixSecret `json:"caSecret,omitempty" yaml:"caSecret,omitempty"`
+	// ClientCertSecret references the Secret which holds the client
+	// certificate and private key for mutual TLS.
+	// +optional
+	ClientCertSecret *ApisixSecret `json:"clientCertSecret,omitempty" yaml:"clientCertSecret,omitempty"`
+	// ServerName is used to verify the hostname of the Admin API certificate.
+	// +optional
+	ServerName string `json:"serverName,omitempty" yaml:"serverName,omitempty"`
+}
+
+// ApisixSecretKeyRef references a key of the Secret.
+type ApisixSecretKeyRef struct {
+	// Namespace is the namespace of the Secret.
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Name is the name of the Secret.
+	Name string `json:"name" yaml:"name"`
+	// Key is the key in the Secret data, "admin-key" is used if it's empty.
+	// +optional
+	Key string `json:"key,omitempty" yaml:"key,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
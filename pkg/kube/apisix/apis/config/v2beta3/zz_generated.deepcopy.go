@@ -102,7 +102,17 @@ func (in *ActiveHealthCheckUnhealthy) DeepCopy() *ActiveHealthCheckUnhealthy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixClusterAdminConfig) DeepCopyInto(out *ApisixClusterAdminConfig) {
 	*out = *in
+	if in.AdminKeySecretRef != nil {
+		in, out := &in.AdminKeySecretRef, &out.AdminKeySecretRef
+		*out = new(ApisixSecretKeyRef)
+		**out = **in
+	}
 	out.ClientTimeout = in.ClientTimeout
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ApisixClusterAdminTLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -116,6 +126,32 @@ func (in *ApisixClusterAdminConfig) DeepCopy() *ApisixClusterAdminConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixClusterAdminTLSConfig) DeepCopyInto(out *ApisixClusterAdminTLSConfig) {
+	*out = *in
+	if in.CASecret != nil {
+		in, out := &in.CASecret, &out.CASecret
+		*out = new(ApisixSecret)
+		**out = **in
+	}
+	if in.ClientCertSecret != nil {
+		in, out := &in.ClientCertSecret, &out.ClientCertSecret
+		*out = new(ApisixSecret)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixClusterAdminTLSConfig.
+func (in *ApisixClusterAdminTLSConfig) DeepCopy() *ApisixClusterAdminTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixClusterAdminTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixClusterConfig) DeepCopyInto(out *ApisixClusterConfig) {
 	*out = *in
@@ -188,7 +224,7 @@ func (in *ApisixClusterConfigSpec) DeepCopyInto(out *ApisixClusterConfigSpec) {
 	if in.Admin != nil {
 		in, out := &in.Admin, &out.Admin
 		*out = new(ApisixClusterAdminConfig)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1056,6 +1092,22 @@ func (in *ApisixSecret) DeepCopy() *ApisixSecret {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApisixSecretKeyRef) DeepCopyInto(out *ApisixSecretKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApisixSecretKeyRef.
+func (in *ApisixSecretKeyRef) DeepCopy() *ApisixSecretKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ApisixSecretKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApisixStatus) DeepCopyInto(out *ApisixStatus) {
 	*out = *in
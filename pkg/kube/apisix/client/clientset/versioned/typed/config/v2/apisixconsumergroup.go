@@ -0,0 +1,194 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	"time"
+
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	scheme "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ApisixConsumerGroupsGetter has a method to return a ApisixConsumerGroupInterface.
+// A group's client should implement this interface.
+type ApisixConsumerGroupsGetter interface {
+	ApisixConsumerGroups(namespace string) ApisixConsumerGroupInterface
+}
+
+// ApisixConsumerGroupInterface has methods to work with ApisixConsumerGroup resources.
+type ApisixConsumerGroupInterface interface {
+	Create(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.CreateOptions) (*v2.ApisixConsumerGroup, error)
+	Update(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.UpdateOptions) (*v2.ApisixConsumerGroup, error)
+	UpdateStatus(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.UpdateOptions) (*v2.ApisixConsumerGroup, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v2.ApisixConsumerGroup, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v2.ApisixConsumerGroupList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixConsumerGroup, err error)
+	ApisixConsumerGroupExpansion
+}
+
+// apisixConsumerGroups implements ApisixConsumerGroupInterface
+type apisixConsumerGroups struct {
+	client rest.Interface
+	ns     string
+}
+
+// newApisixConsumerGroups returns a ApisixConsumerGroups
+func newApisixConsumerGroups(c *ApisixV2Client, namespace string) *apisixConsumerGroups {
+	return &apisixConsumerGroups{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the apisixConsumerGroup, and returns the corresponding apisixConsumerGroup object, and an error if there is any.
+func (c *apisixConsumerGroups) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.ApisixConsumerGroup, err error) {
+	result = &v2.ApisixConsumerGroup{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ApisixConsumerGroups that match those selectors.
+func (c *apisixConsumerGroups) List(ctx context.Context, opts v1.ListOptions) (result *v2.ApisixConsumerGroupList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v2.ApisixConsumerGroupList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested apisixConsumerGroups.
+func (c *apisixConsumerGroups) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a apisixConsumerGroup and creates it.  Returns the server's representation of the apisixConsumerGroup, and an error, if there is any.
+func (c *apisixConsumerGroups) Create(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.CreateOptions) (result *v2.ApisixConsumerGroup, err error) {
+	result = &v2.ApisixConsumerGroup{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(apisixConsumerGroup).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a apisixConsumerGroup and updates it. Returns the server's representation of the apisixConsumerGroup, and an error, if there is any.
+func (c *apisixConsumerGroups) Update(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.UpdateOptions) (result *v2.ApisixConsumerGroup, err error) {
+	result = &v2.ApisixConsumerGroup{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		Name(apisixConsumerGroup.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(apisixConsumerGroup).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *apisixConsumerGroups) UpdateStatus(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.UpdateOptions) (result *v2.ApisixConsumerGroup, err error) {
+	result = &v2.ApisixConsumerGroup{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		Name(apisixConsumerGroup.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(apisixConsumerGroup).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the apisixConsumerGroup and deletes it. Returns an error if one occurs.
+func (c *apisixConsumerGroups) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *apisixConsumerGroups) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched apisixConsumerGroup.
+func (c *apisixConsumerGroups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixConsumerGroup, err error) {
+	result = &v2.ApisixConsumerGroup{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("apisixconsumergroups").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,167 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	"time"
+
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	scheme "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ApisixIngressClassConfigsGetter has a method to return a ApisixIngressClassConfigInterface.
+// A group's client should implement this interface.
+type ApisixIngressClassConfigsGetter interface {
+	ApisixIngressClassConfigs() ApisixIngressClassConfigInterface
+}
+
+// ApisixIngressClassConfigInterface has methods to work with ApisixIngressClassConfig resources.
+type ApisixIngressClassConfigInterface interface {
+	Create(ctx context.Context, apisixIngressClassConfig *v2.ApisixIngressClassConfig, opts v1.CreateOptions) (*v2.ApisixIngressClassConfig, error)
+	Update(ctx context.Context, apisixIngressClassConfig *v2.ApisixIngressClassConfig, opts v1.UpdateOptions) (*v2.ApisixIngressClassConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v2.ApisixIngressClassConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v2.ApisixIngressClassConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixIngressClassConfig, err error)
+	ApisixIngressClassConfigExpansion
+}
+
+// apisixIngressClassConfigs implements ApisixIngressClassConfigInterface
+type apisixIngressClassConfigs struct {
+	client rest.Interface
+}
+
+// newApisixIngressClassConfigs returns a ApisixIngressClassConfigs
+func newApisixIngressClassConfigs(c *ApisixV2Client) *apisixIngressClassConfigs {
+	return &apisixIngressClassConfigs{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the apisixIngressClassConfig, and returns the corresponding apisixIngressClassConfig object, and an error if there is any.
+func (c *apisixIngressClassConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.ApisixIngressClassConfig, err error) {
+	result = &v2.ApisixIngressClassConfig{}
+	err = c.client.Get().
+		Resource("apisixingressclassconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ApisixIngressClassConfigs that match those selectors.
+func (c *apisixIngressClassConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v2.ApisixIngressClassConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v2.ApisixIngressClassConfigList{}
+	err = c.client.Get().
+		Resource("apisixingressclassconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested apisixIngressClassConfigs.
+func (c *apisixIngressClassConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("apisixingressclassconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a apisixIngressClassConfig and creates it.  Returns the server's representation of the apisixIngressClassConfig, and an error, if there is any.
+func (c *apisixIngressClassConfigs) Create(ctx context.Context, apisixIngressClassConfig *v2.ApisixIngressClassConfig, opts v1.CreateOptions) (result *v2.ApisixIngressClassConfig, err error) {
+	result = &v2.ApisixIngressClassConfig{}
+	err = c.client.Post().
+		Resource("apisixingressclassconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(apisixIngressClassConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a apisixIngressClassConfig and updates it. Returns the server's representation of the apisixIngressClassConfig, and an error, if there is any.
+func (c *apisixIngressClassConfigs) Update(ctx context.Context, apisixIngressClassConfig *v2.ApisixIngressClassConfig, opts v1.UpdateOptions) (result *v2.ApisixIngressClassConfig, err error) {
+	result = &v2.ApisixIngressClassConfig{}
+	err = c.client.Put().
+		Resource("apisixingressclassconfigs").
+		Name(apisixIngressClassConfig.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(apisixIngressClassConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the apisixIngressClassConfig and deletes it. Returns an error if one occurs.
+func (c *apisixIngressClassConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("apisixingressclassconfigs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *apisixIngressClassConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("apisixingressclassconfigs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched apisixIngressClassConfig.
+func (c *apisixIngressClassConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixIngressClassConfig, err error) {
+	result = &v2.ApisixIngressClassConfig{}
+	err = c.client.Patch(pt).
+		Resource("apisixingressclassconfigs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,194 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	"time"
+
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	scheme "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ApisixProtosGetter has a method to return a ApisixProtoInterface.
+// A group's client should implement this interface.
+type ApisixProtosGetter interface {
+	ApisixProtos(namespace string) ApisixProtoInterface
+}
+
+// ApisixProtoInterface has methods to work with ApisixProto resources.
+type ApisixProtoInterface interface {
+	Create(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.CreateOptions) (*v2.ApisixProto, error)
+	Update(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.UpdateOptions) (*v2.ApisixProto, error)
+	UpdateStatus(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.UpdateOptions) (*v2.ApisixProto, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v2.ApisixProto, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v2.ApisixProtoList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixProto, err error)
+	ApisixProtoExpansion
+}
+
+// apisixProtos implements ApisixProtoInterface
+type apisixProtos struct {
+	client rest.Interface
+	ns     string
+}
+
+// newApisixProtos returns a ApisixProtos
+func newApisixProtos(c *ApisixV2Client, namespace string) *apisixProtos {
+	return &apisixProtos{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the apisixProto, and returns the corresponding apisixProto object, and an error if there is any.
+func (c *apisixProtos) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.ApisixProto, err error) {
+	result = &v2.ApisixProto{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ApisixProtos that match those selectors.
+func (c *apisixProtos) List(ctx context.Context, opts v1.ListOptions) (result *v2.ApisixProtoList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v2.ApisixProtoList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested apisixProtos.
+func (c *apisixProtos) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a apisixProto and creates it.  Returns the server's representation of the apisixProto, and an error, if there is any.
+func (c *apisixProtos) Create(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.CreateOptions) (result *v2.ApisixProto, err error) {
+	result = &v2.ApisixProto{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(apisixProto).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a apisixProto and updates it. Returns the server's representation of the apisixProto, and an error, if there is any.
+func (c *apisixProtos) Update(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.UpdateOptions) (result *v2.ApisixProto, err error) {
+	result = &v2.ApisixProto{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		Name(apisixProto.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(apisixProto).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *apisixProtos) UpdateStatus(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.UpdateOptions) (result *v2.ApisixProto, err error) {
+	result = &v2.ApisixProto{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		Name(apisixProto.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(apisixProto).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the apisixProto and deletes it. Returns an error if one occurs.
+func (c *apisixProtos) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *apisixProtos) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched apisixProto.
+func (c *apisixProtos) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixProto, err error) {
+	result = &v2.ApisixProto{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("apisixprotos").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
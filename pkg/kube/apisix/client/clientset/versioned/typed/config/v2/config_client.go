@@ -29,9 +29,14 @@ type ApisixV2Interface interface {
 	RESTClient() rest.Interface
 	ApisixClusterConfigsGetter
 	ApisixConsumersGetter
+	ApisixConsumerGroupsGetter
 	ApisixGlobalRulesGetter
+	ApisixIngressClassConfigsGetter
 	ApisixPluginConfigsGetter
+	ApisixProtosGetter
 	ApisixRoutesGetter
+	ApisixSecretProvidersGetter
+	ApisixServicesGetter
 	ApisixTlsesGetter
 	ApisixUpstreamsGetter
 }
@@ -49,18 +54,38 @@ func (c *ApisixV2Client) ApisixConsumers(namespace string) ApisixConsumerInterfa
 	return newApisixConsumers(c, namespace)
 }
 
+func (c *ApisixV2Client) ApisixConsumerGroups(namespace string) ApisixConsumerGroupInterface {
+	return newApisixConsumerGroups(c, namespace)
+}
+
 func (c *ApisixV2Client) ApisixGlobalRules(namespace string) ApisixGlobalRuleInterface {
 	return newApisixGlobalRules(c, namespace)
 }
 
+func (c *ApisixV2Client) ApisixIngressClassConfigs() ApisixIngressClassConfigInterface {
+	return newApisixIngressClassConfigs(c)
+}
+
 func (c *ApisixV2Client) ApisixPluginConfigs(namespace string) ApisixPluginConfigInterface {
 	return newApisixPluginConfigs(c, namespace)
 }
 
+func (c *ApisixV2Client) ApisixProtos(namespace string) ApisixProtoInterface {
+	return newApisixProtos(c, namespace)
+}
+
 func (c *ApisixV2Client) ApisixRoutes(namespace string) ApisixRouteInterface {
 	return newApisixRoutes(c, namespace)
 }
 
+func (c *ApisixV2Client) ApisixSecretProviders(namespace string) ApisixSecretProviderInterface {
+	return newApisixSecretProviders(c, namespace)
+}
+
+func (c *ApisixV2Client) ApisixServices(namespace string) ApisixServiceInterface {
+	return newApisixServices(c, namespace)
+}
+
 func (c *ApisixV2Client) ApisixTlses(namespace string) ApisixTlsInterface {
 	return newApisixTlses(c, namespace)
 }
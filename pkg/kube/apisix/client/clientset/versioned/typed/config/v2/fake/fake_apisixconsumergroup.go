@@ -0,0 +1,141 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeApisixConsumerGroups implements ApisixConsumerGroupInterface
+type FakeApisixConsumerGroups struct {
+	Fake *FakeApisixV2
+	ns   string
+}
+
+var apisixconsumergroupsResource = schema.GroupVersionResource{Group: "apisix.apache.org", Version: "v2", Resource: "apisixconsumergroups"}
+
+var apisixconsumergroupsKind = schema.GroupVersionKind{Group: "apisix.apache.org", Version: "v2", Kind: "ApisixConsumerGroup"}
+
+// Get takes name of the apisixConsumerGroup, and returns the corresponding apisixConsumerGroup object, and an error if there is any.
+func (c *FakeApisixConsumerGroups) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.ApisixConsumerGroup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(apisixconsumergroupsResource, c.ns, name), &v2.ApisixConsumerGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixConsumerGroup), err
+}
+
+// List takes label and field selectors, and returns the list of ApisixConsumerGroups that match those selectors.
+func (c *FakeApisixConsumerGroups) List(ctx context.Context, opts v1.ListOptions) (result *v2.ApisixConsumerGroupList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(apisixconsumergroupsResource, apisixconsumergroupsKind, c.ns, opts), &v2.ApisixConsumerGroupList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v2.ApisixConsumerGroupList{ListMeta: obj.(*v2.ApisixConsumerGroupList).ListMeta}
+	for _, item := range obj.(*v2.ApisixConsumerGroupList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested apisixConsumerGroups.
+func (c *FakeApisixConsumerGroups) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(apisixconsumergroupsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a apisixConsumerGroup and creates it.  Returns the server's representation of the apisixConsumerGroup, and an error, if there is any.
+func (c *FakeApisixConsumerGroups) Create(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.CreateOptions) (result *v2.ApisixConsumerGroup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(apisixconsumergroupsResource, c.ns, apisixConsumerGroup), &v2.ApisixConsumerGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixConsumerGroup), err
+}
+
+// Update takes the representation of a apisixConsumerGroup and updates it. Returns the server's representation of the apisixConsumerGroup, and an error, if there is any.
+func (c *FakeApisixConsumerGroups) Update(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.UpdateOptions) (result *v2.ApisixConsumerGroup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(apisixconsumergroupsResource, c.ns, apisixConsumerGroup), &v2.ApisixConsumerGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixConsumerGroup), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeApisixConsumerGroups) UpdateStatus(ctx context.Context, apisixConsumerGroup *v2.ApisixConsumerGroup, opts v1.UpdateOptions) (*v2.ApisixConsumerGroup, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(apisixconsumergroupsResource, "status", c.ns, apisixConsumerGroup), &v2.ApisixConsumerGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixConsumerGroup), err
+}
+
+// Delete takes name of the apisixConsumerGroup and deletes it. Returns an error if one occurs.
+func (c *FakeApisixConsumerGroups) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(apisixconsumergroupsResource, c.ns, name, opts), &v2.ApisixConsumerGroup{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeApisixConsumerGroups) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(apisixconsumergroupsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v2.ApisixConsumerGroupList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched apisixConsumerGroup.
+func (c *FakeApisixConsumerGroups) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixConsumerGroup, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apisixconsumergroupsResource, c.ns, name, pt, data, subresources...), &v2.ApisixConsumerGroup{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixConsumerGroup), err
+}
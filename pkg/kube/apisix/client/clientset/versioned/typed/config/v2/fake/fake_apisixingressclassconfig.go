@@ -0,0 +1,121 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeApisixIngressClassConfigs implements ApisixIngressClassConfigInterface
+type FakeApisixIngressClassConfigs struct {
+	Fake *FakeApisixV2
+}
+
+var apisixingressclassconfigsResource = schema.GroupVersionResource{Group: "apisix.apache.org", Version: "v2", Resource: "apisixingressclassconfigs"}
+
+var apisixingressclassconfigsKind = schema.GroupVersionKind{Group: "apisix.apache.org", Version: "v2", Kind: "ApisixIngressClassConfig"}
+
+// Get takes name of the apisixIngressClassConfig, and returns the corresponding apisixIngressClassConfig object, and an error if there is any.
+func (c *FakeApisixIngressClassConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.ApisixIngressClassConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(apisixingressclassconfigsResource, name), &v2.ApisixIngressClassConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixIngressClassConfig), err
+}
+
+// List takes label and field selectors, and returns the list of ApisixIngressClassConfigs that match those selectors.
+func (c *FakeApisixIngressClassConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v2.ApisixIngressClassConfigList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(apisixingressclassconfigsResource, apisixingressclassconfigsKind, opts), &v2.ApisixIngressClassConfigList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v2.ApisixIngressClassConfigList{ListMeta: obj.(*v2.ApisixIngressClassConfigList).ListMeta}
+	for _, item := range obj.(*v2.ApisixIngressClassConfigList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested apisixIngressClassConfigs.
+func (c *FakeApisixIngressClassConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(apisixingressclassconfigsResource, opts))
+}
+
+// Create takes the representation of a apisixIngressClassConfig and creates it.  Returns the server's representation of the apisixIngressClassConfig, and an error, if there is any.
+func (c *FakeApisixIngressClassConfigs) Create(ctx context.Context, apisixIngressClassConfig *v2.ApisixIngressClassConfig, opts v1.CreateOptions) (result *v2.ApisixIngressClassConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(apisixingressclassconfigsResource, apisixIngressClassConfig), &v2.ApisixIngressClassConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixIngressClassConfig), err
+}
+
+// Update takes the representation of a apisixIngressClassConfig and updates it. Returns the server's representation of the apisixIngressClassConfig, and an error, if there is any.
+func (c *FakeApisixIngressClassConfigs) Update(ctx context.Context, apisixIngressClassConfig *v2.ApisixIngressClassConfig, opts v1.UpdateOptions) (result *v2.ApisixIngressClassConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(apisixingressclassconfigsResource, apisixIngressClassConfig), &v2.ApisixIngressClassConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixIngressClassConfig), err
+}
+
+// Delete takes name of the apisixIngressClassConfig and deletes it. Returns an error if one occurs.
+func (c *FakeApisixIngressClassConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(apisixingressclassconfigsResource, name, opts), &v2.ApisixIngressClassConfig{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeApisixIngressClassConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(apisixingressclassconfigsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v2.ApisixIngressClassConfigList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched apisixIngressClassConfig.
+func (c *FakeApisixIngressClassConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixIngressClassConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(apisixingressclassconfigsResource, name, pt, data, subresources...), &v2.ApisixIngressClassConfig{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixIngressClassConfig), err
+}
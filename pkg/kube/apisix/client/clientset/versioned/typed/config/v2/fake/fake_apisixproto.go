@@ -0,0 +1,141 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeApisixProtos implements ApisixProtoInterface
+type FakeApisixProtos struct {
+	Fake *FakeApisixV2
+	ns   string
+}
+
+var apisixprotosResource = schema.GroupVersionResource{Group: "apisix.apache.org", Version: "v2", Resource: "apisixprotos"}
+
+var apisixprotosKind = schema.GroupVersionKind{Group: "apisix.apache.org", Version: "v2", Kind: "ApisixProto"}
+
+// Get takes name of the apisixProto, and returns the corresponding apisixProto object, and an error if there is any.
+func (c *FakeApisixProtos) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.ApisixProto, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(apisixprotosResource, c.ns, name), &v2.ApisixProto{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixProto), err
+}
+
+// List takes label and field selectors, and returns the list of ApisixProtos that match those selectors.
+func (c *FakeApisixProtos) List(ctx context.Context, opts v1.ListOptions) (result *v2.ApisixProtoList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(apisixprotosResource, apisixprotosKind, c.ns, opts), &v2.ApisixProtoList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v2.ApisixProtoList{ListMeta: obj.(*v2.ApisixProtoList).ListMeta}
+	for _, item := range obj.(*v2.ApisixProtoList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested apisixProtos.
+func (c *FakeApisixProtos) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(apisixprotosResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a apisixProto and creates it.  Returns the server's representation of the apisixProto, and an error, if there is any.
+func (c *FakeApisixProtos) Create(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.CreateOptions) (result *v2.ApisixProto, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(apisixprotosResource, c.ns, apisixProto), &v2.ApisixProto{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixProto), err
+}
+
+// Update takes the representation of a apisixProto and updates it. Returns the server's representation of the apisixProto, and an error, if there is any.
+func (c *FakeApisixProtos) Update(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.UpdateOptions) (result *v2.ApisixProto, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(apisixprotosResource, c.ns, apisixProto), &v2.ApisixProto{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixProto), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeApisixProtos) UpdateStatus(ctx context.Context, apisixProto *v2.ApisixProto, opts v1.UpdateOptions) (*v2.ApisixProto, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(apisixprotosResource, "status", c.ns, apisixProto), &v2.ApisixProto{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixProto), err
+}
+
+// Delete takes name of the apisixProto and deletes it. Returns an error if one occurs.
+func (c *FakeApisixProtos) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(apisixprotosResource, c.ns, name, opts), &v2.ApisixProto{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeApisixProtos) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(apisixprotosResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v2.ApisixProtoList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched apisixProto.
+func (c *FakeApisixProtos) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixProto, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apisixprotosResource, c.ns, name, pt, data, subresources...), &v2.ApisixProto{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixProto), err
+}
@@ -0,0 +1,141 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeApisixSecretProviders implements ApisixSecretProviderInterface
+type FakeApisixSecretProviders struct {
+	Fake *FakeApisixV2
+	ns   string
+}
+
+var apisixsecretprovidersResource = schema.GroupVersionResource{Group: "apisix.apache.org", Version: "v2", Resource: "apisixsecretproviders"}
+
+var apisixsecretprovidersKind = schema.GroupVersionKind{Group: "apisix.apache.org", Version: "v2", Kind: "ApisixSecretProvider"}
+
+// Get takes name of the apisixSecretProvider, and returns the corresponding apisixSecretProvider object, and an error if there is any.
+func (c *FakeApisixSecretProviders) Get(ctx context.Context, name string, options v1.GetOptions) (result *v2.ApisixSecretProvider, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(apisixsecretprovidersResource, c.ns, name), &v2.ApisixSecretProvider{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixSecretProvider), err
+}
+
+// List takes label and field selectors, and returns the list of ApisixSecretProviders that match those selectors.
+func (c *FakeApisixSecretProviders) List(ctx context.Context, opts v1.ListOptions) (result *v2.ApisixSecretProviderList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(apisixsecretprovidersResource, apisixsecretprovidersKind, c.ns, opts), &v2.ApisixSecretProviderList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v2.ApisixSecretProviderList{ListMeta: obj.(*v2.ApisixSecretProviderList).ListMeta}
+	for _, item := range obj.(*v2.ApisixSecretProviderList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested apisixSecretProviders.
+func (c *FakeApisixSecretProviders) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(apisixsecretprovidersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a apisixSecretProvider and creates it.  Returns the server's representation of the apisixSecretProvider, and an error, if there is any.
+func (c *FakeApisixSecretProviders) Create(ctx context.Context, apisixSecretProvider *v2.ApisixSecretProvider, opts v1.CreateOptions) (result *v2.ApisixSecretProvider, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(apisixsecretprovidersResource, c.ns, apisixSecretProvider), &v2.ApisixSecretProvider{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixSecretProvider), err
+}
+
+// Update takes the representation of a apisixSecretProvider and updates it. Returns the server's representation of the apisixSecretProvider, and an error, if there is any.
+func (c *FakeApisixSecretProviders) Update(ctx context.Context, apisixSecretProvider *v2.ApisixSecretProvider, opts v1.UpdateOptions) (result *v2.ApisixSecretProvider, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(apisixsecretprovidersResource, c.ns, apisixSecretProvider), &v2.ApisixSecretProvider{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixSecretProvider), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeApisixSecretProviders) UpdateStatus(ctx context.Context, apisixSecretProvider *v2.ApisixSecretProvider, opts v1.UpdateOptions) (*v2.ApisixSecretProvider, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(apisixsecretprovidersResource, "status", c.ns, apisixSecretProvider), &v2.ApisixSecretProvider{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixSecretProvider), err
+}
+
+// Delete takes name of the apisixSecretProvider and deletes it. Returns an error if one occurs.
+func (c *FakeApisixSecretProviders) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(apisixsecretprovidersResource, c.ns, name, opts), &v2.ApisixSecretProvider{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeApisixSecretProviders) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(apisixsecretprovidersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v2.ApisixSecretProviderList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched apisixSecretProvider.
+func (c *FakeApisixSecretProviders) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v2.ApisixSecretProvider, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(apisixsecretprovidersResource, c.ns, name, pt, data, subresources...), &v2.ApisixSecretProvider{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v2.ApisixSecretProvider), err
+}
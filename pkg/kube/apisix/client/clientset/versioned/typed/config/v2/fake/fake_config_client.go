@@ -35,18 +35,38 @@ func (c *FakeApisixV2) ApisixConsumers(namespace string) v2.ApisixConsumerInterf
 	return &FakeApisixConsumers{c, namespace}
 }
 
+func (c *FakeApisixV2) ApisixConsumerGroups(namespace string) v2.ApisixConsumerGroupInterface {
+	return &FakeApisixConsumerGroups{c, namespace}
+}
+
 func (c *FakeApisixV2) ApisixGlobalRules(namespace string) v2.ApisixGlobalRuleInterface {
 	return &FakeApisixGlobalRules{c, namespace}
 }
 
+func (c *FakeApisixV2) ApisixIngressClassConfigs() v2.ApisixIngressClassConfigInterface {
+	return &FakeApisixIngressClassConfigs{c}
+}
+
 func (c *FakeApisixV2) ApisixPluginConfigs(namespace string) v2.ApisixPluginConfigInterface {
 	return &FakeApisixPluginConfigs{c, namespace}
 }
 
+func (c *FakeApisixV2) ApisixProtos(namespace string) v2.ApisixProtoInterface {
+	return &FakeApisixProtos{c, namespace}
+}
+
 func (c *FakeApisixV2) ApisixRoutes(namespace string) v2.ApisixRouteInterface {
 	return &FakeApisixRoutes{c, namespace}
 }
 
+func (c *FakeApisixV2) ApisixSecretProviders(namespace string) v2.ApisixSecretProviderInterface {
+	return &FakeApisixSecretProviders{c, namespace}
+}
+
+func (c *FakeApisixV2) ApisixServices(namespace string) v2.ApisixServiceInterface {
+	return &FakeApisixServices{c, namespace}
+}
+
 func (c *FakeApisixV2) ApisixTlses(namespace string) v2.ApisixTlsInterface {
 	return &FakeApisixTlses{c, namespace}
 }
@@ -21,12 +21,22 @@ type ApisixClusterConfigExpansion interface{}
 
 type ApisixConsumerExpansion interface{}
 
+type ApisixConsumerGroupExpansion interface{}
+
 type ApisixGlobalRuleExpansion interface{}
 
+type ApisixIngressClassConfigExpansion interface{}
+
 type ApisixPluginConfigExpansion interface{}
 
+type ApisixProtoExpansion interface{}
+
 type ApisixRouteExpansion interface{}
 
+type ApisixSecretProviderExpansion interface{}
+
+type ApisixServiceExpansion interface{}
+
 type ApisixTlsExpansion interface{}
 
 type ApisixUpstreamExpansion interface{}
@@ -27,12 +27,22 @@ type Interface interface {
 	ApisixClusterConfigs() ApisixClusterConfigInformer
 	// ApisixConsumers returns a ApisixConsumerInformer.
 	ApisixConsumers() ApisixConsumerInformer
+	// ApisixConsumerGroups returns a ApisixConsumerGroupInformer.
+	ApisixConsumerGroups() ApisixConsumerGroupInformer
 	// ApisixGlobalRules returns a ApisixGlobalRuleInformer.
 	ApisixGlobalRules() ApisixGlobalRuleInformer
+	// ApisixIngressClassConfigs returns a ApisixIngressClassConfigInformer.
+	ApisixIngressClassConfigs() ApisixIngressClassConfigInformer
 	// ApisixPluginConfigs returns a ApisixPluginConfigInformer.
 	ApisixPluginConfigs() ApisixPluginConfigInformer
+	// ApisixProtos returns a ApisixProtoInformer.
+	ApisixProtos() ApisixProtoInformer
 	// ApisixRoutes returns a ApisixRouteInformer.
 	ApisixRoutes() ApisixRouteInformer
+	// ApisixSecretProviders returns a ApisixSecretProviderInformer.
+	ApisixSecretProviders() ApisixSecretProviderInformer
+	// ApisixServices returns a ApisixServiceInformer.
+	ApisixServices() ApisixServiceInformer
 	// ApisixTlses returns a ApisixTlsInformer.
 	ApisixTlses() ApisixTlsInformer
 	// ApisixUpstreams returns a ApisixUpstreamInformer.
@@ -60,21 +70,46 @@ func (v *version) ApisixConsumers() ApisixConsumerInformer {
 	return &apisixConsumerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ApisixConsumerGroups returns a ApisixConsumerGroupInformer.
+func (v *version) ApisixConsumerGroups() ApisixConsumerGroupInformer {
+	return &apisixConsumerGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ApisixGlobalRules returns a ApisixGlobalRuleInformer.
 func (v *version) ApisixGlobalRules() ApisixGlobalRuleInformer {
 	return &apisixGlobalRuleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ApisixIngressClassConfigs returns a ApisixIngressClassConfigInformer.
+func (v *version) ApisixIngressClassConfigs() ApisixIngressClassConfigInformer {
+	return &apisixIngressClassConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ApisixPluginConfigs returns a ApisixPluginConfigInformer.
 func (v *version) ApisixPluginConfigs() ApisixPluginConfigInformer {
 	return &apisixPluginConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ApisixProtos returns a ApisixProtoInformer.
+func (v *version) ApisixProtos() ApisixProtoInformer {
+	return &apisixProtoInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ApisixRoutes returns a ApisixRouteInformer.
 func (v *version) ApisixRoutes() ApisixRouteInformer {
 	return &apisixRouteInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ApisixSecretProviders returns a ApisixSecretProviderInformer.
+func (v *version) ApisixSecretProviders() ApisixSecretProviderInformer {
+	return &apisixSecretProviderInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ApisixServices returns a ApisixServiceInformer.
+func (v *version) ApisixServices() ApisixServiceInformer {
+	return &apisixServiceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ApisixTlses returns a ApisixTlsInformer.
 func (v *version) ApisixTlses() ApisixTlsInformer {
 	return &apisixTlsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -57,12 +57,22 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixClusterConfigs().Informer()}, nil
 	case v2.SchemeGroupVersion.WithResource("apisixconsumers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixConsumers().Informer()}, nil
+	case v2.SchemeGroupVersion.WithResource("apisixconsumergroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixConsumerGroups().Informer()}, nil
 	case v2.SchemeGroupVersion.WithResource("apisixglobalrules"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixGlobalRules().Informer()}, nil
+	case v2.SchemeGroupVersion.WithResource("apisixingressclassconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixIngressClassConfigs().Informer()}, nil
 	case v2.SchemeGroupVersion.WithResource("apisixpluginconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixPluginConfigs().Informer()}, nil
+	case v2.SchemeGroupVersion.WithResource("apisixprotos"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixProtos().Informer()}, nil
 	case v2.SchemeGroupVersion.WithResource("apisixroutes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixRoutes().Informer()}, nil
+	case v2.SchemeGroupVersion.WithResource("apisixsecretproviders"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixSecretProviders().Informer()}, nil
+	case v2.SchemeGroupVersion.WithResource("apisixservices"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixServices().Informer()}, nil
 	case v2.SchemeGroupVersion.WithResource("apisixtlses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Apisix().V2().ApisixTlses().Informer()}, nil
 	case v2.SchemeGroupVersion.WithResource("apisixupstreams"):
@@ -0,0 +1,98 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ApisixConsumerGroupLister helps list ApisixConsumerGroups.
+// All objects returned here must be treated as read-only.
+type ApisixConsumerGroupLister interface {
+	// List lists all ApisixConsumerGroups in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.ApisixConsumerGroup, err error)
+	// ApisixConsumerGroups returns an object that can list and get ApisixConsumerGroups.
+	ApisixConsumerGroups(namespace string) ApisixConsumerGroupNamespaceLister
+	ApisixConsumerGroupListerExpansion
+}
+
+// apisixConsumerGroupLister implements the ApisixConsumerGroupLister interface.
+type apisixConsumerGroupLister struct {
+	indexer cache.Indexer
+}
+
+// NewApisixConsumerGroupLister returns a new ApisixConsumerGroupLister.
+func NewApisixConsumerGroupLister(indexer cache.Indexer) ApisixConsumerGroupLister {
+	return &apisixConsumerGroupLister{indexer: indexer}
+}
+
+// List lists all ApisixConsumerGroups in the indexer.
+func (s *apisixConsumerGroupLister) List(selector labels.Selector) (ret []*v2.ApisixConsumerGroup, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v2.ApisixConsumerGroup))
+	})
+	return ret, err
+}
+
+// ApisixConsumerGroups returns an object that can list and get ApisixConsumerGroups.
+func (s *apisixConsumerGroupLister) ApisixConsumerGroups(namespace string) ApisixConsumerGroupNamespaceLister {
+	return apisixConsumerGroupNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ApisixConsumerGroupNamespaceLister helps list and get ApisixConsumerGroups.
+// All objects returned here must be treated as read-only.
+type ApisixConsumerGroupNamespaceLister interface {
+	// List lists all ApisixConsumerGroups in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.ApisixConsumerGroup, err error)
+	// Get retrieves the ApisixConsumerGroup from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v2.ApisixConsumerGroup, error)
+	ApisixConsumerGroupNamespaceListerExpansion
+}
+
+// apisixConsumerGroupNamespaceLister implements the ApisixConsumerGroupNamespaceLister
+// interface.
+type apisixConsumerGroupNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ApisixConsumerGroups in the indexer for a given namespace.
+func (s apisixConsumerGroupNamespaceLister) List(selector labels.Selector) (ret []*v2.ApisixConsumerGroup, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v2.ApisixConsumerGroup))
+	})
+	return ret, err
+}
+
+// Get retrieves the ApisixConsumerGroup from the indexer for a given namespace and name.
+func (s apisixConsumerGroupNamespaceLister) Get(name string) (*v2.ApisixConsumerGroup, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v2.Resource("apisixconsumergroup"), name)
+	}
+	return obj.(*v2.ApisixConsumerGroup), nil
+}
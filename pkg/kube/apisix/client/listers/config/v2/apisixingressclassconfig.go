@@ -0,0 +1,67 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ApisixIngressClassConfigLister helps list ApisixIngressClassConfigs.
+// All objects returned here must be treated as read-only.
+type ApisixIngressClassConfigLister interface {
+	// List lists all ApisixIngressClassConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.ApisixIngressClassConfig, err error)
+	// Get retrieves the ApisixIngressClassConfig from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v2.ApisixIngressClassConfig, error)
+	ApisixIngressClassConfigListerExpansion
+}
+
+// apisixIngressClassConfigLister implements the ApisixIngressClassConfigLister interface.
+type apisixIngressClassConfigLister struct {
+	indexer cache.Indexer
+}
+
+// NewApisixIngressClassConfigLister returns a new ApisixIngressClassConfigLister.
+func NewApisixIngressClassConfigLister(indexer cache.Indexer) ApisixIngressClassConfigLister {
+	return &apisixIngressClassConfigLister{indexer: indexer}
+}
+
+// List lists all ApisixIngressClassConfigs in the indexer.
+func (s *apisixIngressClassConfigLister) List(selector labels.Selector) (ret []*v2.ApisixIngressClassConfig, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v2.ApisixIngressClassConfig))
+	})
+	return ret, err
+}
+
+// Get retrieves the ApisixIngressClassConfig from the index for a given name.
+func (s *apisixIngressClassConfigLister) Get(name string) (*v2.ApisixIngressClassConfig, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v2.Resource("apisixingressclassconfig"), name)
+	}
+	return obj.(*v2.ApisixIngressClassConfig), nil
+}
@@ -0,0 +1,98 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ApisixProtoLister helps list ApisixProtos.
+// All objects returned here must be treated as read-only.
+type ApisixProtoLister interface {
+	// List lists all ApisixProtos in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.ApisixProto, err error)
+	// ApisixProtos returns an object that can list and get ApisixProtos.
+	ApisixProtos(namespace string) ApisixProtoNamespaceLister
+	ApisixProtoListerExpansion
+}
+
+// apisixProtoLister implements the ApisixProtoLister interface.
+type apisixProtoLister struct {
+	indexer cache.Indexer
+}
+
+// NewApisixProtoLister returns a new ApisixProtoLister.
+func NewApisixProtoLister(indexer cache.Indexer) ApisixProtoLister {
+	return &apisixProtoLister{indexer: indexer}
+}
+
+// List lists all ApisixProtos in the indexer.
+func (s *apisixProtoLister) List(selector labels.Selector) (ret []*v2.ApisixProto, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v2.ApisixProto))
+	})
+	return ret, err
+}
+
+// ApisixProtos returns an object that can list and get ApisixProtos.
+func (s *apisixProtoLister) ApisixProtos(namespace string) ApisixProtoNamespaceLister {
+	return apisixProtoNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ApisixProtoNamespaceLister helps list and get ApisixProtos.
+// All objects returned here must be treated as read-only.
+type ApisixProtoNamespaceLister interface {
+	// List lists all ApisixProtos in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.ApisixProto, err error)
+	// Get retrieves the ApisixProto from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v2.ApisixProto, error)
+	ApisixProtoNamespaceListerExpansion
+}
+
+// apisixProtoNamespaceLister implements the ApisixProtoNamespaceLister
+// interface.
+type apisixProtoNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ApisixProtos in the indexer for a given namespace.
+func (s apisixProtoNamespaceLister) List(selector labels.Selector) (ret []*v2.ApisixProto, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v2.ApisixProto))
+	})
+	return ret, err
+}
+
+// Get retrieves the ApisixProto from the indexer for a given namespace and name.
+func (s apisixProtoNamespaceLister) Get(name string) (*v2.ApisixProto, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v2.Resource("apisixproto"), name)
+	}
+	return obj.(*v2.ApisixProto), nil
+}
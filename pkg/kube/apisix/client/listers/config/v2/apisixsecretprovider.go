@@ -0,0 +1,98 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	v2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ApisixSecretProviderLister helps list ApisixSecretProviders.
+// All objects returned here must be treated as read-only.
+type ApisixSecretProviderLister interface {
+	// List lists all ApisixSecretProviders in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.ApisixSecretProvider, err error)
+	// ApisixSecretProviders returns an object that can list and get ApisixSecretProviders.
+	ApisixSecretProviders(namespace string) ApisixSecretProviderNamespaceLister
+	ApisixSecretProviderListerExpansion
+}
+
+// apisixSecretProviderLister implements the ApisixSecretProviderLister interface.
+type apisixSecretProviderLister struct {
+	indexer cache.Indexer
+}
+
+// NewApisixSecretProviderLister returns a new ApisixSecretProviderLister.
+func NewApisixSecretProviderLister(indexer cache.Indexer) ApisixSecretProviderLister {
+	return &apisixSecretProviderLister{indexer: indexer}
+}
+
+// List lists all ApisixSecretProviders in the indexer.
+func (s *apisixSecretProviderLister) List(selector labels.Selector) (ret []*v2.ApisixSecretProvider, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v2.ApisixSecretProvider))
+	})
+	return ret, err
+}
+
+// ApisixSecretProviders returns an object that can list and get ApisixSecretProviders.
+func (s *apisixSecretProviderLister) ApisixSecretProviders(namespace string) ApisixSecretProviderNamespaceLister {
+	return apisixSecretProviderNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ApisixSecretProviderNamespaceLister helps list and get ApisixSecretProviders.
+// All objects returned here must be treated as read-only.
+type ApisixSecretProviderNamespaceLister interface {
+	// List lists all ApisixSecretProviders in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v2.ApisixSecretProvider, err error)
+	// Get retrieves the ApisixSecretProvider from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v2.ApisixSecretProvider, error)
+	ApisixSecretProviderNamespaceListerExpansion
+}
+
+// apisixSecretProviderNamespaceLister implements the ApisixSecretProviderNamespaceLister
+// interface.
+type apisixSecretProviderNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ApisixSecretProviders in the indexer for a given namespace.
+func (s apisixSecretProviderNamespaceLister) List(selector labels.Selector) (ret []*v2.ApisixSecretProvider, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v2.ApisixSecretProvider))
+	})
+	return ret, err
+}
+
+// Get retrieves the ApisixSecretProvider from the indexer for a given namespace and name.
+func (s apisixSecretProviderNamespaceLister) Get(name string) (*v2.ApisixSecretProvider, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v2.Resource("apisixsecretprovider"), name)
+	}
+	return obj.(*v2.ApisixSecretProvider), nil
+}
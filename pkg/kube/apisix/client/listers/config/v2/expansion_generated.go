@@ -29,6 +29,14 @@ type ApisixConsumerListerExpansion interface{}
 // ApisixConsumerNamespaceLister.
 type ApisixConsumerNamespaceListerExpansion interface{}
 
+// ApisixConsumerGroupListerExpansion allows custom methods to be added to
+// ApisixConsumerGroupLister.
+type ApisixConsumerGroupListerExpansion interface{}
+
+// ApisixConsumerGroupNamespaceListerExpansion allows custom methods to be added to
+// ApisixConsumerGroupNamespaceLister.
+type ApisixConsumerGroupNamespaceListerExpansion interface{}
+
 // ApisixGlobalRuleListerExpansion allows custom methods to be added to
 // ApisixGlobalRuleLister.
 type ApisixGlobalRuleListerExpansion interface{}
@@ -37,6 +45,10 @@ type ApisixGlobalRuleListerExpansion interface{}
 // ApisixGlobalRuleNamespaceLister.
 type ApisixGlobalRuleNamespaceListerExpansion interface{}
 
+// ApisixIngressClassConfigListerExpansion allows custom methods to be added to
+// ApisixIngressClassConfigLister.
+type ApisixIngressClassConfigListerExpansion interface{}
+
 // ApisixPluginConfigListerExpansion allows custom methods to be added to
 // ApisixPluginConfigLister.
 type ApisixPluginConfigListerExpansion interface{}
@@ -45,6 +57,14 @@ type ApisixPluginConfigListerExpansion interface{}
 // ApisixPluginConfigNamespaceLister.
 type ApisixPluginConfigNamespaceListerExpansion interface{}
 
+// ApisixProtoListerExpansion allows custom methods to be added to
+// ApisixProtoLister.
+type ApisixProtoListerExpansion interface{}
+
+// ApisixProtoNamespaceListerExpansion allows custom methods to be added to
+// ApisixProtoNamespaceLister.
+type ApisixProtoNamespaceListerExpansion interface{}
+
 // ApisixRouteListerExpansion allows custom methods to be added to
 // ApisixRouteLister.
 type ApisixRouteListerExpansion interface{}
@@ -53,6 +73,22 @@ type ApisixRouteListerExpansion interface{}
 // ApisixRouteNamespaceLister.
 type ApisixRouteNamespaceListerExpansion interface{}
 
+// ApisixSecretProviderListerExpansion allows custom methods to be added to
+// ApisixSecretProviderLister.
+type ApisixSecretProviderListerExpansion interface{}
+
+// ApisixSecretProviderNamespaceListerExpansion allows custom methods to be added to
+// ApisixSecretProviderNamespaceLister.
+type ApisixSecretProviderNamespaceListerExpansion interface{}
+
+// ApisixServiceListerExpansion allows custom methods to be added to
+// ApisixServiceLister.
+type ApisixServiceListerExpansion interface{}
+
+// ApisixServiceNamespaceListerExpansion allows custom methods to be added to
+// ApisixServiceNamespaceLister.
+type ApisixServiceNamespaceListerExpansion interface{}
+
 // ApisixTlsListerExpansion allows custom methods to be added to
 // ApisixTlsLister.
 type ApisixTlsListerExpansion interface{}
@@ -0,0 +1,156 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kube
+
+import (
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	listersv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/listers/config/v2"
+)
+
+// ApisixConsumerGroupLister is an encapsulation for the lister of ApisixConsumerGroup,
+// it aims at to be compatible with different ApisixConsumerGroup versions.
+type ApisixConsumerGroupLister interface {
+	// V2 gets the ApisixConsumerGroup in apisix.apache.org/v2.
+	V2(string, string) (ApisixConsumerGroup, error)
+
+	ApisixConsumerGroup(string, string) (ApisixConsumerGroup, error)
+}
+
+// ApisixConsumerGroupInformer is an encapsulation for the informer of ApisixConsumerGroup,
+// it aims at to be compatible with different ApisixConsumerGroup versions.
+type ApisixConsumerGroupInformer interface {
+	Run(chan struct{})
+}
+
+// ApisixConsumerGroup is an encapsulation for ApisixConsumerGroup resource with different
+// versions, for now, they are apisix.apache.org/v1 and apisix.apache.org/v2alpha1
+type ApisixConsumerGroup interface {
+	// GroupVersion returns the api group version of the
+	// real ApisixConsumerGroup.
+	GroupVersion() string
+	// V2 returns the ApisixConsumerGroup in apisix.apache.org/v2, the real
+	// ApisixConsumerGroup must be in this group version, otherwise will panic.
+	V2() *configv2.ApisixConsumerGroup
+	// ResourceVersion returns the the resource version field inside
+	// the real ApisixConsumerGroup.
+	ResourceVersion() string
+
+	metav1.Object
+}
+
+// ApisixConsumerGroupEvent contains the ApisixConsumerGroup key (namespace/name)
+// and the group version message.
+type ApisixConsumerGroupEvent struct {
+	Key          string
+	OldObject    ApisixConsumerGroup
+	GroupVersion string
+}
+
+type apisixConsumerGroup struct {
+	groupVersion string
+	v2           *configv2.ApisixConsumerGroup
+	metav1.Object
+}
+
+func (acg *apisixConsumerGroup) V2() *configv2.ApisixConsumerGroup {
+	if acg.groupVersion != config.ApisixV2 {
+		panic("not a apisix.apache.org/v2 ApisixConsumerGroup")
+	}
+	return acg.v2
+}
+
+func (acg *apisixConsumerGroup) GroupVersion() string {
+	return acg.groupVersion
+}
+
+func (acg *apisixConsumerGroup) ResourceVersion() string {
+	return acg.V2().ResourceVersion
+}
+
+type apisixConsumerGroupLister struct {
+	groupVersion string
+	v2Lister     listersv2.ApisixConsumerGroupLister
+}
+
+func (l *apisixConsumerGroupLister) V2(namespace, name string) (ApisixConsumerGroup, error) {
+	acg, err := l.v2Lister.ApisixConsumerGroups(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixConsumerGroup{
+		groupVersion: config.ApisixV2,
+		v2:           acg,
+		Object:       acg.GetObjectMeta(),
+	}, nil
+}
+
+func (l *apisixConsumerGroupLister) ApisixConsumerGroup(namespace, name string) (ApisixConsumerGroup, error) {
+	switch l.groupVersion {
+	case config.ApisixV2:
+		acg, err := l.v2Lister.ApisixConsumerGroups(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return &apisixConsumerGroup{
+			groupVersion: config.ApisixV2,
+			v2:           acg,
+		}, nil
+	default:
+		panic("invalid ApisixConsumerGroup group version")
+	}
+}
+
+// MustNewApisixConsumerGroup creates a kube.ApisixConsumerGroup object according to the
+// type of obj.
+func MustNewApisixConsumerGroup(obj interface{}) ApisixConsumerGroup {
+	switch acg := obj.(type) {
+	case *configv2.ApisixConsumerGroup:
+		return &apisixConsumerGroup{
+			groupVersion: config.ApisixV2,
+			v2:           acg,
+			Object:       acg.GetObjectMeta(),
+		}
+	default:
+		panic("invalid ApisixConsumerGroup type")
+	}
+}
+
+// NewApisixConsumerGroup creates a kube.ApisixConsumerGroup object according to the
+// type of obj. It returns nil and the error reason when the
+// type assertion fails.
+func NewApisixConsumerGroup(obj interface{}) (ApisixConsumerGroup, error) {
+	switch acg := obj.(type) {
+	case *configv2.ApisixConsumerGroup:
+		return &apisixConsumerGroup{
+			groupVersion: config.ApisixV2,
+			v2:           acg,
+			Object:       acg.GetObjectMeta(),
+		}, nil
+	default:
+		return nil, errors.New("invalid ApisixConsumerGroup type")
+	}
+}
+
+func NewApisixConsumerGroupLister(apiVersion string, v2 listersv2.ApisixConsumerGroupLister) ApisixConsumerGroupLister {
+	return &apisixConsumerGroupLister{
+		groupVersion: apiVersion,
+		v2Lister:     v2,
+	}
+}
@@ -0,0 +1,130 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kube
+
+import (
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	listersv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/listers/config/v2"
+)
+
+// ApisixIngressClassConfigLister is an encapsulation for the lister of ApisixIngressClassConfig,
+// it aims at to be compatible with different ApisixIngressClassConfig versions.
+type ApisixIngressClassConfigLister interface {
+	// V2 gets the ApisixIngressClassConfig in apisix.apache.org/v2.
+	V2(string) (ApisixIngressClassConfig, error)
+
+	ApisixIngressClassConfig(string) (ApisixIngressClassConfig, error)
+}
+
+// ApisixIngressClassConfig is an encapsulation for ApisixIngressClassConfig resource with different
+// versions, for now, only apisix.apache.org/v2 is supported.
+type ApisixIngressClassConfig interface {
+	// GroupVersion returns the api group version of the
+	// real ApisixIngressClassConfig.
+	GroupVersion() string
+	// V2 returns the ApisixIngressClassConfig in apisix.apache.org/v2, the real
+	// ApisixIngressClassConfig must be in this group version, otherwise will panic.
+	V2() *configv2.ApisixIngressClassConfig
+	// ResourceVersion returns the the resource version field inside
+	// the real ApisixIngressClassConfig.
+	ResourceVersion() string
+
+	metav1.Object
+}
+
+type apisixIngressClassConfig struct {
+	groupVersion string
+	v2           *configv2.ApisixIngressClassConfig
+	metav1.Object
+}
+
+func (aicc *apisixIngressClassConfig) V2() *configv2.ApisixIngressClassConfig {
+	if aicc.groupVersion != config.ApisixV2 {
+		panic("not a apisix.apache.org/v2 ApisixIngressClassConfig")
+	}
+	return aicc.v2
+}
+
+func (aicc *apisixIngressClassConfig) GroupVersion() string {
+	return aicc.groupVersion
+}
+
+func (aicc *apisixIngressClassConfig) ResourceVersion() string {
+	return aicc.V2().ResourceVersion
+}
+
+type apisixIngressClassConfigLister struct {
+	groupVersion string
+	v2Lister     listersv2.ApisixIngressClassConfigLister
+}
+
+func (l *apisixIngressClassConfigLister) V2(name string) (ApisixIngressClassConfig, error) {
+	aicc, err := l.v2Lister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixIngressClassConfig{
+		groupVersion: config.ApisixV2,
+		v2:           aicc,
+		Object:       aicc.GetObjectMeta(),
+	}, nil
+}
+
+func (l *apisixIngressClassConfigLister) ApisixIngressClassConfig(name string) (ApisixIngressClassConfig, error) {
+	switch l.groupVersion {
+	case config.ApisixV2:
+		return l.V2(name)
+	default:
+		return nil, errors.New("ApisixIngressClassConfig is only supported in apisix.apache.org/v2")
+	}
+}
+
+// MustNewApisixIngressClassConfig creates a kube.ApisixIngressClassConfig object according to the
+// type of obj.
+func MustNewApisixIngressClassConfig(obj interface{}) ApisixIngressClassConfig {
+	aicc, err := NewApisixIngressClassConfig(obj)
+	if err != nil {
+		panic(err)
+	}
+	return aicc
+}
+
+// NewApisixIngressClassConfig creates a kube.ApisixIngressClassConfig object according to the
+// type of obj. It returns nil and the error reason when the
+// type assertion fails.
+func NewApisixIngressClassConfig(obj interface{}) (ApisixIngressClassConfig, error) {
+	switch aicc := obj.(type) {
+	case *configv2.ApisixIngressClassConfig:
+		return &apisixIngressClassConfig{
+			groupVersion: config.ApisixV2,
+			v2:           aicc,
+			Object:       aicc.GetObjectMeta(),
+		}, nil
+	default:
+		return nil, errors.New("invalid ApisixIngressClassConfig type")
+	}
+}
+
+func NewApisixIngressClassConfigLister(apiVersion string, v2 listersv2.ApisixIngressClassConfigLister) ApisixIngressClassConfigLister {
+	return &apisixIngressClassConfigLister{
+		groupVersion: apiVersion,
+		v2Lister:     v2,
+	}
+}
@@ -0,0 +1,156 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kube
+
+import (
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	listersv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/listers/config/v2"
+)
+
+// ApisixProtoLister is an encapsulation for the lister of ApisixProto,
+// it aims at to be compatible with different ApisixProto versions.
+type ApisixProtoLister interface {
+	// V2 gets the ApisixProto in apisix.apache.org/v2.
+	V2(string, string) (ApisixProto, error)
+
+	ApisixProto(string, string) (ApisixProto, error)
+}
+
+// ApisixProtoInformer is an encapsulation for the informer of ApisixProto,
+// it aims at to be compatible with different ApisixProto versions.
+type ApisixProtoInformer interface {
+	Run(chan struct{})
+}
+
+// ApisixProto is an encapsulation for ApisixProto resource with different
+// versions, for now, they are apisix.apache.org/v1 and apisix.apache.org/v2alpha1
+type ApisixProto interface {
+	// GroupVersion returns the api group version of the
+	// real ApisixProto.
+	GroupVersion() string
+	// V2 returns the ApisixProto in apisix.apache.org/v2, the real
+	// ApisixProto must be in this group version, otherwise will panic.
+	V2() *configv2.ApisixProto
+	// ResourceVersion returns the the resource version field inside
+	// the real ApisixProto.
+	ResourceVersion() string
+
+	metav1.Object
+}
+
+// ApisixProtoEvent contains the ApisixProto key (namespace/name)
+// and the group version message.
+type ApisixProtoEvent struct {
+	Key          string
+	OldObject    ApisixProto
+	GroupVersion string
+}
+
+type apisixProto struct {
+	groupVersion string
+	v2           *configv2.ApisixProto
+	metav1.Object
+}
+
+func (ap *apisixProto) V2() *configv2.ApisixProto {
+	if ap.groupVersion != config.ApisixV2 {
+		panic("not a apisix.apache.org/v2 ApisixProto")
+	}
+	return ap.v2
+}
+
+func (ap *apisixProto) GroupVersion() string {
+	return ap.groupVersion
+}
+
+func (ap *apisixProto) ResourceVersion() string {
+	return ap.V2().ResourceVersion
+}
+
+type apisixProtoLister struct {
+	groupVersion string
+	v2Lister     listersv2.ApisixProtoLister
+}
+
+func (l *apisixProtoLister) V2(namespace, name string) (ApisixProto, error) {
+	ap, err := l.v2Lister.ApisixProtos(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixProto{
+		groupVersion: config.ApisixV2,
+		v2:           ap,
+		Object:       ap.GetObjectMeta(),
+	}, nil
+}
+
+func (l *apisixProtoLister) ApisixProto(namespace, name string) (ApisixProto, error) {
+	switch l.groupVersion {
+	case config.ApisixV2:
+		ap, err := l.v2Lister.ApisixProtos(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return &apisixProto{
+			groupVersion: config.ApisixV2,
+			v2:           ap,
+		}, nil
+	default:
+		panic("invalid ApisixProto group version")
+	}
+}
+
+// MustNewApisixProto creates a kube.ApisixProto object according to the
+// type of obj.
+func MustNewApisixProto(obj interface{}) ApisixProto {
+	switch ap := obj.(type) {
+	case *configv2.ApisixProto:
+		return &apisixProto{
+			groupVersion: config.ApisixV2,
+			v2:           ap,
+			Object:       ap.GetObjectMeta(),
+		}
+	default:
+		panic("invalid ApisixProto type")
+	}
+}
+
+// NewApisixProto creates a kube.ApisixProto object according to the
+// type of obj. It returns nil and the error reason when the
+// type assertion fails.
+func NewApisixProto(obj interface{}) (ApisixProto, error) {
+	switch ap := obj.(type) {
+	case *configv2.ApisixProto:
+		return &apisixProto{
+			groupVersion: config.ApisixV2,
+			v2:           ap,
+			Object:       ap.GetObjectMeta(),
+		}, nil
+	default:
+		return nil, errors.New("invalid ApisixProto type")
+	}
+}
+
+func NewApisixProtoLister(apiVersion string, v2 listersv2.ApisixProtoLister) ApisixProtoLister {
+	return &apisixProtoLister{
+		groupVersion: apiVersion,
+		v2Lister:     v2,
+	}
+}
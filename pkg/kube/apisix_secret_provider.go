@@ -0,0 +1,156 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kube
+
+import (
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	listersv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/listers/config/v2"
+)
+
+// ApisixSecretProviderLister is an encapsulation for the lister of ApisixSecretProvider,
+// it aims at to be compatible with different ApisixSecretProvider versions.
+type ApisixSecretProviderLister interface {
+	// V2 gets the ApisixSecretProvider in apisix.apache.org/v2.
+	V2(string, string) (ApisixSecretProvider, error)
+
+	ApisixSecretProvider(string, string) (ApisixSecretProvider, error)
+}
+
+// ApisixSecretProviderInformer is an encapsulation for the informer of ApisixSecretProvider,
+// it aims at to be compatible with different ApisixSecretProvider versions.
+type ApisixSecretProviderInformer interface {
+	Run(chan struct{})
+}
+
+// ApisixSecretProvider is an encapsulation for ApisixSecretProvider resource with different
+// versions, for now, they are apisix.apache.org/v1 and apisix.apache.org/v2alpha1
+type ApisixSecretProvider interface {
+	// GroupVersion returns the api group version of the
+	// real ApisixSecretProvider.
+	GroupVersion() string
+	// V2 returns the ApisixSecretProvider in apisix.apache.org/v2, the real
+	// ApisixSecretProvider must be in this group version, otherwise will panic.
+	V2() *configv2.ApisixSecretProvider
+	// ResourceVersion returns the the resource version field inside
+	// the real ApisixSecretProvider.
+	ResourceVersion() string
+
+	metav1.Object
+}
+
+// ApisixSecretProviderEvent contains the ApisixSecretProvider key (namespace/name)
+// and the group version message.
+type ApisixSecretProviderEvent struct {
+	Key          string
+	OldObject    ApisixSecretProvider
+	GroupVersion string
+}
+
+type apisixSecretProvider struct {
+	groupVersion string
+	v2           *configv2.ApisixSecretProvider
+	metav1.Object
+}
+
+func (asp *apisixSecretProvider) V2() *configv2.ApisixSecretProvider {
+	if asp.groupVersion != config.ApisixV2 {
+		panic("not a apisix.apache.org/v2 ApisixSecretProvider")
+	}
+	return asp.v2
+}
+
+func (asp *apisixSecretProvider) GroupVersion() string {
+	return asp.groupVersion
+}
+
+func (asp *apisixSecretProvider) ResourceVersion() string {
+	return asp.V2().ResourceVersion
+}
+
+type apisixSecretProviderLister struct {
+	groupVersion string
+	v2Lister     listersv2.ApisixSecretProviderLister
+}
+
+func (l *apisixSecretProviderLister) V2(namespace, name string) (ApisixSecretProvider, error) {
+	asp, err := l.v2Lister.ApisixSecretProviders(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixSecretProvider{
+		groupVersion: config.ApisixV2,
+		v2:           asp,
+		Object:       asp.GetObjectMeta(),
+	}, nil
+}
+
+func (l *apisixSecretProviderLister) ApisixSecretProvider(namespace, name string) (ApisixSecretProvider, error) {
+	switch l.groupVersion {
+	case config.ApisixV2:
+		asp, err := l.v2Lister.ApisixSecretProviders(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return &apisixSecretProvider{
+			groupVersion: config.ApisixV2,
+			v2:           asp,
+		}, nil
+	default:
+		panic("invalid ApisixSecretProvider group version")
+	}
+}
+
+// MustNewApisixSecretProvider creates a kube.ApisixSecretProvider object according to the
+// type of obj.
+func MustNewApisixSecretProvider(obj interface{}) ApisixSecretProvider {
+	switch asp := obj.(type) {
+	case *configv2.ApisixSecretProvider:
+		return &apisixSecretProvider{
+			groupVersion: config.ApisixV2,
+			v2:           asp,
+			Object:       asp.GetObjectMeta(),
+		}
+	default:
+		panic("invalid ApisixSecretProvider type")
+	}
+}
+
+// NewApisixSecretProvider creates a kube.ApisixSecretProvider object according to the
+// type of obj. It returns nil and the error reason when the
+// type assertion fails.
+func NewApisixSecretProvider(obj interface{}) (ApisixSecretProvider, error) {
+	switch asp := obj.(type) {
+	case *configv2.ApisixSecretProvider:
+		return &apisixSecretProvider{
+			groupVersion: config.ApisixV2,
+			v2:           asp,
+			Object:       asp.GetObjectMeta(),
+		}, nil
+	default:
+		return nil, errors.New("invalid ApisixSecretProvider type")
+	}
+}
+
+func NewApisixSecretProviderLister(apiVersion string, v2 listersv2.ApisixSecretProviderLister) ApisixSecretProviderLister {
+	return &apisixSecretProviderLister{
+		groupVersion: apiVersion,
+		v2Lister:     v2,
+	}
+}
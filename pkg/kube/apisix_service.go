@@ -0,0 +1,156 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package kube
+
+import (
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	listersv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/listers/config/v2"
+)
+
+// ApisixServiceLister is an encapsulation for the lister of ApisixService,
+// it aims at to be compatible with different ApisixService versions.
+type ApisixServiceLister interface {
+	// V2 gets the ApisixService in apisix.apache.org/v2.
+	V2(string, string) (ApisixService, error)
+
+	ApisixService(string, string) (ApisixService, error)
+}
+
+// ApisixServiceInformer is an encapsulation for the informer of ApisixService,
+// it aims at to be compatible with different ApisixService versions.
+type ApisixServiceInformer interface {
+	Run(chan struct{})
+}
+
+// ApisixService is an encapsulation for ApisixService resource with different
+// versions, for now, they are apisix.apache.org/v1 and apisix.apache.org/v2alpha1
+type ApisixService interface {
+	// GroupVersion returns the api group version of the
+	// real ApisixService.
+	GroupVersion() string
+	// V2 returns the ApisixService in apisix.apache.org/v2, the real
+	// ApisixService must be in this group version, otherwise will panic.
+	V2() *configv2.ApisixService
+	// ResourceVersion returns the the resource version field inside
+	// the real ApisixService.
+	ResourceVersion() string
+
+	metav1.Object
+}
+
+// ApisixServiceEvent contains the ApisixService key (namespace/name)
+// and the group version message.
+type ApisixServiceEvent struct {
+	Key          string
+	OldObject    ApisixService
+	GroupVersion string
+}
+
+type apisixService struct {
+	groupVersion string
+	v2           *configv2.ApisixService
+	metav1.Object
+}
+
+func (asvc *apisixService) V2() *configv2.ApisixService {
+	if asvc.groupVersion != config.ApisixV2 {
+		panic("not a apisix.apache.org/v2 ApisixService")
+	}
+	return asvc.v2
+}
+
+func (asvc *apisixService) GroupVersion() string {
+	return asvc.groupVersion
+}
+
+func (asvc *apisixService) ResourceVersion() string {
+	return asvc.V2().ResourceVersion
+}
+
+type apisixServiceLister struct {
+	groupVersion string
+	v2Lister     listersv2.ApisixServiceLister
+}
+
+func (l *apisixServiceLister) V2(namespace, name string) (ApisixService, error) {
+	asvc, err := l.v2Lister.ApisixServices(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixService{
+		groupVersion: config.ApisixV2,
+		v2:           asvc,
+		Object:       asvc.GetObjectMeta(),
+	}, nil
+}
+
+func (l *apisixServiceLister) ApisixService(namespace, name string) (ApisixService, error) {
+	switch l.groupVersion {
+	case config.ApisixV2:
+		asvc, err := l.v2Lister.ApisixServices(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return &apisixService{
+			groupVersion: config.ApisixV2,
+			v2:           asvc,
+		}, nil
+	default:
+		panic("invalid ApisixService group version")
+	}
+}
+
+// MustNewApisixService creates a kube.ApisixService object according to the
+// type of obj.
+func MustNewApisixService(obj interface{}) ApisixService {
+	switch asvc := obj.(type) {
+	case *configv2.ApisixService:
+		return &apisixService{
+			groupVersion: config.ApisixV2,
+			v2:           asvc,
+			Object:       asvc.GetObjectMeta(),
+		}
+	default:
+		panic("invalid ApisixService type")
+	}
+}
+
+// NewApisixService creates a kube.ApisixService object according to the
+// type of obj. It returns nil and the error reason when the
+// type assertion fails.
+func NewApisixService(obj interface{}) (ApisixService, error) {
+	switch asvc := obj.(type) {
+	case *configv2.ApisixService:
+		return &apisixService{
+			groupVersion: config.ApisixV2,
+			v2:           asvc,
+			Object:       asvc.GetObjectMeta(),
+		}, nil
+	default:
+		return nil, errors.New("invalid ApisixService type")
+	}
+}
+
+func NewApisixServiceLister(apiVersion string, v2 listersv2.ApisixServiceLister) ApisixServiceLister {
+	return &apisixServiceLister{
+		groupVersion: apiVersion,
+		v2Lister:     v2,
+	}
+}
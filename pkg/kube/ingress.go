@@ -20,6 +20,9 @@ import (
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
 	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	listersextensionsv1beta1 "k8s.io/client-go/listers/extensions/v1beta1"
 	listersnetworkingv1 "k8s.io/client-go/listers/networking/v1"
 	listersnetworkingv1beta1 "k8s.io/client-go/listers/networking/v1beta1"
@@ -43,6 +46,12 @@ type IngressLister interface {
 	V1beta1(string, string) (Ingress, error)
 	// ExtensionsV1beta1 gets the ingress in extensions/v1beta1.
 	ExtensionsV1beta1(string, string) (Ingress, error)
+	// ListV1 lists the ingresses in networking/v1 of the namespace.
+	ListV1(string) ([]Ingress, error)
+	// ListV1beta1 lists the ingresses in networking/v1beta1 of the namespace.
+	ListV1beta1(string) ([]Ingress, error)
+	// ListExtensionsV1beta1 lists the ingresses in extensions/v1beta1 of the namespace.
+	ListExtensionsV1beta1(string) ([]Ingress, error)
 }
 
 // IngressInformer is an encapsulation for the informer of Kubernetes
@@ -69,6 +78,14 @@ type Ingress interface {
 	// ResourceVersion returns the the resource version field inside
 	// the real Ingress.
 	ResourceVersion() string
+	// Object returns the real Ingress, regardless of its group version.
+	Object() IngressObject
+}
+
+// IngressObject is the real Ingress in any group version.
+type IngressObject interface {
+	metav1.Object
+	runtime.Object
 }
 
 // IngressEvents contains the ingress key (namespace/name)
@@ -112,13 +129,18 @@ func (ing *ingress) GroupVersion() string {
 }
 
 func (ing *ingress) ResourceVersion() string {
-	if ing.GroupVersion() == IngressV1 {
-		return ing.V1().ResourceVersion
-	}
-	if ing.GroupVersion() == IngressV1beta1 {
-		return ing.V1beta1().ResourceVersion
+	return ing.Object().GetResourceVersion()
+}
+
+func (ing *ingress) Object() IngressObject {
+	switch ing.groupVersion {
+	case IngressV1:
+		return ing.v1
+	case IngressV1beta1:
+		return ing.v1beta1
+	default:
+		return ing.extensionsV1beta1
 	}
-	return ing.ExtensionsV1beta1().ResourceVersion
 }
 
 type ingressLister struct {
@@ -160,6 +182,51 @@ func (l *ingressLister) ExtensionsV1beta1(namespace, name string) (Ingress, erro
 	}, nil
 }
 
+func (l *ingressLister) ListV1(namespace string) ([]Ingress, error) {
+	list, err := l.v1Lister.Ingresses(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ings := make([]Ingress, 0, len(list))
+	for _, ing := range list {
+		ings = append(ings, &ingress{
+			groupVersion: IngressV1,
+			v1:           ing,
+		})
+	}
+	return ings, nil
+}
+
+func (l *ingressLister) ListV1beta1(namespace string) ([]Ingress, error) {
+	list, err := l.v1beta1Lister.Ingresses(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ings := make([]Ingress, 0, len(list))
+	for _, ing := range list {
+		ings = append(ings, &ingress{
+			groupVersion: IngressV1beta1,
+			v1beta1:      ing,
+		})
+	}
+	return ings, nil
+}
+
+func (l *ingressLister) ListExtensionsV1beta1(namespace string) ([]Ingress, error) {
+	list, err := l.extensionsV1beta1Lister.Ingresses(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ings := make([]Ingress, 0, len(list))
+	for _, ing := range list {
+		ings = append(ings, &ingress{
+			groupVersion:      IngressExtensionsV1beta1,
+			extensionsV1beta1: ing,
+		})
+	}
+	return ings, nil
+}
+
 // MustNewIngress creates a kube.Ingress object according to the
 // type of obj.
 func MustNewIngress(obj interface{}) Ingress {
@@ -50,6 +50,12 @@ type Collector interface {
 	// IncrEvents increases the number of events handled by controllers with the
 	// operation label.
 	IncrEvents(string, string)
+	// SetDriftObjects sets the number of APISIX objects which drift from the
+	// desired state with the cluster name, resource type and drift type labels.
+	SetDriftObjects(string, string, string, int)
+	// IncrDriftCorrection increases the number of drifted objects which are
+	// corrected with the resource type and action labels.
+	IncrDriftCorrection(string, string)
 }
 
 // collector contains necessary messages to collect Prometheus metrics.
@@ -62,6 +68,8 @@ type collector struct {
 	syncOperation      *prometheus.CounterVec
 	cacheSyncOperation *prometheus.CounterVec
 	controllerEvents   *prometheus.CounterVec
+	driftObjects       *prometheus.GaugeVec
+	driftCorrection    *prometheus.CounterVec
 }
 
 // NewPrometheusCollector creates the Prometheus metrics collector.
@@ -150,6 +158,24 @@ func NewPrometheusCollector() Collector {
 			},
 			[]string{"operation", "resource"},
 		),
+		driftObjects: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace:   _namespace,
+				Name:        "drift_objects",
+				Help:        "Number of APISIX objects drifted from the desired state",
+				ConstLabels: constLabels,
+			},
+			[]string{"cluster", "resource", "type"},
+		),
+		driftCorrection: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace:   _namespace,
+				Name:        "drift_correction_total",
+				Help:        "Number of drifted APISIX objects corrected by the controller",
+				ConstLabels: constLabels,
+			},
+			[]string{"resource", "action"},
+		),
 	}
 
 	// Since we use the DefaultRegisterer, in test cases, the metrics
@@ -162,6 +188,8 @@ func NewPrometheusCollector() Collector {
 	prometheus.Unregister(collector.syncOperation)
 	prometheus.Unregister(collector.cacheSyncOperation)
 	prometheus.Unregister(collector.controllerEvents)
+	prometheus.Unregister(collector.driftObjects)
+	prometheus.Unregister(collector.driftCorrection)
 
 	prometheus.MustRegister(
 		collector.isLeader,
@@ -172,6 +200,8 @@ func NewPrometheusCollector() Collector {
 		collector.syncOperation,
 		collector.cacheSyncOperation,
 		collector.controllerEvents,
+		collector.driftObjects,
+		collector.driftCorrection,
 	)
 
 	return collector
@@ -237,6 +267,25 @@ func (c *collector) IncrEvents(resource, operation string) {
 	}).Inc()
 }
 
+// SetDriftObjects sets the number of drifted objects for specific cluster,
+// resource and drift type (e.g. modified, missing and orphaned).
+func (c *collector) SetDriftObjects(cluster, resource, driftType string, count int) {
+	c.driftObjects.With(prometheus.Labels{
+		"cluster":  cluster,
+		"resource": resource,
+		"type":     driftType,
+	}).Set(float64(count))
+}
+
+// IncrDriftCorrection increases the number of drifted objects corrected
+// for specific resource and action.
+func (c *collector) IncrDriftCorrection(resource, action string) {
+	c.driftCorrection.With(prometheus.Labels{
+		"action":   action,
+		"resource": resource,
+	}).Inc()
+}
+
 // Collect collects the prometheus.Collect.
 func (c *collector) Collect(ch chan<- prometheus.Metric) {
 	c.isLeader.Collect(ch)
@@ -247,6 +296,8 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 	c.syncOperation.Collect(ch)
 	c.cacheSyncOperation.Collect(ch)
 	c.controllerEvents.Collect(ch)
+	c.driftObjects.Collect(ch)
+	c.driftCorrection.Collect(ch)
 }
 
 // Describe describes the prometheus.Describe.
@@ -259,4 +310,6 @@ func (c *collector) Describe(ch chan<- *prometheus.Desc) {
 	c.syncOperation.Describe(ch)
 	c.cacheSyncOperation.Describe(ch)
 	c.controllerEvents.Describe(ch)
+	c.driftObjects.Describe(ch)
+	c.driftCorrection.Describe(ch)
 }
@@ -200,6 +200,48 @@ func controllerEventsTestHandler(t *testing.T, metrics []*io_prometheus_client.M
 	}
 }
 
+func driftObjectsTestHandler(t *testing.T, metrics []*io_prometheus_client.MetricFamily) func(t *testing.T) {
+	return func(t *testing.T) {
+		metric := findMetric("apisix_ingress_controller_drift_objects", metrics)
+		assert.NotNil(t, metric)
+		assert.Equal(t, metric.Type.String(), "GAUGE")
+		m := metric.GetMetric()
+		assert.Len(t, m, 1)
+
+		assert.Equal(t, *m[0].Gauge.Value, float64(2))
+		assert.Equal(t, *m[0].Label[0].Name, "cluster")
+		assert.Equal(t, *m[0].Label[0].Value, "default")
+		assert.Equal(t, *m[0].Label[1].Name, "controller_namespace")
+		assert.Equal(t, *m[0].Label[1].Value, "default")
+		assert.Equal(t, *m[0].Label[2].Name, "controller_pod")
+		assert.Equal(t, *m[0].Label[2].Value, "")
+		assert.Equal(t, *m[0].Label[3].Name, "resource")
+		assert.Equal(t, *m[0].Label[3].Value, "route")
+		assert.Equal(t, *m[0].Label[4].Name, "type")
+		assert.Equal(t, *m[0].Label[4].Value, "modified")
+	}
+}
+
+func driftCorrectionTestHandler(t *testing.T, metrics []*io_prometheus_client.MetricFamily) func(t *testing.T) {
+	return func(t *testing.T) {
+		metric := findMetric("apisix_ingress_controller_drift_correction_total", metrics)
+		assert.NotNil(t, metric)
+		assert.Equal(t, metric.Type.String(), "COUNTER")
+		m := metric.GetMetric()
+		assert.Len(t, m, 1)
+
+		assert.Equal(t, *m[0].Counter.Value, float64(1))
+		assert.Equal(t, *m[0].Label[0].Name, "action")
+		assert.Equal(t, *m[0].Label[0].Value, "update")
+		assert.Equal(t, *m[0].Label[1].Name, "controller_namespace")
+		assert.Equal(t, *m[0].Label[1].Value, "default")
+		assert.Equal(t, *m[0].Label[2].Name, "controller_pod")
+		assert.Equal(t, *m[0].Label[2].Value, "")
+		assert.Equal(t, *m[0].Label[3].Name, "resource")
+		assert.Equal(t, *m[0].Label[3].Value, "route")
+	}
+}
+
 func TestPrometheusCollector(t *testing.T) {
 	c := NewPrometheusCollector()
 	c.ResetLeader(true)
@@ -214,6 +256,8 @@ func TestPrometheusCollector(t *testing.T) {
 	c.IncrSyncOperation("endpoint", "success")
 	c.IncrCacheSyncOperation("failure")
 	c.IncrEvents("pod", "add")
+	c.SetDriftObjects("default", "route", "modified", 2)
+	c.IncrDriftCorrection("route", "update")
 
 	metrics, err := prometheus.DefaultGatherer.Gather()
 	assert.Nil(t, err)
@@ -226,6 +270,8 @@ func TestPrometheusCollector(t *testing.T) {
 	t.Run("sync_operation_total", syncOperationTestHandler(t, metrics))
 	t.Run("cache_sync_total", cacheSncOperationTestHandler(t, metrics))
 	t.Run("events_total", controllerEventsTestHandler(t, metrics))
+	t.Run("drift_objects", driftObjectsTestHandler(t, metrics))
+	t.Run("drift_correction_total", driftCorrectionTestHandler(t, metrics))
 }
 
 func findMetric(name string, metrics []*io_prometheus_client.MetricFamily) *io_prometheus_client.MetricFamily {
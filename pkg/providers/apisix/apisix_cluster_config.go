@@ -15,6 +15,7 @@
 package apisix
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
@@ -34,22 +35,33 @@ import (
 	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
 	configv2beta3 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2beta3"
 	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
 	"github.com/apache/apisix-ingress-controller/pkg/types"
 )
 
+const (
+	// _tlsFilesCheckInterval is the interval to check whether the TLS
+	// files of the default cluster changed.
+	_tlsFilesCheckInterval = 10 * time.Second
+)
+
 type apisixClusterConfigController struct {
 	*apisixCommon
 
 	workqueue workqueue.RateLimitingInterface
 	workers   int
+
+	// onClusterChange is called after a non-default cluster was added or updated.
+	onClusterChange func()
 }
 
-func newApisixClusterConfigController(common *apisixCommon) *apisixClusterConfigController {
+func newApisixClusterConfigController(common *apisixCommon, onClusterChange func()) *apisixClusterConfigController {
 	c := &apisixClusterConfigController{
-		apisixCommon: common,
-		workqueue:    workqueue.NewNamedRateLimitingQueue(workqueue.NewItemFastSlowRateLimiter(time.Second, 60*time.Second, 5), "ApisixClusterConfig"),
-		workers:      1,
+		apisixCommon:    common,
+		workqueue:       workqueue.NewNamedRateLimitingQueue(workqueue.NewItemFastSlowRateLimiter(time.Second, 60*time.Second, 5), "ApisixClusterConfig"),
+		workers:         1,
+		onClusterChange: onClusterChange,
 	}
 	c.ApisixClusterConfigInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
@@ -69,6 +81,7 @@ func (c *apisixClusterConfigController) run(ctx context.Context) {
 	for i := 0; i < c.workers; i++ {
 		go c.runWorker(ctx)
 	}
+	go c.syncDefaultClusterTLSFiles(ctx)
 	<-ctx.Done()
 }
 
@@ -134,43 +147,63 @@ func (c *apisixClusterConfigController) sync(ctx context.Context, ev *types.Even
 	switch event.GroupVersion {
 	case config.ApisixV2beta3:
 		acc := multiVersioned.V2beta3()
-		// Currently we don't handle multiple cluster, so only process
-		// the default apisix cluster.
-		if acc.Name != c.Config.APISIX.DefaultClusterName {
-			log.Infow("ignore non-default apisix cluster config",
+		if acc.Name == c.Config.APISIX.DefaultClusterName && ev.Type == types.EventDelete {
+			// Cluster delete is dangerous, the default cluster is always kept.
+			log.Error("ApisixClusterConfig delete event for default apisix cluster will be ignored")
+			return nil
+		}
+		if acc.Name != c.Config.APISIX.DefaultClusterName && acc.Spec.Admin == nil {
+			log.Infow("ignore non-default apisix cluster config without admin config",
 				zap.String("default_cluster_name", c.Config.APISIX.DefaultClusterName),
 				zap.Any("ApisixClusterConfig", acc),
 			)
 			return nil
 		}
-		// Cluster delete is dangerous.
-		// TODO handle delete?
-		if ev.Type == types.EventDelete {
-			log.Error("ApisixClusterConfig delete event for default apisix cluster will be ignored")
-			return nil
-		}
 
 		if acc.Spec.Admin != nil {
+			adminKey := acc.Spec.Admin.AdminKey
+			if ref := acc.Spec.Admin.AdminKeySecretRef; ref != nil && ev.Type != types.EventDelete {
+				adminKey, err = c.getAdminKey(ref.Namespace, ref.Name, ref.Key)
+				if err != nil {
+					log.Errorw("failed to get admin key",
+						zap.String("cluster_name", acc.Name),
+						zap.Error(err),
+					)
+					c.RecordEvent(acc, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
+					c.recordStatus(acc, utils.ResourceSyncAborted, err, metav1.ConditionFalse, acc.GetGeneration())
+					return err
+				}
+			}
 			clusterOpts := &apisix.ClusterOptions{
-				Name:     acc.Name,
-				BaseURL:  acc.Spec.Admin.BaseURL,
-				AdminKey: acc.Spec.Admin.AdminKey,
+				Name:             acc.Name,
+				BaseURL:          acc.Spec.Admin.BaseURL,
+				AdminKey:         adminKey,
+				Timeout:          acc.Spec.Admin.ClientTimeout.Duration,
+				MetricsCollector: c.MetricsCollector,
 			}
-			log.Infow("updating cluster",
-				zap.Any("opts", clusterOpts),
-			)
-			// TODO we may first call AddCluster.
-			// Since we already have the default cluster, we just call UpdateCluster.
-			if err := c.APISIX.UpdateCluster(ctx, clusterOpts); err != nil {
-				log.Errorw("failed to update cluster",
+			if tlsConfig := acc.Spec.Admin.TLS; tlsConfig != nil && ev.Type != types.EventDelete {
+				if err := c.setClusterTLS(clusterOpts, tlsConfig.ServerName, secretKeyV2beta3(tlsConfig.CASecret), secretKeyV2beta3(tlsConfig.ClientCertSecret)); err != nil {
+					log.Errorw("failed to get admin api tls config",
+						zap.String("cluster_name", acc.Name),
+						zap.Error(err),
+					)
+					c.RecordEvent(acc, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
+					c.recordStatus(acc, utils.ResourceSyncAborted, err, metav1.ConditionFalse, acc.GetGeneration())
+					return err
+				}
+			}
+			if err := c.syncCluster(ctx, ev.Type, clusterOpts); err != nil {
+				log.Errorw("failed to sync cluster",
 					zap.String("cluster_name", acc.Name),
 					zap.Error(err),
-					zap.Any("opts", clusterOpts),
 				)
 				c.RecordEvent(acc, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
 				c.recordStatus(acc, utils.ResourceSyncAborted, err, metav1.ConditionFalse, acc.GetGeneration())
 				return err
 			}
+			if ev.Type == types.EventDelete {
+				return nil
+			}
 		}
 
 		globalRule, err := c.translator.TranslateClusterConfigV2beta3(acc)
@@ -188,7 +221,6 @@ func (c *apisixClusterConfigController) sync(ctx context.Context, ev *types.Even
 			zap.Any("object", globalRule),
 		)
 
-		// TODO multiple cluster support
 		if ev.Type == types.EventAdd {
 			_, err = c.APISIX.Cluster(acc.Name).GlobalRule().Create(ctx, globalRule)
 		} else {
@@ -208,43 +240,63 @@ func (c *apisixClusterConfigController) sync(ctx context.Context, ev *types.Even
 		return nil
 	case config.ApisixV2:
 		acc := multiVersioned.V2()
-		// Currently we don't handle multiple cluster, so only process
-		// the default apisix cluster.
-		if acc.Name != c.Config.APISIX.DefaultClusterName {
-			log.Infow("ignore non-default apisix cluster config",
+		if acc.Name == c.Config.APISIX.DefaultClusterName && ev.Type == types.EventDelete {
+			// Cluster delete is dangerous, the default cluster is always kept.
+			log.Error("ApisixClusterConfig delete event for default apisix cluster will be ignored")
+			return nil
+		}
+		if acc.Name != c.Config.APISIX.DefaultClusterName && acc.Spec.Admin == nil {
+			log.Infow("ignore non-default apisix cluster config without admin config",
 				zap.String("default_cluster_name", c.Config.APISIX.DefaultClusterName),
 				zap.Any("ApisixClusterConfig", acc),
 			)
 			return nil
 		}
-		// Cluster delete is dangerous.
-		// TODO handle delete?
-		if ev.Type == types.EventDelete {
-			log.Error("ApisixClusterConfig delete event for default apisix cluster will be ignored")
-			return nil
-		}
 
 		if acc.Spec.Admin != nil {
+			adminKey := acc.Spec.Admin.AdminKey
+			if ref := acc.Spec.Admin.AdminKeySecretRef; ref != nil && ev.Type != types.EventDelete {
+				adminKey, err = c.getAdminKey(ref.Namespace, ref.Name, ref.Key)
+				if err != nil {
+					log.Errorw("failed to get admin key",
+						zap.String("cluster_name", acc.Name),
+						zap.Error(err),
+					)
+					c.RecordEvent(acc, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
+					c.recordStatus(acc, utils.ResourceSyncAborted, err, metav1.ConditionFalse, acc.GetGeneration())
+					return err
+				}
+			}
 			clusterOpts := &apisix.ClusterOptions{
-				Name:     acc.Name,
-				BaseURL:  acc.Spec.Admin.BaseURL,
-				AdminKey: acc.Spec.Admin.AdminKey,
+				Name:             acc.Name,
+				BaseURL:          acc.Spec.Admin.BaseURL,
+				AdminKey:         adminKey,
+				Timeout:          acc.Spec.Admin.ClientTimeout.Duration,
+				MetricsCollector: c.MetricsCollector,
 			}
-			log.Infow("updating cluster",
-				zap.Any("opts", clusterOpts),
-			)
-			// TODO we may first call AddCluster.
-			// Since now we already have the default cluster, we just call UpdateCluster.
-			if err := c.APISIX.UpdateCluster(ctx, clusterOpts); err != nil {
-				log.Errorw("failed to update cluster",
+			if tlsConfig := acc.Spec.Admin.TLS; tlsConfig != nil && ev.Type != types.EventDelete {
+				if err := c.setClusterTLS(clusterOpts, tlsConfig.ServerName, secretKeyV2(tlsConfig.CASecret), secretKeyV2(tlsConfig.ClientCertSecret)); err != nil {
+					log.Errorw("failed to get admin api tls config",
+						zap.String("cluster_name", acc.Name),
+						zap.Error(err),
+					)
+					c.RecordEvent(acc, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
+					c.recordStatus(acc, utils.ResourceSyncAborted, err, metav1.ConditionFalse, acc.GetGeneration())
+					return err
+				}
+			}
+			if err := c.syncCluster(ctx, ev.Type, clusterOpts); err != nil {
+				log.Errorw("failed to sync cluster",
 					zap.String("cluster_name", acc.Name),
 					zap.Error(err),
-					zap.Any("opts", clusterOpts),
 				)
 				c.RecordEvent(acc, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
 				c.recordStatus(acc, utils.ResourceSyncAborted, err, metav1.ConditionFalse, acc.GetGeneration())
 				return err
 			}
+			if ev.Type == types.EventDelete {
+				return nil
+			}
 		}
 
 		globalRule, err := c.translator.TranslateClusterConfigV2(acc)
@@ -262,7 +314,6 @@ func (c *apisixClusterConfigController) sync(ctx context.Context, ev *types.Even
 			zap.Any("object", globalRule),
 		)
 
-		// TODO multiple cluster support
 		if ev.Type == types.EventAdd {
 			_, err = c.APISIX.Cluster(acc.Name).GlobalRule().Create(ctx, globalRule)
 		} else {
@@ -285,6 +336,132 @@ func (c *apisixClusterConfigController) sync(ctx context.Context, ev *types.Even
 	}
 }
 
+// getAdminKey gets the admin key from the Secret.
+func (c *apisixClusterConfigController) getAdminKey(namespace, name, key string) (string, error) {
+	if key == "" {
+		key = config.DefaultAdminKeySecretKey
+	}
+	secret, err := c.SecretLister.Secrets(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+	return utils.AdminKeyFromSecret(secret, key)
+}
+
+// setClusterTLS sets the TLS options of the cluster, the CA bundle and the
+// client key pair are read from the Secrets in the format of "namespace/name".
+func (c *apisixClusterConfigController) setClusterTLS(clusterOpts *apisix.ClusterOptions, serverName, caSecret, clientCertSecret string) error {
+	clusterOpts.ServerName = serverName
+	if caSecret != "" {
+		secret, err := c.getSecret(caSecret)
+		if err != nil {
+			return err
+		}
+		clusterOpts.CACert, _, err = translation.ExtractKeyPair(secret, false)
+		if err != nil {
+			return fmt.Errorf("invalid CA secret %s: %s", caSecret, err)
+		}
+	}
+	if clientCertSecret != "" {
+		secret, err := c.getSecret(clientCertSecret)
+		if err != nil {
+			return err
+		}
+		clusterOpts.ClientCert, clusterOpts.ClientKey, err = translation.ExtractKeyPair(secret, true)
+		if err != nil {
+			return fmt.Errorf("invalid client cert secret %s: %s", clientCertSecret, err)
+		}
+	}
+	return nil
+}
+
+func (c *apisixClusterConfigController) getSecret(key string) (*corev1.Secret, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return c.SecretLister.Secrets(namespace).Get(name)
+}
+
+func secretKeyV2beta3(secret *configv2beta3.ApisixSecret) string {
+	if secret == nil {
+		return ""
+	}
+	return secret.Namespace + "/" + secret.Name
+}
+
+func secretKeyV2(secret *configv2.ApisixSecret) string {
+	if secret == nil {
+		return ""
+	}
+	return secret.Namespace + "/" + secret.Name
+}
+
+// adminSecretsV2beta3 returns all Secrets referenced by the admin config.
+func adminSecretsV2beta3(admin *configv2beta3.ApisixClusterAdminConfig) []string {
+	var secrets []string
+	if admin.AdminKeySecretRef != nil {
+		secrets = append(secrets, admin.AdminKeySecretRef.Namespace+"/"+admin.AdminKeySecretRef.Name)
+	}
+	if admin.TLS != nil {
+		secrets = append(secrets, secretKeyV2beta3(admin.TLS.CASecret), secretKeyV2beta3(admin.TLS.ClientCertSecret))
+	}
+	return secrets
+}
+
+// adminSecretsV2 returns all Secrets referenced by the admin config.
+func adminSecretsV2(admin *configv2.ApisixClusterAdminConfig) []string {
+	var secrets []string
+	if admin.AdminKeySecretRef != nil {
+		secrets = append(secrets, admin.AdminKeySecretRef.Namespace+"/"+admin.AdminKeySecretRef.Name)
+	}
+	if admin.TLS != nil {
+		secrets = append(secrets, secretKeyV2(admin.TLS.CASecret), secretKeyV2(admin.TLS.ClientCertSecret))
+	}
+	return secrets
+}
+
+// syncCluster registers, updates or deletes the APISIX cluster according
+// to the ApisixClusterConfig event.
+func (c *apisixClusterConfigController) syncCluster(ctx context.Context, evType types.EventType, clusterOpts *apisix.ClusterOptions) error {
+	if clusterOpts.Name == c.Config.APISIX.DefaultClusterName && c.Config.APISIX.ConfigProvider == config.ConfigProviderYAML {
+		log.Warnw("admin config of the default cluster is ignored since the config provider is yaml",
+			zap.String("cluster_name", clusterOpts.Name),
+		)
+		return nil
+	}
+	if evType == types.EventDelete {
+		log.Infow("deleting cluster",
+			zap.String("cluster_name", clusterOpts.Name),
+		)
+		c.APISIX.DeleteCluster(clusterOpts.Name)
+		return nil
+	}
+
+	log.Infow("updating cluster",
+		zap.String("cluster_name", clusterOpts.Name),
+		zap.String("base_url", clusterOpts.BaseURL),
+	)
+	err := c.APISIX.UpdateCluster(ctx, clusterOpts)
+	if err == apisix.ErrClusterNotExist {
+		log.Infow("adding cluster",
+			zap.String("cluster_name", clusterOpts.Name),
+		)
+		err = c.APISIX.AddCluster(ctx, clusterOpts)
+	}
+	if err != nil {
+		return err
+	}
+	if err := c.APISIX.Cluster(clusterOpts.Name).HasSynced(ctx); err != nil {
+		return err
+	}
+	// Resources which select this cluster should be pushed to it again.
+	if clusterOpts.Name != c.Config.APISIX.DefaultClusterName && c.onClusterChange != nil {
+		c.onClusterChange()
+	}
+	return nil
+}
+
 func (c *apisixClusterConfigController) handleSyncErr(obj interface{}, err error) {
 	if err == nil {
 		c.workqueue.Forget(obj)
@@ -493,3 +670,151 @@ func (c *apisixClusterConfigController) recordStatus(at interface{}, reason stri
 		log.Errorf("unsupported resource record: %s", v)
 	}
 }
+
+// SyncSecretChange re-creates the APISIX clusters whose admin key or TLS materials
+// are stored in the changed Secret, so that the rotated credentials take effect.
+func (c *apisixClusterConfigController) SyncSecretChange(ctx context.Context, ev *types.Event, secret *corev1.Secret, secretMapKey string) {
+	if ev.Type == types.EventDelete {
+		// Keep using the current admin key until the Secret is re-created.
+		return
+	}
+
+	if secretMapKey == c.Config.APISIX.DefaultClusterAdminKeySecret {
+		if err := c.syncDefaultClusterAdminKey(ctx, secret); err != nil {
+			log.Errorw("failed to sync admin key of the default cluster",
+				zap.String("secret", secretMapKey),
+				zap.Error(err),
+			)
+		}
+	}
+
+	objs, err := c.ApisixClusterConfigInformer.GetIndexer().ByIndex(_secretIndex, secretMapKey)
+	if err != nil {
+		log.Errorw("failed to list ApisixClusterConfigs referring to secret",
+			zap.String("secret", secretMapKey),
+			zap.Error(err),
+		)
+		return
+	}
+	for _, obj := range objs {
+		acc, err := kube.NewApisixClusterConfig(obj)
+		if err != nil {
+			continue
+		}
+		var name string
+		switch acc.GroupVersion() {
+		case config.ApisixV2beta3:
+			name = acc.V2beta3().Name
+		case config.ApisixV2:
+			name = acc.V2().Name
+		}
+		log.Infow("admin secret changed, re-sync ApisixClusterConfig",
+			zap.String("secret", secretMapKey),
+			zap.String("ApisixClusterConfig", name),
+		)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventUpdate,
+			Object: kube.ApisixClusterConfigEvent{
+				Key:          name,
+				GroupVersion: acc.GroupVersion(),
+			},
+		})
+	}
+}
+
+// syncDefaultClusterAdminKey re-creates the default cluster with the admin key
+// in the Secret.
+func (c *apisixClusterConfigController) syncDefaultClusterAdminKey(ctx context.Context, secret *corev1.Secret) error {
+	adminKey, err := utils.AdminKeyFromSecret(secret, c.Config.APISIX.DefaultClusterAdminKeySecretKey)
+	if err != nil {
+		return err
+	}
+	if adminKey == c.Config.APISIX.GetDefaultClusterAdminKey() {
+		return nil
+	}
+	c.Config.APISIX.SetDefaultClusterAdminKey(adminKey)
+
+	clusterOpts, err := utils.DefaultClusterOptions(&c.Config.APISIX, c.MetricsCollector)
+	if err != nil {
+		return err
+	}
+	log.Infow("admin key of the default cluster changed",
+		zap.String("cluster_name", c.Config.APISIX.DefaultClusterName),
+	)
+	return c.syncDefaultCluster(ctx, clusterOpts)
+}
+
+// syncDefaultClusterTLSFiles watches the TLS files of the default cluster
+// and re-creates the cluster once their contents change.
+func (c *apisixClusterConfigController) syncDefaultClusterTLSFiles(ctx context.Context) {
+	if c.Config.APISIX.DefaultClusterCACertFile == "" && c.Config.APISIX.DefaultClusterClientCertFile == "" {
+		return
+	}
+	last, err := utils.DefaultClusterOptions(&c.Config.APISIX, c.MetricsCollector)
+	if err != nil {
+		log.Errorw("failed to read TLS files of the default cluster",
+			zap.Error(err),
+		)
+	}
+
+	ticker := time.NewTicker(_tlsFilesCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		clusterOpts, err := utils.DefaultClusterOptions(&c.Config.APISIX, c.MetricsCollector)
+		if err != nil {
+			log.Errorw("failed to read TLS files of the default cluster",
+				zap.Error(err),
+			)
+			continue
+		}
+		if last != nil && bytes.Equal(last.CACert, clusterOpts.CACert) &&
+			bytes.Equal(last.ClientCert, clusterOpts.ClientCert) && bytes.Equal(last.ClientKey, clusterOpts.ClientKey) {
+			continue
+		}
+		last = clusterOpts
+
+		log.Infow("TLS files of the default cluster changed",
+			zap.String("cluster_name", c.Config.APISIX.DefaultClusterName),
+		)
+		if err := c.syncDefaultCluster(ctx, clusterOpts); err != nil {
+			log.Errorw("failed to re-create the default cluster",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// syncDefaultCluster re-creates the default cluster, unless the admin config
+// is overridden by ApisixClusterConfig.
+func (c *apisixClusterConfigController) syncDefaultCluster(ctx context.Context, clusterOpts *apisix.ClusterOptions) error {
+	if c.Config.APISIX.ConfigProvider == config.ConfigProviderYAML {
+		return nil
+	}
+	var (
+		acc kube.ApisixClusterConfig
+		err error
+	)
+	switch c.Kubernetes.APIVersion {
+	case config.ApisixV2beta3:
+		acc, err = c.ApisixClusterConfigLister.V2beta3(c.Config.APISIX.DefaultClusterName)
+		if err == nil && acc.V2beta3().Spec.Admin != nil {
+			return nil
+		}
+	case config.ApisixV2:
+		acc, err = c.ApisixClusterConfigLister.V2(c.Config.APISIX.DefaultClusterName)
+		if err == nil && acc.V2().Spec.Admin != nil {
+			return nil
+		}
+	}
+
+	log.Infow("re-creating the default cluster",
+		zap.String("cluster_name", c.Config.APISIX.DefaultClusterName),
+	)
+	return c.APISIX.UpdateCluster(ctx, clusterOpts)
+}
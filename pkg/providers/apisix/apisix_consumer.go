@@ -150,14 +150,16 @@ func (c *apisixConsumerController) sync(ctx context.Context, ev *types.Event) er
 			zap.Any("consumer", consumer),
 			zap.Any("ApisixConsumer", ac),
 		)
+		consumer.Labels = utils.OwnerLabels(consumer.Labels, c.Config.Kubernetes.ElectionID, "ApisixConsumer", key)
 
 		if err := c.SyncConsumer(ctx, consumer, ev.Type); err != nil {
 			log.Errorw("failed to sync Consumer to APISIX",
 				zap.Error(err),
 				zap.Any("consumer", consumer),
 			)
-			c.RecordEvent(ac, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
-			c.recordStatus(ac, utils.ResourceSyncAborted, err, metav1.ConditionFalse, ac.GetGeneration())
+			reason := utils.SyncFailedReason(err)
+			c.RecordEvent(ac, corev1.EventTypeWarning, reason, err)
+			c.recordStatus(ac, reason, err, metav1.ConditionFalse, ac.GetGeneration())
 			return err
 		}
 
@@ -179,14 +181,16 @@ func (c *apisixConsumerController) sync(ctx context.Context, ev *types.Event) er
 			zap.Any("consumer", consumer),
 			zap.Any("ApisixConsumer", ac),
 		)
+		consumer.Labels = utils.OwnerLabels(consumer.Labels, c.Config.Kubernetes.ElectionID, "ApisixConsumer", key)
 
 		if err := c.SyncConsumer(ctx, consumer, ev.Type); err != nil {
 			log.Errorw("failed to sync Consumer to APISIX",
 				zap.Error(err),
 				zap.Any("consumer", consumer),
 			)
-			c.RecordEvent(ac, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
-			c.recordStatus(ac, utils.ResourceSyncAborted, err, metav1.ConditionFalse, ac.GetGeneration())
+			reason := utils.SyncFailedReason(err)
+			c.RecordEvent(ac, corev1.EventTypeWarning, reason, err)
+			c.recordStatus(ac, reason, err, metav1.ConditionFalse, ac.GetGeneration())
 			return err
 		}
 
@@ -349,6 +353,29 @@ func (c *apisixConsumerController) ResourceSync() {
 	}
 }
 
+// NotifySecretChange re-syncs the ApisixConsumers which refer to the changed Secret
+// as the credentials.
+func (c *apisixConsumerController) NotifySecretChange(secretKey string) {
+	for _, obj := range c.secretDependents(c.ApisixConsumerInformer, secretKey) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			continue
+		}
+		log.Infow("secret changed, re-sync ApisixConsumer",
+			zap.String("secret", secretKey),
+			zap.String("ApisixConsumer", key),
+		)
+		ac := kube.MustNewApisixConsumer(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixConsumerEvent{
+				Key:          key,
+				GroupVersion: ac.GroupVersion(),
+			},
+		})
+	}
+}
+
 // recordStatus record resources status
 func (c *apisixConsumerController) recordStatus(at interface{}, reason string, err error, status metav1.ConditionStatus, generation int64) {
 	if c.Kubernetes.DisableStatusUpdates {
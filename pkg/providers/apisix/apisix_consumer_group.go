@@ -0,0 +1,397 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type apisixConsumerGroupController struct {
+	*apisixCommon
+
+	workqueue workqueue.RateLimitingInterface
+	workers   int
+}
+
+func newApisixConsumerGroupController(common *apisixCommon) *apisixConsumerGroupController {
+	c := &apisixConsumerGroupController{
+		apisixCommon: common,
+		workqueue:    workqueue.NewNamedRateLimitingQueue(workqueue.NewItemFastSlowRateLimiter(1*time.Second, 60*time.Second, 5), "ApisixConsumerGroup"),
+		workers:      1,
+	}
+
+	c.ApisixConsumerGroupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.onAdd,
+			UpdateFunc: c.onUpdate,
+			DeleteFunc: c.onDelete,
+		},
+	)
+	return c
+}
+
+func (c *apisixConsumerGroupController) run(ctx context.Context) {
+	log.Info("ApisixConsumerGroup controller started")
+	defer log.Info("ApisixConsumerGroup controller exited")
+	defer c.workqueue.ShutDown()
+
+	for i := 0; i < c.workers; i++ {
+		go c.runWorker(ctx)
+	}
+	<-ctx.Done()
+}
+
+func (c *apisixConsumerGroupController) runWorker(ctx context.Context) {
+	for {
+		obj, quit := c.workqueue.Get()
+		if quit {
+			return
+		}
+		err := c.sync(ctx, obj.(*types.Event))
+		c.workqueue.Done(obj)
+		c.handleSyncErr(obj, err)
+	}
+}
+
+func (c *apisixConsumerGroupController) sync(ctx context.Context, ev *types.Event) error {
+	obj := ev.Object.(kube.ApisixConsumerGroupEvent)
+	namespace, name, err := cache.SplitMetaNamespaceKey(obj.Key)
+	if err != nil {
+		log.Errorf("invalid resource key: %s", obj.Key)
+		return err
+	}
+	var (
+		acg kube.ApisixConsumerGroup
+	)
+	acg, err = c.ApisixConsumerGroupLister.ApisixConsumerGroup(namespace, name)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Errorw("failed to get ApisixConsumerGroup",
+				zap.String("version", obj.GroupVersion),
+				zap.String("key", obj.Key),
+				zap.Error(err),
+			)
+			return err
+		}
+
+		if ev.Type != types.EventDelete {
+			log.Warnw("ApisixConsumerGroup was deleted before it can be delivered",
+				zap.String("key", obj.Key),
+				zap.String("version", obj.GroupVersion),
+			)
+			return nil
+		}
+	}
+	if ev.Type == types.EventDelete {
+		if acg != nil {
+			// We still find the resource while we are processing the DELETE event,
+			// that means object with same namespace and name was created, discarding
+			// this stale DELETE event.
+			log.Warnw("discard the stale ApisixConsumerGroup delete event since the resource still exists",
+				zap.String("key", obj.Key),
+			)
+			return nil
+		}
+		acg = ev.Tombstone.(kube.ApisixConsumerGroup)
+	}
+
+	var cg *apisixv1.ConsumerGroup
+	if ev.Type == types.EventDelete {
+		cg = c.translator.GenerateApisixConsumerGroupV2DeleteMark(acg.V2())
+	} else {
+		cg, err = c.translator.TranslateApisixConsumerGroupV2(acg.V2())
+		if err != nil {
+			log.Errorw("failed to translate ApisixConsumerGroup",
+				zap.Error(err),
+				zap.Any("object", acg),
+			)
+			return err
+		}
+	}
+	cg.Labels = utils.OwnerLabels(cg.Labels, c.Config.Kubernetes.ElectionID, "ApisixConsumerGroup", obj.Key)
+
+	log.Debugw("sync ApisixConsumerGroup to cluster",
+		zap.String("event_type", ev.Type.String()),
+		zap.Any("consumer_group", cg),
+	)
+	return c.SyncConsumerGroup(ctx, cg, ev.Type)
+}
+
+func (c *apisixConsumerGroupController) handleSyncErr(obj interface{}, errOrigin error) {
+	ev := obj.(*types.Event)
+	event := ev.Object.(kube.ApisixConsumerGroupEvent)
+	if k8serrors.IsNotFound(errOrigin) && ev.Type != types.EventDelete {
+		log.Infow("sync ApisixConsumerGroup but not found, ignore",
+			zap.String("event_type", ev.Type.String()),
+			zap.String("ApisixConsumerGroup", ev.Object.(kube.ApisixConsumerGroupEvent).Key),
+		)
+		c.workqueue.Forget(event)
+		return
+	}
+	namespace, name, errLocal := cache.SplitMetaNamespaceKey(event.Key)
+	if errLocal != nil {
+		log.Errorf("invalid resource key: %s", event.Key)
+		c.MetricsCollector.IncrSyncOperation("ConsumerGroup", "failure")
+		return
+	}
+	var acg kube.ApisixConsumerGroup
+	switch event.GroupVersion {
+	case config.ApisixV2:
+		acg, errLocal = c.ApisixConsumerGroupLister.V2(namespace, name)
+	default:
+		errLocal = fmt.Errorf("unsupported ApisixConsumerGroup group version %s", event.GroupVersion)
+	}
+	if errOrigin == nil {
+		if ev.Type != types.EventDelete {
+			if errLocal == nil {
+				switch acg.GroupVersion() {
+				case config.ApisixV2:
+					c.RecordEvent(acg.V2(), v1.EventTypeNormal, utils.ResourceSynced, nil)
+					c.recordStatus(acg.V2(), utils.ResourceSynced, nil, metav1.ConditionTrue, acg.GetGeneration())
+				}
+			} else {
+				log.Errorw("failed list ApisixConsumerGroup",
+					zap.Error(errLocal),
+					zap.String("name", name),
+					zap.String("namespace", namespace),
+				)
+			}
+		}
+		c.workqueue.Forget(obj)
+		c.MetricsCollector.IncrSyncOperation("ConsumerGroup", "success")
+		return
+	}
+	log.Warnw("sync ApisixConsumerGroup failed, will retry",
+		zap.Any("object", obj),
+		zap.Error(errOrigin),
+	)
+	reason := utils.SyncFailedReason(errOrigin)
+	if errLocal == nil {
+		switch acg.GroupVersion() {
+		case config.ApisixV2:
+			c.RecordEvent(acg.V2(), v1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(acg.V2(), reason, errOrigin, metav1.ConditionFalse, acg.GetGeneration())
+		}
+	} else {
+		log.Errorw("failed list ApisixConsumerGroup",
+			zap.Error(errLocal),
+			zap.String("name", name),
+			zap.String("namespace", namespace),
+		)
+	}
+	c.workqueue.AddRateLimited(obj)
+	c.MetricsCollector.IncrSyncOperation("ConsumerGroup", "failure")
+}
+
+func (c *apisixConsumerGroupController) onAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorf("found ApisixConsumerGroup resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixConsumerGroup add event arrived",
+		zap.Any("object", obj))
+
+	acg := kube.MustNewApisixConsumerGroup(obj)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventAdd,
+		Object: kube.ApisixConsumerGroupEvent{
+			Key:          key,
+			GroupVersion: acg.GroupVersion(),
+		},
+	})
+
+	c.MetricsCollector.IncrEvents("ConsumerGroup", "add")
+}
+
+func (c *apisixConsumerGroupController) onUpdate(oldObj, newObj interface{}) {
+	prev := kube.MustNewApisixConsumerGroup(oldObj)
+	curr := kube.MustNewApisixConsumerGroup(newObj)
+	if prev.ResourceVersion() >= curr.ResourceVersion() {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(newObj)
+	if err != nil {
+		log.Errorf("found ApisixConsumerGroup resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixConsumerGroup update event arrived",
+		zap.Any("new object", curr),
+		zap.Any("old object", prev),
+	)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventUpdate,
+		Object: kube.ApisixConsumerGroupEvent{
+			Key:          key,
+			GroupVersion: curr.GroupVersion(),
+			OldObject:    prev,
+		},
+	})
+
+	c.MetricsCollector.IncrEvents("ConsumerGroup", "update")
+}
+
+func (c *apisixConsumerGroupController) onDelete(obj interface{}) {
+	acg, err := kube.NewApisixConsumerGroup(obj)
+	if err != nil {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		acg = kube.MustNewApisixConsumerGroup(tombstone)
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorf("found ApisixConsumerGroup resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixConsumerGroup delete event arrived",
+		zap.Any("final state", acg),
+	)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventDelete,
+		Object: kube.ApisixConsumerGroupEvent{
+			Key:          key,
+			GroupVersion: acg.GroupVersion(),
+		},
+		Tombstone: acg,
+	})
+
+	c.MetricsCollector.IncrEvents("ConsumerGroup", "delete")
+}
+
+func (c *apisixConsumerGroupController) ResourceSync() {
+	objs := c.ApisixConsumerGroupInformer.GetIndexer().List()
+	for _, obj := range objs {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			log.Errorw("ApisixConsumerGroup sync failed, found ApisixConsumerGroup resource with bad meta namespace key", zap.String("error", err.Error()))
+			continue
+		}
+		if !c.namespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		acg := kube.MustNewApisixConsumerGroup(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixConsumerGroupEvent{
+				Key:          key,
+				GroupVersion: acg.GroupVersion(),
+			},
+		})
+	}
+}
+
+// NotifySecretChange re-syncs the ApisixConsumerGroups which refer to the changed Secret
+// in their plugins.
+func (c *apisixConsumerGroupController) NotifySecretChange(secretKey string) {
+	for _, obj := range c.secretDependents(c.ApisixConsumerGroupInformer, secretKey) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			continue
+		}
+		log.Infow("secret changed, re-sync ApisixConsumerGroup",
+			zap.String("secret", secretKey),
+			zap.String("ApisixConsumerGroup", key),
+		)
+		acg := kube.MustNewApisixConsumerGroup(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixConsumerGroupEvent{
+				Key:          key,
+				GroupVersion: acg.GroupVersion(),
+			},
+		})
+	}
+}
+
+// recordStatus record resources status
+func (c *apisixConsumerGroupController) recordStatus(at interface{}, reason string, err error, status metav1.ConditionStatus, generation int64) {
+	// build condition
+	message := utils.CommonSuccessMessage
+	if err != nil {
+		message = err.Error()
+	}
+	condition := metav1.Condition{
+		Type:               utils.ConditionType,
+		Reason:             reason,
+		Status:             status,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+	apisixClient := c.KubeClient.APISIXClient
+
+	if kubeObj, ok := at.(runtime.Object); ok {
+		at = kubeObj.DeepCopyObject()
+	}
+
+	switch v := at.(type) {
+	case *configv2.ApisixConsumerGroup:
+		// set to status
+		if v.Status.Conditions == nil {
+			conditions := make([]metav1.Condition, 0)
+			v.Status.Conditions = conditions
+		}
+		changed := false
+		if utils.VerifyGeneration(&v.Status.Conditions, condition) && !meta.IsStatusConditionPresentAndEqual(v.Status.Conditions, condition.Type, condition.Status) {
+			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
+			if _, errRecord := apisixClient.ApisixV2().ApisixConsumerGroups(v.Namespace).
+				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+				log.Errorw("failed to record status change for ApisixConsumerGroup",
+					zap.Error(errRecord),
+					zap.String("name", v.Name),
+					zap.String("namespace", v.Namespace),
+				)
+			}
+		}
+	default:
+		// This should not be executed
+		log.Errorf("unsupported resource record: %s", v)
+	}
+}
@@ -134,6 +134,15 @@ func (c *apisixGlobalRuleController) sync(ctx context.Context, ev *types.Event)
 		return err
 	}
 
+	clusters, err := c.SelectClusters(agr.V2())
+	if err != nil {
+		log.Errorw("failed to select clusters for ApisixGlobalRule",
+			zap.Error(err),
+			zap.Any("object", agr),
+		)
+		return err
+	}
+
 	m := &utils.Manifest{
 		GlobalRules: tctx.GlobalRules,
 	}
@@ -162,6 +171,16 @@ func (c *apisixGlobalRuleController) sync(ctx context.Context, ev *types.Event)
 				GlobalRules: oldCtx.GlobalRules,
 			}
 			added, updated, deleted = m.Diff(om)
+
+			// Remove the old objects from clusters which are no longer selected.
+			oldClusters, err := c.SelectClusters(obj.OldObject.V2())
+			if err == nil {
+				if unselected := utils.Difference(oldClusters, clusters); len(unselected) > 0 {
+					if err := c.SyncClustersManifests(ctx, unselected, nil, nil, om); err != nil {
+						return err
+					}
+				}
+			}
 		}
 	}
 	log.Debugw("sync ApisixGlaobalRule to cluster",
@@ -170,7 +189,7 @@ func (c *apisixGlobalRuleController) sync(ctx context.Context, ev *types.Event)
 		zap.Any("update", updated),
 		zap.Any("delete", deleted),
 	)
-	return c.SyncManifests(ctx, added, updated, deleted)
+	return c.SyncClustersManifests(ctx, clusters, added, updated, deleted)
 }
 
 func (c *apisixGlobalRuleController) handleSyncErr(obj interface{}, errOrigin error) {
@@ -221,11 +240,12 @@ func (c *apisixGlobalRuleController) handleSyncErr(obj interface{}, errOrigin er
 		zap.Any("object", obj),
 		zap.Error(errOrigin),
 	)
+	reason := utils.SyncFailedReason(errOrigin)
 	if errLocal == nil {
 		switch agr.GroupVersion() {
 		case config.ApisixV2:
-			c.RecordEvent(agr.V2(), v1.EventTypeWarning, utils.ResourceSyncAborted, errOrigin)
-			c.recordStatus(agr.V2(), utils.ResourceSyncAborted, errOrigin, metav1.ConditionFalse, agr.GetGeneration())
+			c.RecordEvent(agr.V2(), v1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(agr.V2(), reason, errOrigin, metav1.ConditionFalse, agr.GetGeneration())
 		}
 	} else {
 		log.Errorw("failed list ApisixGlobalRule",
@@ -346,6 +366,29 @@ func (c *apisixGlobalRuleController) ResourceSync() {
 	}
 }
 
+// NotifySecretChange re-syncs the ApisixGlobalRules which refer to the changed Secret
+// in their plugins.
+func (c *apisixGlobalRuleController) NotifySecretChange(secretKey string) {
+	for _, obj := range c.secretDependents(c.ApisixGlobalRuleInformer, secretKey) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			continue
+		}
+		log.Infow("secret changed, re-sync ApisixGlobalRule",
+			zap.String("secret", secretKey),
+			zap.String("ApisixGlobalRule", key),
+		)
+		agr := kube.MustNewApisixGlobalRule(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixGlobalRuleEvent{
+				Key:          key,
+				GroupVersion: agr.GroupVersion(),
+			},
+		})
+	}
+}
+
 // recordStatus record resources status
 func (c *apisixGlobalRuleController) recordStatus(at interface{}, reason string, err error, status metav1.ConditionStatus, generation int64) {
 	// build condition
@@ -373,8 +416,15 @@ func (c *apisixGlobalRuleController) recordStatus(at interface{}, reason string,
 			conditions := make([]metav1.Condition, 0)
 			v.Status.Conditions = conditions
 		}
+		changed := false
 		if utils.VerifyGeneration(&v.Status.Conditions, condition) && !meta.IsStatusConditionPresentAndEqual(v.Status.Conditions, condition.Type, condition.Status) {
 			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
 			if _, errRecord := apisixClient.ApisixV2().ApisixGlobalRules(v.Namespace).
 				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
 				log.Errorw("failed to record status change for ApisixGlobalRule",
@@ -168,9 +168,19 @@ func (c *apisixPluginConfigController) sync(ctx context.Context, ev *types.Event
 		zap.Any("pluginConfigs", tctx.PluginConfigs),
 	)
 
+	clusters, err := c.selectClusters(apc)
+	if err != nil {
+		log.Errorw("failed to select clusters for ApisixPluginConfig",
+			zap.Error(err),
+			zap.Any("object", apc),
+		)
+		return err
+	}
+
 	m := &utils.Manifest{
 		PluginConfigs: tctx.PluginConfigs,
 	}
+	m.SetOwnerLabels(c.Config.Kubernetes.ElectionID, "ApisixPluginConfig", obj.Key)
 
 	var (
 		added   *utils.Manifest
@@ -203,10 +213,33 @@ func (c *apisixPluginConfigController) sync(ctx context.Context, ev *types.Event
 		om := &utils.Manifest{
 			PluginConfigs: oldCtx.PluginConfigs,
 		}
+		om.SetOwnerLabels(c.Config.Kubernetes.ElectionID, "ApisixPluginConfig", obj.Key)
 		added, updated, deleted = m.Diff(om)
+
+		// Remove the old objects from clusters which are no longer selected.
+		oldClusters, err := c.selectClusters(obj.OldObject)
+		if err == nil {
+			if unselected := utils.Difference(oldClusters, clusters); len(unselected) > 0 {
+				if err := c.SyncClustersManifests(ctx, unselected, nil, nil, om); err != nil {
+					return err
+				}
+			}
+		}
 	}
 
-	return c.SyncManifests(ctx, added, updated, deleted)
+	return c.SyncClustersManifests(ctx, clusters, added, updated, deleted)
+}
+
+// selectClusters returns the APISIX clusters which the ApisixPluginConfig should be synced to.
+func (c *apisixPluginConfigController) selectClusters(apc kube.ApisixPluginConfig) ([]string, error) {
+	switch apc.GroupVersion() {
+	case config.ApisixV2beta3:
+		return c.SelectClusters(apc.V2beta3())
+	case config.ApisixV2:
+		return c.SelectClusters(apc.V2())
+	default:
+		return nil, fmt.Errorf("unsupported ApisixPluginConfig group version %s", apc.GroupVersion())
+	}
 }
 
 func (c *apisixPluginConfigController) handleSyncErr(obj interface{}, errOrigin error) {
@@ -262,14 +295,15 @@ func (c *apisixPluginConfigController) handleSyncErr(obj interface{}, errOrigin
 		zap.Any("object", obj),
 		zap.Error(errOrigin),
 	)
+	reason := utils.SyncFailedReason(errOrigin)
 	if errLocal == nil {
 		switch apc.GroupVersion() {
 		case config.ApisixV2beta3:
-			c.RecordEvent(apc.V2beta3(), v1.EventTypeWarning, utils.ResourceSyncAborted, errOrigin)
-			c.recordStatus(apc.V2beta3(), utils.ResourceSyncAborted, errOrigin, metav1.ConditionFalse, apc.V2beta3().GetGeneration())
+			c.RecordEvent(apc.V2beta3(), v1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(apc.V2beta3(), reason, errOrigin, metav1.ConditionFalse, apc.V2beta3().GetGeneration())
 		case config.ApisixV2:
-			c.RecordEvent(apc.V2(), v1.EventTypeWarning, utils.ResourceSyncAborted, errOrigin)
-			c.recordStatus(apc.V2(), utils.ResourceSyncAborted, errOrigin, metav1.ConditionFalse, apc.V2().GetGeneration())
+			c.RecordEvent(apc.V2(), v1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(apc.V2(), reason, errOrigin, metav1.ConditionFalse, apc.V2().GetGeneration())
 		}
 	} else {
 		log.Errorw("failed list ApisixPluginConfig",
@@ -390,6 +424,29 @@ func (c *apisixPluginConfigController) ResourceSync() {
 	}
 }
 
+// NotifySecretChange re-syncs the ApisixPluginConfigs which refer to the changed Secret
+// in their plugins.
+func (c *apisixPluginConfigController) NotifySecretChange(secretKey string) {
+	for _, obj := range c.secretDependents(c.ApisixPluginConfigInformer, secretKey) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			continue
+		}
+		log.Infow("secret changed, re-sync ApisixPluginConfig",
+			zap.String("secret", secretKey),
+			zap.String("ApisixPluginConfig", key),
+		)
+		apc := kube.MustNewApisixPluginConfig(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixPluginConfigEvent{
+				Key:          key,
+				GroupVersion: apc.GroupVersion(),
+			},
+		})
+	}
+}
+
 // recordStatus record resources status
 func (c *apisixPluginConfigController) recordStatus(at interface{}, reason string, err error, status metav1.ConditionStatus, generation int64) {
 	if c.Kubernetes.DisableStatusUpdates {
@@ -420,8 +477,15 @@ func (c *apisixPluginConfigController) recordStatus(at interface{}, reason strin
 			conditions := make([]metav1.Condition, 0)
 			v.Status.Conditions = conditions
 		}
+		changed := false
 		if utils.VerifyGeneration(&v.Status.Conditions, condition) {
 			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
 			if _, errRecord := apisixClient.ApisixV2beta3().ApisixPluginConfigs(v.Namespace).
 				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
 				log.Errorw("failed to record status change for ApisixPluginConfig",
@@ -437,8 +501,15 @@ func (c *apisixPluginConfigController) recordStatus(at interface{}, reason strin
 			conditions := make([]metav1.Condition, 0)
 			v.Status.Conditions = conditions
 		}
+		changed := false
 		if utils.VerifyConditions(&v.Status.Conditions, condition) {
 			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
 			if _, errRecord := apisixClient.ApisixV2().ApisixPluginConfigs(v.Namespace).
 				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
 				log.Errorw("failed to record status change for ApisixPluginConfig",
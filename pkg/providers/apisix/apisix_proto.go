@@ -0,0 +1,444 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+// _configMapIndex is the name of the informer index which maps a ConfigMap
+// (namespace/name) to the ApisixProtos referring to it.
+const _configMapIndex = "configmap"
+
+type apisixProtoController struct {
+	*apisixCommon
+
+	workqueue workqueue.RateLimitingInterface
+	workers   int
+}
+
+func newApisixProtoController(common *apisixCommon) *apisixProtoController {
+	c := &apisixProtoController{
+		apisixCommon: common,
+		workqueue:    workqueue.NewNamedRateLimitingQueue(workqueue.NewItemFastSlowRateLimiter(1*time.Second, 60*time.Second, 5), "ApisixProto"),
+		workers:      1,
+	}
+
+	c.ApisixProtoInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.onAdd,
+			UpdateFunc: c.onUpdate,
+			DeleteFunc: c.onDelete,
+		},
+	)
+	c.ConfigMapInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: c.onConfigMapChange,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				prev := oldObj.(*corev1.ConfigMap)
+				curr := newObj.(*corev1.ConfigMap)
+				if prev.ResourceVersion >= curr.ResourceVersion {
+					return
+				}
+				c.onConfigMapChange(newObj)
+			},
+		},
+	)
+	return c
+}
+
+func (c *apisixProtoController) run(ctx context.Context) {
+	log.Info("ApisixProto controller started")
+	defer log.Info("ApisixProto controller exited")
+	defer c.workqueue.ShutDown()
+
+	for i := 0; i < c.workers; i++ {
+		go c.runWorker(ctx)
+	}
+	<-ctx.Done()
+}
+
+func (c *apisixProtoController) runWorker(ctx context.Context) {
+	for {
+		obj, quit := c.workqueue.Get()
+		if quit {
+			return
+		}
+		err := c.sync(ctx, obj.(*types.Event))
+		c.workqueue.Done(obj)
+		c.handleSyncErr(obj, err)
+	}
+}
+
+func (c *apisixProtoController) sync(ctx context.Context, ev *types.Event) error {
+	obj := ev.Object.(kube.ApisixProtoEvent)
+	namespace, name, err := cache.SplitMetaNamespaceKey(obj.Key)
+	if err != nil {
+		log.Errorf("invalid resource key: %s", obj.Key)
+		return err
+	}
+	var (
+		ap kube.ApisixProto
+	)
+	ap, err = c.ApisixProtoLister.ApisixProto(namespace, name)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Errorw("failed to get ApisixProto",
+				zap.String("version", obj.GroupVersion),
+				zap.String("key", obj.Key),
+				zap.Error(err),
+			)
+			return err
+		}
+
+		if ev.Type != types.EventDelete {
+			log.Warnw("ApisixProto was deleted before it can be delivered",
+				zap.String("key", obj.Key),
+				zap.String("version", obj.GroupVersion),
+			)
+			return nil
+		}
+	}
+	if ev.Type == types.EventDelete {
+		if ap != nil {
+			// We still find the resource while we are processing the DELETE event,
+			// that means object with same namespace and name was created, discarding
+			// this stale DELETE event.
+			log.Warnw("discard the stale ApisixProto delete event since the resource still exists",
+				zap.String("key", obj.Key),
+			)
+			return nil
+		}
+		ap = ev.Tombstone.(kube.ApisixProto)
+	}
+
+	var proto *apisixv1.Proto
+	if ev.Type == types.EventDelete {
+		proto = c.translator.GenerateApisixProtoV2DeleteMark(ap.V2())
+	} else {
+		proto, err = c.translator.TranslateApisixProtoV2(ap.V2())
+		if err != nil {
+			log.Errorw("failed to translate ApisixProto",
+				zap.Error(err),
+				zap.Any("object", ap),
+			)
+			return err
+		}
+	}
+	proto.Labels = utils.OwnerLabels(proto.Labels, c.Config.Kubernetes.ElectionID, "ApisixProto", obj.Key)
+
+	log.Debugw("sync ApisixProto to cluster",
+		zap.String("event_type", ev.Type.String()),
+		zap.Any("proto", proto),
+	)
+	return c.SyncProto(ctx, proto, ev.Type)
+}
+
+func (c *apisixProtoController) handleSyncErr(obj interface{}, errOrigin error) {
+	ev := obj.(*types.Event)
+	event := ev.Object.(kube.ApisixProtoEvent)
+	if k8serrors.IsNotFound(errOrigin) && ev.Type != types.EventDelete {
+		log.Infow("sync ApisixProto but not found, ignore",
+			zap.String("event_type", ev.Type.String()),
+			zap.String("ApisixProto", ev.Object.(kube.ApisixProtoEvent).Key),
+		)
+		c.workqueue.Forget(event)
+		return
+	}
+	namespace, name, errLocal := cache.SplitMetaNamespaceKey(event.Key)
+	if errLocal != nil {
+		log.Errorf("invalid resource key: %s", event.Key)
+		c.MetricsCollector.IncrSyncOperation("Proto", "failure")
+		return
+	}
+	var ap kube.ApisixProto
+	switch event.GroupVersion {
+	case config.ApisixV2:
+		ap, errLocal = c.ApisixProtoLister.V2(namespace, name)
+	default:
+		errLocal = fmt.Errorf("unsupported ApisixProto group version %s", event.GroupVersion)
+	}
+	if errOrigin == nil {
+		if ev.Type != types.EventDelete {
+			if errLocal == nil {
+				switch ap.GroupVersion() {
+				case config.ApisixV2:
+					c.RecordEvent(ap.V2(), corev1.EventTypeNormal, utils.ResourceSynced, nil)
+					c.recordStatus(ap.V2(), utils.ResourceSynced, nil, metav1.ConditionTrue, ap.GetGeneration())
+				}
+			} else {
+				log.Errorw("failed list ApisixProto",
+					zap.Error(errLocal),
+					zap.String("name", name),
+					zap.String("namespace", namespace),
+				)
+			}
+		}
+		c.workqueue.Forget(obj)
+		c.MetricsCollector.IncrSyncOperation("Proto", "success")
+		return
+	}
+	log.Warnw("sync ApisixProto failed, will retry",
+		zap.Any("object", obj),
+		zap.Error(errOrigin),
+	)
+	reason := utils.SyncFailedReason(errOrigin)
+	if errLocal == nil {
+		switch ap.GroupVersion() {
+		case config.ApisixV2:
+			c.RecordEvent(ap.V2(), corev1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(ap.V2(), reason, errOrigin, metav1.ConditionFalse, ap.GetGeneration())
+		}
+	} else {
+		log.Errorw("failed list ApisixProto",
+			zap.Error(errLocal),
+			zap.String("name", name),
+			zap.String("namespace", namespace),
+		)
+	}
+	c.workqueue.AddRateLimited(obj)
+	c.MetricsCollector.IncrSyncOperation("Proto", "failure")
+}
+
+func (c *apisixProtoController) onAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorf("found ApisixProto resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixProto add event arrived",
+		zap.Any("object", obj))
+
+	ap := kube.MustNewApisixProto(obj)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventAdd,
+		Object: kube.ApisixProtoEvent{
+			Key:          key,
+			GroupVersion: ap.GroupVersion(),
+		},
+	})
+
+	c.MetricsCollector.IncrEvents("Proto", "add")
+}
+
+func (c *apisixProtoController) onUpdate(oldObj, newObj interface{}) {
+	prev := kube.MustNewApisixProto(oldObj)
+	curr := kube.MustNewApisixProto(newObj)
+	if prev.ResourceVersion() >= curr.ResourceVersion() {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(newObj)
+	if err != nil {
+		log.Errorf("found ApisixProto resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixProto update event arrived",
+		zap.Any("new object", curr),
+		zap.Any("old object", prev),
+	)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventUpdate,
+		Object: kube.ApisixProtoEvent{
+			Key:          key,
+			GroupVersion: curr.GroupVersion(),
+			OldObject:    prev,
+		},
+	})
+
+	c.MetricsCollector.IncrEvents("Proto", "update")
+}
+
+func (c *apisixProtoController) onDelete(obj interface{}) {
+	ap, err := kube.NewApisixProto(obj)
+	if err != nil {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		ap = kube.MustNewApisixProto(tombstone)
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorf("found ApisixProto resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixProto delete event arrived",
+		zap.Any("final state", ap),
+	)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventDelete,
+		Object: kube.ApisixProtoEvent{
+			Key:          key,
+			GroupVersion: ap.GroupVersion(),
+		},
+		Tombstone: ap,
+	})
+
+	c.MetricsCollector.IncrEvents("Proto", "delete")
+}
+
+func (c *apisixProtoController) ResourceSync() {
+	objs := c.ApisixProtoInformer.GetIndexer().List()
+	for _, obj := range objs {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			log.Errorw("ApisixProto sync failed, found ApisixProto resource with bad meta namespace key", zap.String("error", err.Error()))
+			continue
+		}
+		if !c.namespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		ap := kube.MustNewApisixProto(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixProtoEvent{
+				Key:          key,
+				GroupVersion: ap.GroupVersion(),
+			},
+		})
+	}
+}
+
+// onConfigMapChange re-syncs the ApisixProtos which refer to the changed
+// ConfigMap.
+func (c *apisixProtoController) onConfigMapChange(obj interface{}) {
+	cmKey, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	objs, err := c.ApisixProtoInformer.GetIndexer().ByIndex(_configMapIndex, cmKey)
+	if err != nil {
+		log.Errorf("failed to list ApisixProtos referring to ConfigMap %s: %s", cmKey, err)
+		return
+	}
+	for _, obj := range objs {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil || !c.namespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		log.Infow("configmap changed, re-sync ApisixProto",
+			zap.String("configmap", cmKey),
+			zap.String("ApisixProto", key),
+		)
+		ap := kube.MustNewApisixProto(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixProtoEvent{
+				Key:          key,
+				GroupVersion: ap.GroupVersion(),
+			},
+		})
+	}
+}
+
+// addConfigMapIndexer registers the ConfigMap index on the ApisixProto
+// informer, it must be called before the informer starts.
+func addConfigMapIndexer(informer cache.SharedIndexInformer) error {
+	if informer == nil {
+		return nil
+	}
+	return informer.AddIndexers(cache.Indexers{_configMapIndex: configMapIndexFunc})
+}
+
+// configMapIndexFunc returns the key of the ConfigMap referred by the ApisixProto.
+func configMapIndexFunc(obj interface{}) ([]string, error) {
+	ap, ok := obj.(*configv2.ApisixProto)
+	if !ok || ap.Spec.ConfigMapRef == nil {
+		return nil, nil
+	}
+	return []string{ap.Namespace + "/" + ap.Spec.ConfigMapRef.Name}, nil
+}
+
+// recordStatus record resources status
+func (c *apisixProtoController) recordStatus(at interface{}, reason string, err error, status metav1.ConditionStatus, generation int64) {
+	if c.Kubernetes.DisableStatusUpdates {
+		return
+	}
+	// build condition
+	message := utils.CommonSuccessMessage
+	if err != nil {
+		message = err.Error()
+	}
+	condition := metav1.Condition{
+		Type:               utils.ConditionType,
+		Reason:             reason,
+		Status:             status,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+	apisixClient := c.KubeClient.APISIXClient
+
+	if kubeObj, ok := at.(runtime.Object); ok {
+		at = kubeObj.DeepCopyObject()
+	}
+
+	switch v := at.(type) {
+	case *configv2.ApisixProto:
+		// set to status
+		if v.Status.Conditions == nil {
+			conditions := make([]metav1.Condition, 0)
+			v.Status.Conditions = conditions
+		}
+		changed := false
+		if utils.VerifyGeneration(&v.Status.Conditions, condition) && !meta.IsStatusConditionPresentAndEqual(v.Status.Conditions, condition.Type, condition.Status) {
+			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
+			if _, errRecord := apisixClient.ApisixV2().ApisixProtos(v.Namespace).
+				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+				log.Errorw("failed to record status change for ApisixProto",
+					zap.Error(errRecord),
+					zap.String("name", v.Name),
+					zap.String("namespace", v.Namespace),
+				)
+			}
+		}
+	default:
+		// This should not be executed
+		log.Errorf("unsupported resource record: %s", v)
+	}
+}
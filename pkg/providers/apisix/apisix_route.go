@@ -335,10 +335,19 @@ func (c *apisixRouteController) sync(ctx context.Context, ev *types.Event) error
 		ar = ev.Tombstone.(kube.ApisixRoute)
 	}
 
+	clusters, err := c.selectClusters(ar)
+	if err != nil {
+		log.Errorw("failed to select clusters for ApisixRoute",
+			zap.Error(err),
+			zap.Any("object", ar),
+		)
+		return err
+	}
+
 	switch obj.GroupVersion {
 	case config.ApisixV2beta3:
 		if ev.Type != types.EventDelete {
-			if err = c.checkPluginNameIfNotEmptyV2beta3(ctx, ar.V2beta3()); err == nil {
+			if err = c.checkPluginNameIfNotEmptyV2beta3(ctx, clusters, ar.V2beta3()); err == nil {
 				tctx, err = c.translator.TranslateRouteV2beta3(ar.V2beta3())
 			}
 		} else {
@@ -353,8 +362,10 @@ func (c *apisixRouteController) sync(ctx context.Context, ev *types.Event) error
 		}
 	case config.ApisixV2:
 		if ev.Type != types.EventDelete {
-			if err = c.checkPluginNameIfNotEmptyV2(ctx, ar.V2()); err == nil {
-				tctx, err = c.translator.TranslateRouteV2(ar.V2())
+			if err = c.checkPluginNameIfNotEmptyV2(ctx, clusters, ar.V2()); err == nil {
+				if err = c.checkServiceNameIfNotEmptyV2(ctx, clusters, ar.V2()); err == nil {
+					tctx, err = c.translator.TranslateRouteV2(ar.V2())
+				}
 			}
 		} else {
 			tctx, err = c.translator.GenerateRouteV2DeleteMark(ar.V2())
@@ -387,6 +398,7 @@ func (c *apisixRouteController) sync(ctx context.Context, ev *types.Event) error
 		StreamRoutes:  tctx.StreamRoutes,
 		PluginConfigs: tctx.PluginConfigs,
 	}
+	m.SetOwnerLabels(c.Config.Kubernetes.ElectionID, "ApisixRoute", obj.Key)
 
 	var (
 		added   *utils.Manifest
@@ -406,25 +418,53 @@ func (c *apisixRouteController) sync(ctx context.Context, ev *types.Event) error
 			StreamRoutes:  oldCtx.StreamRoutes,
 			PluginConfigs: oldCtx.PluginConfigs,
 		}
+		om.SetOwnerLabels(c.Config.Kubernetes.ElectionID, "ApisixRoute", obj.Key)
 		added, updated, deleted = m.Diff(om)
+
+		// Remove the old objects from clusters which are no longer selected.
+		oldClusters, err := c.selectClusters(obj.OldObject)
+		if err == nil {
+			if unselected := utils.Difference(oldClusters, clusters); len(unselected) > 0 {
+				if err := c.SyncClustersManifests(ctx, unselected, nil, nil, om); err != nil {
+					return err
+				}
+			}
+		}
 	}
 
-	return c.SyncManifests(ctx, added, updated, deleted)
+	return c.SyncClustersManifests(ctx, clusters, added, updated, deleted)
+}
+
+// selectClusters returns the APISIX clusters which the ApisixRoute should be synced to.
+func (c *apisixRouteController) selectClusters(ar kube.ApisixRoute) ([]string, error) {
+	switch ar.GroupVersion() {
+	case config.ApisixV2beta3:
+		return c.SelectClusters(ar.V2beta3())
+	case config.ApisixV2:
+		return c.SelectClusters(ar.V2())
+	default:
+		return nil, fmt.Errorf("unknown ApisixRoute version %v", ar.GroupVersion())
+	}
 }
 
-func (c *apisixRouteController) checkPluginNameIfNotEmptyV2beta3(ctx context.Context, in *v2beta3.ApisixRoute) error {
+func (c *apisixRouteController) checkPluginNameIfNotEmptyV2beta3(ctx context.Context, clusters []string, in *v2beta3.ApisixRoute) error {
 	for _, v := range in.Spec.HTTP {
-		if v.PluginConfigName != "" {
-			_, err := c.APISIX.Cluster(c.Config.APISIX.DefaultClusterName).PluginConfig().Get(ctx, apisixv1.ComposePluginConfigName(in.Namespace, v.PluginConfigName))
+		if v.PluginConfigName == "" {
+			continue
+		}
+		for _, cluster := range clusters {
+			_, err := c.APISIX.Cluster(cluster).PluginConfig().Get(ctx, apisixv1.ComposePluginConfigName(in.Namespace, v.PluginConfigName))
 			if err != nil {
 				if err == apisixcache.ErrNotFound {
 					log.Errorw("checkPluginNameIfNotEmptyV2beta3 error: plugin_config not found",
 						zap.String("name", apisixv1.ComposePluginConfigName(in.Namespace, v.PluginConfigName)),
+						zap.String("cluster", cluster),
 						zap.Any("obj", in),
 						zap.Error(err))
 				} else {
 					log.Errorw("checkPluginNameIfNotEmptyV2beta3 PluginConfig get failed",
 						zap.String("name", apisixv1.ComposePluginConfigName(in.Namespace, v.PluginConfigName)),
+						zap.String("cluster", cluster),
 						zap.Any("obj", in),
 						zap.Error(err))
 				}
@@ -435,19 +475,52 @@ func (c *apisixRouteController) checkPluginNameIfNotEmptyV2beta3(ctx context.Con
 	return nil
 }
 
-func (c *apisixRouteController) checkPluginNameIfNotEmptyV2(ctx context.Context, in *v2.ApisixRoute) error {
+func (c *apisixRouteController) checkPluginNameIfNotEmptyV2(ctx context.Context, clusters []string, in *v2.ApisixRoute) error {
 	for _, v := range in.Spec.HTTP {
-		if v.PluginConfigName != "" {
-			_, err := c.APISIX.Cluster(c.Config.APISIX.DefaultClusterName).PluginConfig().Get(ctx, apisixv1.ComposePluginConfigName(in.Namespace, v.PluginConfigName))
+		if v.PluginConfigName == "" {
+			continue
+		}
+		for _, cluster := range clusters {
+			_, err := c.APISIX.Cluster(cluster).PluginConfig().Get(ctx, apisixv1.ComposePluginConfigName(in.Namespace, v.PluginConfigName))
 			if err != nil {
 				if err == apisixcache.ErrNotFound {
 					log.Errorw("checkPluginNameIfNotEmptyV2 error: plugin_config not found",
 						zap.String("name", apisixv1.ComposePluginConfigName(in.Namespace, v.PluginConfigName)),
+						zap.String("cluster", cluster),
 						zap.Any("obj", in),
 						zap.Error(err))
 				} else {
 					log.Errorw("checkPluginNameIfNotEmptyV2 PluginConfig get failed",
 						zap.String("name", apisixv1.ComposePluginConfigName(in.Namespace, v.PluginConfigName)),
+						zap.String("cluster", cluster),
+						zap.Any("obj", in),
+						zap.Error(err))
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *apisixRouteController) checkServiceNameIfNotEmptyV2(ctx context.Context, clusters []string, in *v2.ApisixRoute) error {
+	for _, v := range in.Spec.HTTP {
+		if v.ApisixServiceName == "" {
+			continue
+		}
+		for _, cluster := range clusters {
+			_, err := c.APISIX.Cluster(cluster).Service().Get(ctx, apisixv1.ComposeServiceName(in.Namespace, v.ApisixServiceName))
+			if err != nil {
+				if err == apisixcache.ErrNotFound {
+					log.Errorw("checkServiceNameIfNotEmptyV2 error: service not found",
+						zap.String("name", apisixv1.ComposeServiceName(in.Namespace, v.ApisixServiceName)),
+						zap.String("cluster", cluster),
+						zap.Any("obj", in),
+						zap.Error(err))
+				} else {
+					log.Errorw("checkServiceNameIfNotEmptyV2 Service get failed",
+						zap.String("name", apisixv1.ComposeServiceName(in.Namespace, v.ApisixServiceName)),
+						zap.String("cluster", cluster),
 						zap.Any("obj", in),
 						zap.Error(err))
 				}
@@ -514,14 +587,15 @@ func (c *apisixRouteController) handleSyncErr(obj interface{}, errOrigin error)
 		zap.Any("object", obj),
 		zap.Error(errOrigin),
 	)
+	reason := utils.SyncFailedReason(errOrigin)
 	if errLocal == nil {
 		switch ar.GroupVersion() {
 		case config.ApisixV2beta3:
-			c.RecordEvent(ar.V2beta3(), v1.EventTypeWarning, utils.ResourceSyncAborted, errOrigin)
-			c.recordStatus(ar.V2beta3(), utils.ResourceSyncAborted, errOrigin, metav1.ConditionFalse, ar.V2beta3().GetGeneration())
+			c.RecordEvent(ar.V2beta3(), v1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(ar.V2beta3(), reason, errOrigin, metav1.ConditionFalse, ar.V2beta3().GetGeneration())
 		case config.ApisixV2:
-			c.RecordEvent(ar.V2(), v1.EventTypeWarning, utils.ResourceSyncAborted, errOrigin)
-			c.recordStatus(ar.V2(), utils.ResourceSyncAborted, errOrigin, metav1.ConditionFalse, ar.V2().GetGeneration())
+			c.RecordEvent(ar.V2(), v1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(ar.V2(), reason, errOrigin, metav1.ConditionFalse, ar.V2().GetGeneration())
 		}
 	} else {
 		log.Errorw("failed list ApisixRoute",
@@ -705,6 +779,29 @@ func (c *apisixRouteController) ResourceSync() {
 	}
 }
 
+// NotifySecretChange re-syncs the ApisixRoutes which refer to the changed Secret
+// in their plugins.
+func (c *apisixRouteController) NotifySecretChange(secretKey string) {
+	for _, obj := range c.secretDependents(c.ApisixRouteInformer, secretKey) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			continue
+		}
+		log.Infow("secret changed, re-sync ApisixRoute",
+			zap.String("secret", secretKey),
+			zap.String("ApisixRoute", key),
+		)
+		ar := kube.MustNewApisixRoute(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixRouteEvent{
+				Key:          key,
+				GroupVersion: ar.GroupVersion(),
+			},
+		})
+	}
+}
+
 func (c *apisixRouteController) onSvcAdd(obj interface{}) {
 	log.Debugw("Service add event arrived",
 		zap.Any("object", obj),
@@ -882,8 +979,15 @@ func (c *apisixRouteController) recordStatus(at interface{}, reason string, err
 			conditions := make([]metav1.Condition, 0)
 			v.Status.Conditions = conditions
 		}
+		changed := false
 		if utils.VerifyGeneration(&v.Status.Conditions, condition) {
 			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
 			if _, errRecord := apisixClient.ApisixV2beta3().ApisixRoutes(v.Namespace).
 				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
 				log.Errorw("failed to record status change for ApisixRoute",
@@ -899,8 +1003,15 @@ func (c *apisixRouteController) recordStatus(at interface{}, reason string, err
 			conditions := make([]metav1.Condition, 0)
 			v.Status.Conditions = conditions
 		}
+		changed := false
 		if utils.VerifyConditions(&v.Status.Conditions, condition) && !meta.IsStatusConditionPresentAndEqual(v.Status.Conditions, condition.Type, condition.Status) {
 			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
 			if _, errRecord := apisixClient.ApisixV2().ApisixRoutes(v.Namespace).
 				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
 				log.Errorw("failed to record status change for ApisixRoute",
@@ -0,0 +1,396 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type apisixSecretProviderController struct {
+	*apisixCommon
+
+	workqueue workqueue.RateLimitingInterface
+	workers   int
+}
+
+func newApisixSecretProviderController(common *apisixCommon) *apisixSecretProviderController {
+	c := &apisixSecretProviderController{
+		apisixCommon: common,
+		workqueue:    workqueue.NewNamedRateLimitingQueue(workqueue.NewItemFastSlowRateLimiter(1*time.Second, 60*time.Second, 5), "ApisixSecretProvider"),
+		workers:      1,
+	}
+
+	c.ApisixSecretProviderInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.onAdd,
+			UpdateFunc: c.onUpdate,
+			DeleteFunc: c.onDelete,
+		},
+	)
+	return c
+}
+
+func (c *apisixSecretProviderController) run(ctx context.Context) {
+	log.Info("ApisixSecretProvider controller started")
+	defer log.Info("ApisixSecretProvider controller exited")
+	defer c.workqueue.ShutDown()
+
+	for i := 0; i < c.workers; i++ {
+		go c.runWorker(ctx)
+	}
+	<-ctx.Done()
+}
+
+func (c *apisixSecretProviderController) runWorker(ctx context.Context) {
+	for {
+		obj, quit := c.workqueue.Get()
+		if quit {
+			return
+		}
+		err := c.sync(ctx, obj.(*types.Event))
+		c.workqueue.Done(obj)
+		c.handleSyncErr(obj, err)
+	}
+}
+
+func (c *apisixSecretProviderController) sync(ctx context.Context, ev *types.Event) error {
+	obj := ev.Object.(kube.ApisixSecretProviderEvent)
+	namespace, name, err := cache.SplitMetaNamespaceKey(obj.Key)
+	if err != nil {
+		log.Errorf("invalid resource key: %s", obj.Key)
+		return err
+	}
+	var (
+		asp kube.ApisixSecretProvider
+	)
+	asp, err = c.ApisixSecretProviderLister.ApisixSecretProvider(namespace, name)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Errorw("failed to get ApisixSecretProvider",
+				zap.String("version", obj.GroupVersion),
+				zap.String("key", obj.Key),
+				zap.Error(err),
+			)
+			return err
+		}
+
+		if ev.Type != types.EventDelete {
+			log.Warnw("ApisixSecretProvider was deleted before it can be delivered",
+				zap.String("key", obj.Key),
+				zap.String("version", obj.GroupVersion),
+			)
+			return nil
+		}
+	}
+	if ev.Type == types.EventDelete {
+		if asp != nil {
+			// We still find the resource while we are processing the DELETE event,
+			// that means object with same namespace and name was created, discarding
+			// this stale DELETE event.
+			log.Warnw("discard the stale ApisixSecretProvider delete event since the resource still exists",
+				zap.String("key", obj.Key),
+			)
+			return nil
+		}
+		asp = ev.Tombstone.(kube.ApisixSecretProvider)
+	}
+
+	var secret *apisixv1.Secret
+	if ev.Type == types.EventDelete {
+		secret = c.translator.GenerateApisixSecretProviderV2DeleteMark(asp.V2())
+	} else {
+		secret, err = c.translator.TranslateApisixSecretProviderV2(asp.V2())
+		if err != nil {
+			log.Errorw("failed to translate ApisixSecretProvider",
+				zap.Error(err),
+				zap.Any("object", asp),
+			)
+			return err
+		}
+	}
+
+	log.Debugw("sync ApisixSecretProvider to cluster",
+		zap.String("event_type", ev.Type.String()),
+		zap.String("secret", secret.ID),
+	)
+	return c.SyncSecret(ctx, secret, ev.Type)
+}
+
+func (c *apisixSecretProviderController) handleSyncErr(obj interface{}, errOrigin error) {
+	ev := obj.(*types.Event)
+	event := ev.Object.(kube.ApisixSecretProviderEvent)
+	if k8serrors.IsNotFound(errOrigin) && ev.Type != types.EventDelete {
+		log.Infow("sync ApisixSecretProvider but not found, ignore",
+			zap.String("event_type", ev.Type.String()),
+			zap.String("ApisixSecretProvider", ev.Object.(kube.ApisixSecretProviderEvent).Key),
+		)
+		c.workqueue.Forget(event)
+		return
+	}
+	namespace, name, errLocal := cache.SplitMetaNamespaceKey(event.Key)
+	if errLocal != nil {
+		log.Errorf("invalid resource key: %s", event.Key)
+		c.MetricsCollector.IncrSyncOperation("SecretProvider", "failure")
+		return
+	}
+	var asp kube.ApisixSecretProvider
+	switch event.GroupVersion {
+	case config.ApisixV2:
+		asp, errLocal = c.ApisixSecretProviderLister.V2(namespace, name)
+	default:
+		errLocal = fmt.Errorf("unsupported ApisixSecretProvider group version %s", event.GroupVersion)
+	}
+	if errOrigin == nil {
+		if ev.Type != types.EventDelete {
+			if errLocal == nil {
+				switch asp.GroupVersion() {
+				case config.ApisixV2:
+					c.RecordEvent(asp.V2(), v1.EventTypeNormal, utils.ResourceSynced, nil)
+					c.recordStatus(asp.V2(), utils.ResourceSynced, nil, metav1.ConditionTrue, asp.GetGeneration())
+				}
+			} else {
+				log.Errorw("failed list ApisixSecretProvider",
+					zap.Error(errLocal),
+					zap.String("name", name),
+					zap.String("namespace", namespace),
+				)
+			}
+		}
+		c.workqueue.Forget(obj)
+		c.MetricsCollector.IncrSyncOperation("SecretProvider", "success")
+		return
+	}
+	log.Warnw("sync ApisixSecretProvider failed, will retry",
+		zap.Any("object", obj),
+		zap.Error(errOrigin),
+	)
+	reason := utils.SyncFailedReason(errOrigin)
+	if errLocal == nil {
+		switch asp.GroupVersion() {
+		case config.ApisixV2:
+			c.RecordEvent(asp.V2(), v1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(asp.V2(), reason, errOrigin, metav1.ConditionFalse, asp.GetGeneration())
+		}
+	} else {
+		log.Errorw("failed list ApisixSecretProvider",
+			zap.Error(errLocal),
+			zap.String("name", name),
+			zap.String("namespace", namespace),
+		)
+	}
+	c.workqueue.AddRateLimited(obj)
+	c.MetricsCollector.IncrSyncOperation("SecretProvider", "failure")
+}
+
+func (c *apisixSecretProviderController) onAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorf("found ApisixSecretProvider resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixSecretProvider add event arrived",
+		zap.Any("object", obj))
+
+	asp := kube.MustNewApisixSecretProvider(obj)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventAdd,
+		Object: kube.ApisixSecretProviderEvent{
+			Key:          key,
+			GroupVersion: asp.GroupVersion(),
+		},
+	})
+
+	c.MetricsCollector.IncrEvents("SecretProvider", "add")
+}
+
+func (c *apisixSecretProviderController) onUpdate(oldObj, newObj interface{}) {
+	prev := kube.MustNewApisixSecretProvider(oldObj)
+	curr := kube.MustNewApisixSecretProvider(newObj)
+	if prev.ResourceVersion() >= curr.ResourceVersion() {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(newObj)
+	if err != nil {
+		log.Errorf("found ApisixSecretProvider resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixSecretProvider update event arrived",
+		zap.Any("new object", curr),
+		zap.Any("old object", prev),
+	)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventUpdate,
+		Object: kube.ApisixSecretProviderEvent{
+			Key:          key,
+			GroupVersion: curr.GroupVersion(),
+			OldObject:    prev,
+		},
+	})
+
+	c.MetricsCollector.IncrEvents("SecretProvider", "update")
+}
+
+func (c *apisixSecretProviderController) onDelete(obj interface{}) {
+	asp, err := kube.NewApisixSecretProvider(obj)
+	if err != nil {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		asp = kube.MustNewApisixSecretProvider(tombstone)
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorf("found ApisixSecretProvider resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixSecretProvider delete event arrived",
+		zap.Any("final state", asp),
+	)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventDelete,
+		Object: kube.ApisixSecretProviderEvent{
+			Key:          key,
+			GroupVersion: asp.GroupVersion(),
+		},
+		Tombstone: asp,
+	})
+
+	c.MetricsCollector.IncrEvents("SecretProvider", "delete")
+}
+
+func (c *apisixSecretProviderController) ResourceSync() {
+	objs := c.ApisixSecretProviderInformer.GetIndexer().List()
+	for _, obj := range objs {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			log.Errorw("ApisixSecretProvider sync failed, found ApisixSecretProvider resource with bad meta namespace key", zap.String("error", err.Error()))
+			continue
+		}
+		if !c.namespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		asp := kube.MustNewApisixSecretProvider(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixSecretProviderEvent{
+				Key:          key,
+				GroupVersion: asp.GroupVersion(),
+			},
+		})
+	}
+}
+
+// NotifySecretChange re-syncs the ApisixSecretProviders which refer to the changed Secret
+// as the token.
+func (c *apisixSecretProviderController) NotifySecretChange(secretKey string) {
+	for _, obj := range c.secretDependents(c.ApisixSecretProviderInformer, secretKey) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			continue
+		}
+		log.Infow("secret changed, re-sync ApisixSecretProvider",
+			zap.String("secret", secretKey),
+			zap.String("ApisixSecretProvider", key),
+		)
+		asp := kube.MustNewApisixSecretProvider(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixSecretProviderEvent{
+				Key:          key,
+				GroupVersion: asp.GroupVersion(),
+			},
+		})
+	}
+}
+
+// recordStatus record resources status
+func (c *apisixSecretProviderController) recordStatus(at interface{}, reason string, err error, status metav1.ConditionStatus, generation int64) {
+	// build condition
+	message := utils.CommonSuccessMessage
+	if err != nil {
+		message = err.Error()
+	}
+	condition := metav1.Condition{
+		Type:               utils.ConditionType,
+		Reason:             reason,
+		Status:             status,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+	apisixClient := c.KubeClient.APISIXClient
+
+	if kubeObj, ok := at.(runtime.Object); ok {
+		at = kubeObj.DeepCopyObject()
+	}
+
+	switch v := at.(type) {
+	case *configv2.ApisixSecretProvider:
+		// set to status
+		if v.Status.Conditions == nil {
+			conditions := make([]metav1.Condition, 0)
+			v.Status.Conditions = conditions
+		}
+		changed := false
+		if utils.VerifyGeneration(&v.Status.Conditions, condition) && !meta.IsStatusConditionPresentAndEqual(v.Status.Conditions, condition.Type, condition.Status) {
+			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
+			if _, errRecord := apisixClient.ApisixV2().ApisixSecretProviders(v.Namespace).
+				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+				log.Errorw("failed to record status change for ApisixSecretProvider",
+					zap.Error(errRecord),
+					zap.String("name", v.Name),
+					zap.String("namespace", v.Namespace),
+				)
+			}
+		}
+	default:
+		// This should not be executed
+		log.Errorf("unsupported resource record: %s", v)
+	}
+}
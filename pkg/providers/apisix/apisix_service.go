@@ -0,0 +1,481 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+)
+
+type apisixServiceController struct {
+	*apisixCommon
+
+	workqueue workqueue.RateLimitingInterface
+	workers   int
+}
+
+func newApisixServiceController(common *apisixCommon) *apisixServiceController {
+	c := &apisixServiceController{
+		apisixCommon: common,
+		workqueue:    workqueue.NewNamedRateLimitingQueue(workqueue.NewItemFastSlowRateLimiter(1*time.Second, 60*time.Second, 5), "ApisixService"),
+		workers:      1,
+	}
+
+	c.ApisixServiceInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.onAdd,
+			UpdateFunc: c.onUpdate,
+			DeleteFunc: c.onDelete,
+		},
+	)
+	return c
+}
+
+func (c *apisixServiceController) run(ctx context.Context) {
+	log.Info("ApisixService controller started")
+	defer log.Info("ApisixService controller exited")
+	defer c.workqueue.ShutDown()
+
+	for i := 0; i < c.workers; i++ {
+		go c.runWorker(ctx)
+	}
+	<-ctx.Done()
+}
+
+func (c *apisixServiceController) runWorker(ctx context.Context) {
+	for {
+		obj, quit := c.workqueue.Get()
+		if quit {
+			return
+		}
+		err := c.sync(ctx, obj.(*types.Event))
+		c.workqueue.Done(obj)
+		c.handleSyncErr(obj, err)
+	}
+}
+
+func (c *apisixServiceController) sync(ctx context.Context, ev *types.Event) error {
+	obj := ev.Object.(kube.ApisixServiceEvent)
+	namespace, name, err := cache.SplitMetaNamespaceKey(obj.Key)
+	if err != nil {
+		log.Errorf("invalid resource key: %s", obj.Key)
+		return err
+	}
+	var (
+		as   kube.ApisixService
+		tctx *translation.TranslateContext
+	)
+	as, err = c.ApisixServiceLister.ApisixService(namespace, name)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Errorw("failed to get ApisixService",
+				zap.String("version", obj.GroupVersion),
+				zap.String("key", obj.Key),
+				zap.Error(err),
+			)
+			return err
+		}
+
+		if ev.Type != types.EventDelete {
+			log.Warnw("ApisixService was deleted before it can be delivered",
+				zap.String("key", obj.Key),
+				zap.String("version", obj.GroupVersion),
+			)
+			return nil
+		}
+	}
+	if ev.Type == types.EventDelete {
+		if as != nil {
+			// We still find the resource while we are processing the DELETE event,
+			// that means object with same namespace and name was created, discarding
+			// this stale DELETE event.
+			log.Warnw("discard the stale ApisixService delete event since the resource still exists",
+				zap.String("key", obj.Key),
+			)
+			return nil
+		}
+		as = ev.Tombstone.(kube.ApisixService)
+	}
+
+	if ev.Type != types.EventDelete {
+		tctx, err = c.translator.TranslateApisixServiceV2(as.V2())
+	} else {
+		tctx, err = c.translator.GenerateApisixServiceV2DeleteMark(as.V2())
+	}
+	if err != nil {
+		log.Errorw("failed to translate ApisixService",
+			zap.Error(err),
+			zap.Any("object", as),
+		)
+		return err
+	}
+
+	log.Debugw("translated ApisixService",
+		zap.Any("services", tctx.Services),
+		zap.Any("upstreams", tctx.Upstreams),
+	)
+
+	clusters, err := c.SelectClusters(as.V2())
+	if err != nil {
+		log.Errorw("failed to select clusters for ApisixService",
+			zap.Error(err),
+			zap.Any("object", as),
+		)
+		return err
+	}
+
+	m := &utils.Manifest{
+		Services:  tctx.Services,
+		Upstreams: tctx.Upstreams,
+	}
+	m.SetOwnerLabels(c.Config.Kubernetes.ElectionID, "ApisixService", obj.Key)
+
+	var (
+		added   *utils.Manifest
+		updated *utils.Manifest
+		deleted *utils.Manifest
+	)
+
+	if ev.Type == types.EventDelete {
+		deleted = m
+	} else if ev.Type == types.EventAdd {
+		added = m
+	} else {
+		oldCtx, err := c.translator.TranslateApisixServiceV2(obj.OldObject.V2())
+		if err != nil {
+			log.Errorw("failed to translate old ApisixService",
+				zap.String("version", obj.GroupVersion),
+				zap.String("event", "update"),
+				zap.Error(err),
+				zap.Any("ApisixService", as),
+			)
+			return err
+		}
+
+		om := &utils.Manifest{
+			Services:  oldCtx.Services,
+			Upstreams: oldCtx.Upstreams,
+		}
+		om.SetOwnerLabels(c.Config.Kubernetes.ElectionID, "ApisixService", obj.Key)
+		added, updated, deleted = m.Diff(om)
+
+		// Remove the old objects from clusters which are no longer selected.
+		oldClusters, err := c.SelectClusters(obj.OldObject.V2())
+		if err == nil {
+			if unselected := utils.Difference(oldClusters, clusters); len(unselected) > 0 {
+				if err := c.SyncClustersManifests(ctx, unselected, nil, nil, om); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return c.SyncClustersManifests(ctx, clusters, added, updated, deleted)
+}
+
+func (c *apisixServiceController) handleSyncErr(obj interface{}, errOrigin error) {
+	ev := obj.(*types.Event)
+	event := ev.Object.(kube.ApisixServiceEvent)
+	if k8serrors.IsNotFound(errOrigin) && ev.Type != types.EventDelete {
+		log.Infow("sync ApisixService but not found, ignore",
+			zap.String("event_type", ev.Type.String()),
+			zap.String("ApisixService", ev.Object.(kube.ApisixServiceEvent).Key),
+		)
+		c.workqueue.Forget(event)
+		return
+	}
+	namespace, name, errLocal := cache.SplitMetaNamespaceKey(event.Key)
+	if errLocal != nil {
+		log.Errorf("invalid resource key: %s", event.Key)
+		c.MetricsCollector.IncrSyncOperation("Service", "failure")
+		return
+	}
+	var as kube.ApisixService
+	switch event.GroupVersion {
+	case config.ApisixV2:
+		as, errLocal = c.ApisixServiceLister.V2(namespace, name)
+	default:
+		errLocal = fmt.Errorf("unsupported ApisixService group version %s", event.GroupVersion)
+	}
+	if errOrigin == nil {
+		if ev.Type != types.EventDelete {
+			if errLocal == nil {
+				switch as.GroupVersion() {
+				case config.ApisixV2:
+					c.RecordEvent(as.V2(), v1.EventTypeNormal, utils.ResourceSynced, nil)
+					c.recordStatus(as.V2(), utils.ResourceSynced, nil, metav1.ConditionTrue, as.GetGeneration())
+				}
+			} else {
+				log.Errorw("failed list ApisixService",
+					zap.Error(errLocal),
+					zap.String("name", name),
+					zap.String("namespace", namespace),
+				)
+			}
+		}
+		c.workqueue.Forget(obj)
+		c.MetricsCollector.IncrSyncOperation("Service", "success")
+		return
+	}
+	log.Warnw("sync ApisixService failed, will retry",
+		zap.Any("object", obj),
+		zap.Error(errOrigin),
+	)
+	reason := utils.SyncFailedReason(errOrigin)
+	if errLocal == nil {
+		switch as.GroupVersion() {
+		case config.ApisixV2:
+			c.RecordEvent(as.V2(), v1.EventTypeWarning, reason, errOrigin)
+			c.recordStatus(as.V2(), reason, errOrigin, metav1.ConditionFalse, as.GetGeneration())
+		}
+	} else {
+		log.Errorw("failed list ApisixService",
+			zap.Error(errLocal),
+			zap.String("name", name),
+			zap.String("namespace", namespace),
+		)
+	}
+	c.workqueue.AddRateLimited(obj)
+	c.MetricsCollector.IncrSyncOperation("Service", "failure")
+}
+
+func (c *apisixServiceController) onAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorf("found ApisixService resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixService add event arrived",
+		zap.Any("object", obj))
+
+	as := kube.MustNewApisixService(obj)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventAdd,
+		Object: kube.ApisixServiceEvent{
+			Key:          key,
+			GroupVersion: as.GroupVersion(),
+		},
+	})
+
+	c.MetricsCollector.IncrEvents("Service", "add")
+}
+
+func (c *apisixServiceController) onUpdate(oldObj, newObj interface{}) {
+	prev := kube.MustNewApisixService(oldObj)
+	curr := kube.MustNewApisixService(newObj)
+	if prev.ResourceVersion() >= curr.ResourceVersion() {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(newObj)
+	if err != nil {
+		log.Errorf("found ApisixService resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixService update event arrived",
+		zap.Any("new object", curr),
+		zap.Any("old object", prev),
+	)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventUpdate,
+		Object: kube.ApisixServiceEvent{
+			Key:          key,
+			GroupVersion: curr.GroupVersion(),
+			OldObject:    prev,
+		},
+	})
+
+	c.MetricsCollector.IncrEvents("Service", "update")
+}
+
+func (c *apisixServiceController) onDelete(obj interface{}) {
+	as, err := kube.NewApisixService(obj)
+	if err != nil {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		as = kube.MustNewApisixService(tombstone)
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorf("found ApisixService resource with bad meta namespace key: %s", err)
+		return
+	}
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("ApisixService delete event arrived",
+		zap.Any("final state", as),
+	)
+	c.workqueue.Add(&types.Event{
+		Type: types.EventDelete,
+		Object: kube.ApisixServiceEvent{
+			Key:          key,
+			GroupVersion: as.GroupVersion(),
+		},
+		Tombstone: as,
+	})
+
+	c.MetricsCollector.IncrEvents("Service", "delete")
+}
+
+func (c *apisixServiceController) ResourceSync() {
+	objs := c.ApisixServiceInformer.GetIndexer().List()
+	for _, obj := range objs {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			log.Errorw("ApisixService sync failed, found ApisixService resource with bad meta namespace key", zap.String("error", err.Error()))
+			continue
+		}
+		if !c.namespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		as := kube.MustNewApisixService(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixServiceEvent{
+				Key:          key,
+				GroupVersion: as.GroupVersion(),
+			},
+		})
+	}
+}
+
+// NotifyServiceAdd re-syncs the ApisixServices whose backend is the added
+// Kubernetes Service.
+func (c *apisixServiceController) NotifyServiceAdd(svcKey string) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(svcKey)
+	if err != nil {
+		return
+	}
+	for _, obj := range c.ApisixServiceInformer.GetIndexer().List() {
+		as := kube.MustNewApisixService(obj)
+		if as.GetNamespace() != namespace || as.V2().Spec.Backend.ServiceName != name {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil || !c.namespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixServiceEvent{
+				Key:          key,
+				GroupVersion: as.GroupVersion(),
+			},
+		})
+	}
+}
+
+// NotifySecretChange re-syncs the ApisixServices which refer to the changed Secret
+// in their plugins.
+func (c *apisixServiceController) NotifySecretChange(secretKey string) {
+	for _, obj := range c.secretDependents(c.ApisixServiceInformer, secretKey) {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil {
+			continue
+		}
+		log.Infow("secret changed, re-sync ApisixService",
+			zap.String("secret", secretKey),
+			zap.String("ApisixService", key),
+		)
+		as := kube.MustNewApisixService(obj)
+		c.workqueue.Add(&types.Event{
+			Type: types.EventAdd,
+			Object: kube.ApisixServiceEvent{
+				Key:          key,
+				GroupVersion: as.GroupVersion(),
+			},
+		})
+	}
+}
+
+// recordStatus record resources status
+func (c *apisixServiceController) recordStatus(at interface{}, reason string, err error, status metav1.ConditionStatus, generation int64) {
+	if c.Kubernetes.DisableStatusUpdates {
+		return
+	}
+	// build condition
+	message := utils.CommonSuccessMessage
+	if err != nil {
+		message = err.Error()
+	}
+	condition := metav1.Condition{
+		Type:               utils.ConditionType,
+		Reason:             reason,
+		Status:             status,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+	apisixClient := c.KubeClient.APISIXClient
+
+	if kubeObj, ok := at.(runtime.Object); ok {
+		at = kubeObj.DeepCopyObject()
+	}
+
+	switch v := at.(type) {
+	case *configv2.ApisixService:
+		// set to status
+		if v.Status.Conditions == nil {
+			conditions := make([]metav1.Condition, 0)
+			v.Status.Conditions = conditions
+		}
+		changed := false
+		if utils.VerifyConditions(&v.Status.Conditions, condition) {
+			meta.SetStatusCondition(&v.Status.Conditions, condition)
+			changed = true
+		}
+		if clusterConditions := c.ClusterConditions(v, err, generation); clusterConditions != nil {
+			changed = utils.SetClusterConditions(&v.Status.Conditions, clusterConditions) || changed
+		}
+		if changed {
+			if _, errRecord := apisixClient.ApisixV2().ApisixServices(v.Namespace).
+				UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+				log.Errorw("failed to record status change for ApisixService",
+					zap.Error(errRecord),
+					zap.String("name", v.Name),
+					zap.String("namespace", v.Namespace),
+				)
+			}
+		}
+	default:
+		// This should not be executed
+		log.Errorf("unsupported resource record: %s", v)
+	}
+}
@@ -161,6 +161,7 @@ func (c *apisixTlsController) sync(ctx context.Context, ev *types.Event) error {
 			zap.Any("ssl", ssl),
 			zap.Any("ApisixTls", tls),
 		)
+		ssl.Labels = utils.OwnerLabels(ssl.Labels, c.Config.Kubernetes.ElectionID, "ApisixTls", apisixTlsKey)
 
 		secretKey := tls.Spec.Secret.Namespace + "/" + tls.Spec.Secret.Name
 		c.storeSecretCache(secretKey, apisixTlsKey, ssl, ev.Type)
@@ -176,8 +177,9 @@ func (c *apisixTlsController) sync(ctx context.Context, ev *types.Event) error {
 				zap.Error(err),
 				zap.Any("ssl", ssl),
 			)
-			c.RecordEvent(tls, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
-			c.recordStatus(tls, utils.ResourceSyncAborted, err, metav1.ConditionFalse, tls.GetGeneration())
+			reason := utils.SyncFailedReason(err)
+			c.RecordEvent(tls, corev1.EventTypeWarning, reason, err)
+			c.recordStatus(tls, reason, err, metav1.ConditionFalse, tls.GetGeneration())
 			return err
 		}
 		c.RecordEvent(tls, corev1.EventTypeNormal, utils.ResourceSynced, nil)
@@ -199,6 +201,7 @@ func (c *apisixTlsController) sync(ctx context.Context, ev *types.Event) error {
 			zap.Any("ssl", ssl),
 			zap.Any("ApisixTls", tls),
 		)
+		ssl.Labels = utils.OwnerLabels(ssl.Labels, c.Config.Kubernetes.ElectionID, "ApisixTls", apisixTlsKey)
 
 		secretKey := tls.Spec.Secret.Namespace + "/" + tls.Spec.Secret.Name
 		c.storeSecretCache(secretKey, apisixTlsKey, ssl, ev.Type)
@@ -214,8 +217,9 @@ func (c *apisixTlsController) sync(ctx context.Context, ev *types.Event) error {
 				zap.Error(err),
 				zap.Any("ssl", ssl),
 			)
-			c.RecordEvent(tls, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
-			c.recordStatus(tls, utils.ResourceSyncAborted, err, metav1.ConditionFalse, tls.GetGeneration())
+			reason := utils.SyncFailedReason(err)
+			c.RecordEvent(tls, corev1.EventTypeWarning, reason, err)
+			c.recordStatus(tls, reason, err, metav1.ConditionFalse, tls.GetGeneration())
 			return err
 		}
 		c.RecordEvent(tls, corev1.EventTypeNormal, utils.ResourceSynced, nil)
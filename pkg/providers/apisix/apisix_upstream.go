@@ -202,59 +202,60 @@ func (c *apisixUpstreamController) sync(ctx context.Context, ev *types.Event) er
 		if au.Spec != nil && len(au.Spec.Subsets) > 0 {
 			subsets = append(subsets, au.Spec.Subsets...)
 		}
-		clusterName := c.Config.APISIX.DefaultClusterName
+		clusters := c.APISIX.ListClusters()
 		for _, port := range svc.Spec.Ports {
 			for _, subset := range subsets {
 				upsName := apisixv1.ComposeUpstreamName(namespace, name, subset.Name, port.Port, "")
-				// TODO: multiple cluster
-				ups, err := c.APISIX.Cluster(clusterName).Upstream().Get(ctx, upsName)
-				if err != nil {
-					if err == apisixcache.ErrNotFound {
-						continue
-					}
-					log.Errorf("failed to get upstream %s: %s", upsName, err)
-					c.RecordEvent(au, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
-					c.recordStatus(au, utils.ResourceSyncAborted, err, metav1.ConditionFalse, au.GetGeneration())
-					return err
-				}
-				var newUps *apisixv1.Upstream
-				if au.Spec != nil && ev.Type != types.EventDelete {
-					cfg, ok := portLevelSettings[port.Port]
-					if !ok {
-						cfg = au.Spec.ApisixUpstreamConfig
-					}
-					// FIXME Same ApisixUpstreamConfig might be translated multiple times.
-					newUps, err = c.translator.TranslateUpstreamConfigV2beta3(&cfg)
+				for _, cluster := range clusters {
+					ups, err := cluster.Upstream().Get(ctx, upsName)
 					if err != nil {
-						log.Errorw("found malformed ApisixUpstream",
-							zap.Any("object", au),
-							zap.Error(err),
-						)
+						if err == apisixcache.ErrNotFound {
+							continue
+						}
+						log.Errorf("failed to get upstream %s: %s", upsName, err)
 						c.RecordEvent(au, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
 						c.recordStatus(au, utils.ResourceSyncAborted, err, metav1.ConditionFalse, au.GetGeneration())
 						return err
 					}
-				} else {
-					newUps = apisixv1.NewDefaultUpstream()
-				}
+					var newUps *apisixv1.Upstream
+					if au.Spec != nil && ev.Type != types.EventDelete {
+						cfg, ok := portLevelSettings[port.Port]
+						if !ok {
+							cfg = au.Spec.ApisixUpstreamConfig
+						}
+						// FIXME Same ApisixUpstreamConfig might be translated multiple times.
+						newUps, err = c.translator.TranslateUpstreamConfigV2beta3(&cfg)
+						if err != nil {
+							log.Errorw("found malformed ApisixUpstream",
+								zap.Any("object", au),
+								zap.Error(err),
+							)
+							c.RecordEvent(au, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
+							c.recordStatus(au, utils.ResourceSyncAborted, err, metav1.ConditionFalse, au.GetGeneration())
+							return err
+						}
+					} else {
+						newUps = apisixv1.NewDefaultUpstream()
+					}
 
-				newUps.Metadata = ups.Metadata
-				newUps.Nodes = ups.Nodes
-				log.Debugw("updating upstream since ApisixUpstream changed",
-					zap.String("event", ev.Type.String()),
-					zap.Any("upstream", newUps),
-					zap.Any("ApisixUpstream", au),
-				)
-				if _, err := c.APISIX.Cluster(clusterName).Upstream().Update(ctx, newUps); err != nil {
-					log.Errorw("failed to update upstream",
-						zap.Error(err),
+					newUps.Metadata = ups.Metadata
+					newUps.Nodes = ups.Nodes
+					log.Debugw("updating upstream since ApisixUpstream changed",
+						zap.String("event", ev.Type.String()),
 						zap.Any("upstream", newUps),
 						zap.Any("ApisixUpstream", au),
-						zap.String("cluster", clusterName),
 					)
-					c.RecordEvent(au, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
-					c.recordStatus(au, utils.ResourceSyncAborted, err, metav1.ConditionFalse, au.GetGeneration())
-					return err
+					if _, err := cluster.Upstream().Update(ctx, newUps); err != nil {
+						log.Errorw("failed to update upstream",
+							zap.Error(err),
+							zap.Any("upstream", newUps),
+							zap.Any("ApisixUpstream", au),
+							zap.String("cluster", cluster.String()),
+						)
+						c.RecordEvent(au, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
+						c.recordStatus(au, utils.ResourceSyncAborted, err, metav1.ConditionFalse, au.GetGeneration())
+						return err
+					}
 				}
 			}
 		}
@@ -355,66 +356,68 @@ func (c *apisixUpstreamController) sync(ctx context.Context, ev *types.Event) er
 	return err
 }
 
+// updateUpstream updates the upstream with the ApisixUpstream config in all
+// clusters, the ones the upstream is not synced to are skipped.
 func (c *apisixUpstreamController) updateUpstream(ctx context.Context, upsName string, cfg *configv2.ApisixUpstreamConfig) error {
-	// TODO: multi cluster
-	clusterName := c.Config.APISIX.DefaultClusterName
-
-	ups, err := c.APISIX.Cluster(clusterName).Upstream().Get(ctx, upsName)
-	if err != nil {
-		if err == apisixcache.ErrNotFound {
-			return nil
-		}
-		log.Errorf("failed to get upstream %s: %s", upsName, err)
-		return err
-	}
-	var newUps *apisixv1.Upstream
-	if cfg != nil {
-		newUps, err = c.translator.TranslateUpstreamConfigV2(cfg)
+	for _, cluster := range c.APISIX.ListClusters() {
+		ups, err := cluster.Upstream().Get(ctx, upsName)
 		if err != nil {
-			log.Errorw("ApisixUpstream conversion cannot be completed, or the format is incorrect",
-				zap.String("ApisixUpstream name", upsName),
-				zap.Error(err),
-			)
+			if err == apisixcache.ErrNotFound {
+				continue
+			}
+			log.Errorf("failed to get upstream %s: %s", upsName, err)
 			return err
 		}
-	} else {
-		newUps = apisixv1.NewDefaultUpstream()
-	}
+		var newUps *apisixv1.Upstream
+		if cfg != nil {
+			newUps, err = c.translator.TranslateUpstreamConfigV2(cfg)
+			if err != nil {
+				log.Errorw("ApisixUpstream conversion cannot be completed, or the format is incorrect",
+					zap.String("ApisixUpstream name", upsName),
+					zap.Error(err),
+				)
+				return err
+			}
+		} else {
+			newUps = apisixv1.NewDefaultUpstream()
+		}
 
-	newUps.Metadata = ups.Metadata
-	newUps.Nodes = ups.Nodes
-	log.Debugw("updating upstream since ApisixUpstream changed",
-		zap.Any("upstream", newUps),
-		zap.String("ApisixUpstream name", upsName),
-	)
-	if _, err := c.APISIX.Cluster(clusterName).Upstream().Update(ctx, newUps); err != nil {
-		log.Errorw("failed to update upstream",
-			zap.Error(err),
+		newUps.Metadata = ups.Metadata
+		newUps.Nodes = ups.Nodes
+		log.Debugw("updating upstream since ApisixUpstream changed",
 			zap.Any("upstream", newUps),
 			zap.String("ApisixUpstream name", upsName),
-			zap.String("cluster", clusterName),
+			zap.String("cluster", cluster.String()),
 		)
-		return err
+		if _, err := cluster.Upstream().Update(ctx, newUps); err != nil {
+			log.Errorw("failed to update upstream",
+				zap.Error(err),
+				zap.Any("upstream", newUps),
+				zap.String("ApisixUpstream name", upsName),
+				zap.String("cluster", cluster.String()),
+			)
+			return err
+		}
 	}
 	return nil
 }
 
 func (c *apisixUpstreamController) updateExternalNodes(ctx context.Context, au *configv2.ApisixUpstream, old *configv2.ApisixUpstream, newUps *apisixv1.Upstream, ns, name string) error {
-	clusterName := c.Config.APISIX.DefaultClusterName
-
 	// TODO: if old is not nil, diff the external nodes change first
 
 	upsName := apisixv1.ComposeExternalUpstreamName(ns, name)
-	ups, err := c.APISIX.Cluster(clusterName).Upstream().Get(ctx, upsName)
-	if err != nil {
-		if err != apisixcache.ErrNotFound {
-			log.Errorf("failed to get upstream %s: %s", upsName, err)
-			c.RecordEvent(au, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
-			c.recordStatus(au, utils.ResourceSyncAborted, err, metav1.ConditionFalse, au.GetGeneration())
-			return err
+	for _, cluster := range c.APISIX.ListClusters() {
+		ups, err := cluster.Upstream().Get(ctx, upsName)
+		if err != nil {
+			if err != apisixcache.ErrNotFound {
+				log.Errorf("failed to get upstream %s: %s", upsName, err)
+				c.RecordEvent(au, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
+				c.recordStatus(au, utils.ResourceSyncAborted, err, metav1.ConditionFalse, au.GetGeneration())
+				return err
+			}
+			// Do nothing if not found
+			continue
 		}
-		// Do nothing if not found
-	} else {
 		nodes, err := c.translator.TranslateApisixUpstreamExternalNodes(au)
 		if err != nil {
 			log.Errorf("failed to translate upstream external nodes %s: %s", upsName, err)
@@ -423,17 +426,18 @@ func (c *apisixUpstreamController) updateExternalNodes(ctx context.Context, au *
 			return err
 		}
 		if newUps != nil {
-			newUps.Metadata = ups.Metadata
-			ups = newUps
+			metadata := ups.Metadata
+			ups = newUps.DeepCopy()
+			ups.Metadata = metadata
 		}
 
 		ups.Nodes = nodes
-		if _, err := c.APISIX.Cluster(clusterName).Upstream().Update(ctx, ups); err != nil {
+		if _, err := cluster.Upstream().Update(ctx, ups); err != nil {
 			log.Errorw("failed to update external nodes upstream",
 				zap.Error(err),
 				zap.Any("upstream", ups),
 				zap.Any("ApisixUpstream", au),
-				zap.String("cluster", clusterName),
+				zap.String("cluster", cluster.String()),
 			)
 			c.RecordEvent(au, corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
 			c.recordStatus(au, utils.ResourceSyncAborted, err, metav1.ConditionFalse, au.GetGeneration())
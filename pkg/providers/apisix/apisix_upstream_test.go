@@ -0,0 +1,73 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixtranslation "github.com/apache/apisix-ingress-controller/pkg/providers/apisix/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	providertypes "github.com/apache/apisix-ingress-controller/pkg/providers/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestUpdateUpstreamInAllClusters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := apisix.NewClient("v3")
+	assert.Nil(t, err)
+	for _, name := range []string{"default", "edge"} {
+		assert.Nil(t, client.AddCluster(ctx, &apisix.ClusterOptions{
+			Name:               name,
+			StandaloneRenderer: apisix.NewFileRenderer(filepath.Join(t.TempDir(), name+".yaml")),
+		}))
+	}
+
+	// The upstream is only synced to the non-default cluster.
+	upsName := apisixv1.ComposeExternalUpstreamName("default", "httpbin")
+	ups := apisixv1.NewDefaultUpstream()
+	ups.Name = upsName
+	ups.ID = id.GenID(upsName)
+	ups.Nodes = apisixv1.UpstreamNodes{{Host: "httpbin.org", Port: 80, Weight: 100}}
+	_, err = client.Cluster("edge").Upstream().Create(ctx, ups)
+	assert.Nil(t, err)
+
+	c := &apisixUpstreamController{
+		apisixCommon: &apisixCommon{
+			Common: &providertypes.Common{
+				APISIX: client,
+			},
+			translator: apisixtranslation.NewApisixTranslator(&apisixtranslation.TranslatorOptions{},
+				translation.NewTranslator(&translation.TranslatorOptions{})),
+		},
+	}
+	retries := 3
+	assert.Nil(t, c.updateUpstream(ctx, upsName, &configv2.ApisixUpstreamConfig{
+		Retries: &retries,
+	}))
+
+	ups, err = client.Cluster("edge").Upstream().Get(ctx, upsName)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, *ups.Retries)
+	assert.Equal(t, apisixv1.UpstreamNodes{{Host: "httpbin.org", Port: 80, Weight: 100}}, ups.Nodes)
+}
@@ -0,0 +1,142 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package apisix
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+// TranslateManifests translates ApisixRoute, ApisixPluginConfig, ApisixTls,
+// ApisixGlobalRule and ApisixService resources.
+func (p *apisixProvider) TranslateManifests(kind, key string, m *utils.Manifest) error {
+	informer, err := p.manifestInformer(kind)
+	if err != nil {
+		return err
+	}
+	objs, err := utils.ListObjects(informer.GetIndexer(), key)
+	if err != nil {
+		return err
+	}
+
+	var merr *multierror.Error
+	for _, obj := range objs {
+		objKey, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil || !p.namespaceProvider.IsWatchingNamespace(objKey) {
+			continue
+		}
+		om := &utils.Manifest{}
+		if err := p.translateManifest(kind, obj, om); err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("%s %s: %s", kind, objKey, err))
+			continue
+		}
+		om.SetOwnerLabels(p.common.Config.Kubernetes.ElectionID, kind, objKey)
+		m.Append(om)
+	}
+	return merr.ErrorOrNil()
+}
+
+// ListManifestResources lists ApisixRoute, ApisixPluginConfig, ApisixTls,
+// ApisixGlobalRule and ApisixService resources.
+func (p *apisixProvider) ListManifestResources(kind string) ([]interface{}, error) {
+	informer, err := p.manifestInformer(kind)
+	if err != nil {
+		return nil, err
+	}
+	return informer.GetIndexer().List(), nil
+}
+
+func (p *apisixProvider) manifestInformer(kind string) (cache.SharedIndexInformer, error) {
+	var informer cache.SharedIndexInformer
+	switch kind {
+	case "ApisixRoute":
+		informer = p.common.ApisixRouteInformer
+	case "ApisixPluginConfig":
+		informer = p.common.ApisixPluginConfigInformer
+	case "ApisixTls":
+		informer = p.common.ApisixTlsInformer
+	case "ApisixGlobalRule":
+		informer = p.common.ApisixGlobalRuleInformer
+	case "ApisixService":
+		informer = p.common.ApisixServiceInformer
+	}
+	if informer == nil {
+		return nil, fmt.Errorf("unsupported resource kind %s", kind)
+	}
+	return informer, nil
+}
+
+func (p *apisixProvider) translateManifest(kind string, obj interface{}, m *utils.Manifest) error {
+	var (
+		tctx *translation.TranslateContext
+		ssl  *apisixv1.Ssl
+		err  error
+	)
+	switch kind {
+	case "ApisixRoute":
+		ar := kube.MustNewApisixRoute(obj)
+		switch ar.GroupVersion() {
+		case config.ApisixV2beta3:
+			tctx, err = p.apisixTranslator.TranslateRouteV2beta3(ar.V2beta3())
+		case config.ApisixV2:
+			tctx, err = p.apisixTranslator.TranslateRouteV2(ar.V2())
+		}
+	case "ApisixPluginConfig":
+		apc := kube.MustNewApisixPluginConfig(obj)
+		switch apc.GroupVersion() {
+		case config.ApisixV2beta3:
+			tctx, err = p.apisixTranslator.TranslatePluginConfigV2beta3(apc.V2beta3())
+		case config.ApisixV2:
+			tctx, err = p.apisixTranslator.TranslatePluginConfigV2(apc.V2())
+		}
+	case "ApisixTls":
+		tls := kube.MustNewApisixTls(obj)
+		switch tls.GroupVersion() {
+		case config.ApisixV2beta3:
+			ssl, err = p.apisixTranslator.TranslateSSLV2Beta3(tls.V2beta3())
+		case config.ApisixV2:
+			ssl, err = p.apisixTranslator.TranslateSSLV2(tls.V2())
+		}
+	case "ApisixGlobalRule":
+		tctx, err = p.apisixTranslator.TranslateGlobalRule(kube.MustNewApisixGlobalRule(obj))
+	case "ApisixService":
+		tctx, err = p.apisixTranslator.TranslateApisixServiceV2(kube.MustNewApisixService(obj).V2())
+	}
+	if err != nil {
+		return err
+	}
+	if ssl != nil {
+		m.SSLs = append(m.SSLs, ssl)
+	}
+	if tctx != nil {
+		m.Routes = append(m.Routes, tctx.Routes...)
+		m.Upstreams = append(m.Upstreams, tctx.Upstreams...)
+		m.StreamRoutes = append(m.StreamRoutes, tctx.StreamRoutes...)
+		m.PluginConfigs = append(m.PluginConfigs, tctx.PluginConfigs...)
+		m.GlobalRules = append(m.GlobalRules, tctx.GlobalRules...)
+		m.Services = append(m.Services, tctx.Services...)
+	}
+	return nil
+}
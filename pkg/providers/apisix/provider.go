@@ -45,6 +45,7 @@ var _ Provider = (*apisixProvider)(nil)
 
 type Provider interface {
 	providertypes.Provider
+	providertypes.ManifestTranslator
 
 	Init(ctx context.Context) error
 	ResourceSync()
@@ -52,6 +53,9 @@ type Provider interface {
 	NotifyApisixUpstreamChange(key string)
 
 	SyncSecretChange(ctx context.Context, ev *types.Event, secret *corev1.Secret, secretMapKey string)
+	// IsAdminSecret tells whether the Secret is referenced by the admin config
+	// of any ApisixClusterConfig.
+	IsAdminSecret(secretKey string) bool
 }
 
 type apisixProvider struct {
@@ -59,14 +63,18 @@ type apisixProvider struct {
 	common            *providertypes.Common
 	namespaceProvider namespace.WatchingNamespaceProvider
 
-	apisixTranslator              apisixtranslation.ApisixTranslator
-	apisixUpstreamController      *apisixUpstreamController
-	apisixRouteController         *apisixRouteController
-	apisixTlsController           *apisixTlsController
-	apisixClusterConfigController *apisixClusterConfigController
-	apisixConsumerController      *apisixConsumerController
-	apisixPluginConfigController  *apisixPluginConfigController
-	apisixGlobalRuleController    *apisixGlobalRuleController
+	apisixTranslator               apisixtranslation.ApisixTranslator
+	apisixUpstreamController       *apisixUpstreamController
+	apisixRouteController          *apisixRouteController
+	apisixTlsController            *apisixTlsController
+	apisixClusterConfigController  *apisixClusterConfigController
+	apisixConsumerController       *apisixConsumerController
+	apisixPluginConfigController   *apisixPluginConfigController
+	apisixGlobalRuleController     *apisixGlobalRuleController
+	apisixConsumerGroupController  *apisixConsumerGroupController
+	apisixSecretProviderController *apisixSecretProviderController
+	apisixServiceController        *apisixServiceController
+	apisixProtoController          *apisixProtoController
 }
 
 func NewProvider(common *providertypes.Common, namespaceProvider namespace.WatchingNamespaceProvider,
@@ -83,7 +91,23 @@ func NewProvider(common *providertypes.Common, namespaceProvider namespace.Watch
 		ServiceLister:        common.SvcLister,
 		ApisixUpstreamLister: common.ApisixUpstreamLister,
 		SecretLister:         common.SecretLister,
+		ConfigMapLister:      common.ConfigMapLister,
 	}, translator)
+	if err := addSecretIndexers(
+		common.ApisixRouteInformer,
+		common.ApisixPluginConfigInformer,
+		common.ApisixConsumerInformer,
+		common.ApisixGlobalRuleInformer,
+		common.ApisixConsumerGroupInformer,
+		common.ApisixSecretProviderInformer,
+		common.ApisixServiceInformer,
+		common.ApisixClusterConfigInformer,
+	); err != nil {
+		return nil, nil, err
+	}
+	if err := addConfigMapIndexer(common.ApisixProtoInformer); err != nil {
+		return nil, nil, err
+	}
 	c := &apisixCommon{
 		Common:            common,
 		namespaceProvider: namespaceProvider,
@@ -93,11 +117,15 @@ func NewProvider(common *providertypes.Common, namespaceProvider namespace.Watch
 	p.apisixUpstreamController = newApisixUpstreamController(c, p.NotifyApisixUpstreamChange)
 	p.apisixRouteController = newApisixRouteController(c)
 	p.apisixTlsController = newApisixTlsController(c)
-	p.apisixClusterConfigController = newApisixClusterConfigController(c)
+	p.apisixClusterConfigController = newApisixClusterConfigController(c, p.resyncClusterResources)
 	p.apisixConsumerController = newApisixConsumerController(c)
 	p.apisixPluginConfigController = newApisixPluginConfigController(c)
 	if p.common.Kubernetes.APIVersion == config.ApisixV2 {
 		p.apisixGlobalRuleController = newApisixGlobalRuleController(c)
+		p.apisixConsumerGroupController = newApisixConsumerGroupController(c)
+		p.apisixSecretProviderController = newApisixSecretProviderController(c)
+		p.apisixServiceController = newApisixServiceController(c)
+		p.apisixProtoController = newApisixProtoController(c)
 	}
 
 	return p, p.apisixTranslator, nil
@@ -128,6 +156,18 @@ func (p *apisixProvider) Run(ctx context.Context) {
 		e.Add(func() {
 			p.apisixGlobalRuleController.run(ctx)
 		})
+		e.Add(func() {
+			p.apisixConsumerGroupController.run(ctx)
+		})
+		e.Add(func() {
+			p.apisixSecretProviderController.run(ctx)
+		})
+		e.Add(func() {
+			p.apisixServiceController.run(ctx)
+		})
+		e.Add(func() {
+			p.apisixProtoController.run(ctx)
+		})
 	}
 
 	e.Wait()
@@ -142,18 +182,71 @@ func (p *apisixProvider) ResourceSync() {
 	e.Add(p.apisixClusterConfigController.ResourceSync)
 	e.Add(p.apisixConsumerController.ResourceSync)
 	e.Add(p.apisixPluginConfigController.ResourceSync)
+	if p.apisixConsumerGroupController != nil {
+		e.Add(p.apisixConsumerGroupController.ResourceSync)
+	}
+	if p.apisixSecretProviderController != nil {
+		e.Add(p.apisixSecretProviderController.ResourceSync)
+	}
+	if p.apisixServiceController != nil {
+		e.Add(p.apisixServiceController.ResourceSync)
+	}
+	if p.apisixProtoController != nil {
+		e.Add(p.apisixProtoController.ResourceSync)
+	}
 
 	e.Wait()
 }
 
+// resyncClusterResources re-syncs resources which may select APISIX clusters,
+// so that a newly registered cluster receives them.
+func (p *apisixProvider) resyncClusterResources() {
+	p.apisixPluginConfigController.ResourceSync()
+	p.apisixRouteController.ResourceSync()
+	if p.apisixGlobalRuleController != nil {
+		p.apisixGlobalRuleController.ResourceSync()
+	}
+	if p.apisixServiceController != nil {
+		p.apisixServiceController.ResourceSync()
+	}
+}
+
 func (p *apisixProvider) NotifyServiceAdd(key string) {
 	p.apisixRouteController.NotifyServiceAdd(key)
+	if p.apisixServiceController != nil {
+		p.apisixServiceController.NotifyServiceAdd(key)
+	}
 }
 
 func (p *apisixProvider) NotifyApisixUpstreamChange(key string) {
 	p.apisixRouteController.NotifyApisixUpstreamChange(key)
 }
 
+func (p *apisixProvider) IsAdminSecret(secretKey string) bool {
+	return isAdminSecret(p.common.ApisixClusterConfigInformer, secretKey)
+}
+
 func (p *apisixProvider) SyncSecretChange(ctx context.Context, ev *types.Event, secret *corev1.Secret, secretMapKey string) {
 	p.apisixTlsController.SyncSecretChange(ctx, ev, secret, secretMapKey)
+	p.apisixClusterConfigController.SyncSecretChange(ctx, ev, secret, secretMapKey)
+
+	if ev.Type == types.EventDelete {
+		// Keep the current credentials until the Secret is re-created.
+		return
+	}
+	p.apisixRouteController.NotifySecretChange(secretMapKey)
+	p.apisixPluginConfigController.NotifySecretChange(secretMapKey)
+	p.apisixConsumerController.NotifySecretChange(secretMapKey)
+	if p.apisixGlobalRuleController != nil {
+		p.apisixGlobalRuleController.NotifySecretChange(secretMapKey)
+	}
+	if p.apisixConsumerGroupController != nil {
+		p.apisixConsumerGroupController.NotifySecretChange(secretMapKey)
+	}
+	if p.apisixSecretProviderController != nil {
+		p.apisixSecretProviderController.NotifySecretChange(secretMapKey)
+	}
+	if p.apisixServiceController != nil {
+		p.apisixServiceController.NotifySecretChange(secretMapKey)
+	}
 }
@@ -0,0 +1,235 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	configv2beta3 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2beta3"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+)
+
+// _secretIndex is the name of the informer index which maps a Secret
+// (namespace/name) to the resources referring to it.
+const _secretIndex = "secret"
+
+// addSecretIndexers registers the Secret index to the informers, it must be
+// called before the informers are started.
+func addSecretIndexers(informers ...cache.SharedIndexInformer) error {
+	for _, informer := range informers {
+		if informer == nil {
+			continue
+		}
+		if err := informer.AddIndexers(cache.Indexers{_secretIndex: secretIndexFunc}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// secretIndexFunc returns the keys of Secrets referred by obj, all of them
+// are in the same namespace of obj.
+func secretIndexFunc(obj interface{}) ([]string, error) {
+	var (
+		namespace string
+		names     []string
+	)
+	switch o := obj.(type) {
+	case *configv2.ApisixRoute:
+		namespace = o.Namespace
+		for _, part := range o.Spec.HTTP {
+			names = append(names, pluginSecretRefs(part.Plugins)...)
+		}
+		for _, part := range o.Spec.Stream {
+			names = append(names, pluginSecretRefs(part.Plugins)...)
+		}
+	case *configv2.ApisixPluginConfig:
+		namespace = o.Namespace
+		names = pluginSecretRefs(o.Spec.Plugins)
+	case *configv2.ApisixGlobalRule:
+		namespace = o.Namespace
+		names = pluginSecretRefs(o.Spec.Plugins)
+	case *configv2.ApisixConsumerGroup:
+		namespace = o.Namespace
+		names = pluginSecretRefs(o.Spec.Plugins)
+	case *configv2.ApisixService:
+		namespace = o.Namespace
+		names = pluginSecretRefs(o.Spec.Plugins)
+	case *configv2.ApisixConsumer:
+		namespace = o.Namespace
+		// The credentials are referred from the secret provider.
+		if o.Spec.SecretProvider == "" {
+			names = consumerSecretRefsV2(&o.Spec.AuthParameter)
+		}
+	case *configv2.ApisixSecretProvider:
+		namespace = o.Namespace
+		if o.Spec.Vault != nil && o.Spec.Vault.TokenSecretRef != nil {
+			names = []string{o.Spec.Vault.TokenSecretRef.Name}
+		}
+	case *configv2beta3.ApisixConsumer:
+		namespace = o.Namespace
+		names = consumerSecretRefsV2beta3(&o.Spec.AuthParameter)
+	case *configv2.ApisixClusterConfig:
+		// ApisixClusterConfig is cluster scoped, the keys carry namespaces.
+		if o.Spec.Admin == nil {
+			return nil, nil
+		}
+		return adminSecretKeys(adminSecretsV2(o.Spec.Admin)), nil
+	case *configv2beta3.ApisixClusterConfig:
+		if o.Spec.Admin == nil {
+			return nil, nil
+		}
+		return adminSecretKeys(adminSecretsV2beta3(o.Spec.Admin)), nil
+	default:
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		key := namespace + "/" + name
+		if !utils.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func adminSecretKeys(secrets []string) []string {
+	var keys []string
+	for _, key := range secrets {
+		if key != "" && !utils.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func pluginSecretRefs(plugins []configv2.ApisixRoutePlugin) []string {
+	var names []string
+	for _, plugin := range plugins {
+		if !plugin.Enable {
+			continue
+		}
+		if plugin.SecretRef != "" {
+			names = append(names, plugin.SecretRef)
+		}
+		for _, ref := range plugin.SecretKeyRefs {
+			// References are resolved by APISIX.
+			if ref.Provider == "" && ref.Env == "" {
+				names = append(names, ref.Name)
+			}
+		}
+	}
+	return names
+}
+
+func consumerSecretRefsV2(auth *configv2.ApisixConsumerAuthParameter) []string {
+	var refs []*corev1.LocalObjectReference
+	if auth.BasicAuth != nil {
+		refs = append(refs, auth.BasicAuth.SecretRef)
+	}
+	if auth.KeyAuth != nil {
+		refs = append(refs, auth.KeyAuth.SecretRef)
+	}
+	if auth.WolfRBAC != nil {
+		refs = append(refs, auth.WolfRBAC.SecretRef)
+	}
+	if auth.JwtAuth != nil {
+		refs = append(refs, auth.JwtAuth.SecretRef)
+	}
+	if auth.HMACAuth != nil {
+		refs = append(refs, auth.HMACAuth.SecretRef)
+	}
+	if auth.LDAPAuth != nil {
+		refs = append(refs, auth.LDAPAuth.SecretRef)
+	}
+	return localSecretRefs(refs)
+}
+
+func consumerSecretRefsV2beta3(auth *configv2beta3.ApisixConsumerAuthParameter) []string {
+	var refs []*corev1.LocalObjectReference
+	if auth.BasicAuth != nil {
+		refs = append(refs, auth.BasicAuth.SecretRef)
+	}
+	if auth.KeyAuth != nil {
+		refs = append(refs, auth.KeyAuth.SecretRef)
+	}
+	if auth.WolfRBAC != nil {
+		refs = append(refs, auth.WolfRBAC.SecretRef)
+	}
+	if auth.JwtAuth != nil {
+		refs = append(refs, auth.JwtAuth.SecretRef)
+	}
+	if auth.HMACAuth != nil {
+		refs = append(refs, auth.HMACAuth.SecretRef)
+	}
+	return localSecretRefs(refs)
+}
+
+func localSecretRefs(refs []*corev1.LocalObjectReference) []string {
+	var names []string
+	for _, ref := range refs {
+		if ref != nil && ref.Name != "" {
+			names = append(names, ref.Name)
+		}
+	}
+	return names
+}
+
+// isAdminSecret tells whether the Secret is referenced by the admin config,
+// i.e. the admin key or the TLS materials, of any ApisixClusterConfig.
+func isAdminSecret(informer cache.SharedIndexInformer, secretKey string) bool {
+	if informer == nil {
+		return false
+	}
+	keys, err := informer.GetIndexer().IndexKeys(_secretIndex, secretKey)
+	if err != nil {
+		log.Errorf("failed to list ApisixClusterConfigs referring to secret %s: %s", secretKey, err)
+		return false
+	}
+	return len(keys) > 0
+}
+
+// secretDependents returns the objects in the informer which refer to
+// the Secret, and records an event on each of them.
+func (c *apisixCommon) secretDependents(informer cache.SharedIndexInformer, secretKey string) []interface{} {
+	if informer == nil {
+		return nil
+	}
+	objs, err := informer.GetIndexer().ByIndex(_secretIndex, secretKey)
+	if err != nil {
+		log.Errorf("failed to list resources referring to secret %s: %s", secretKey, err)
+		return nil
+	}
+	var dependents []interface{}
+	for _, obj := range objs {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil || !c.namespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		if robj, ok := obj.(runtime.Object); ok {
+			c.RecordEventS(robj, corev1.EventTypeNormal, utils.SecretChanged,
+				fmt.Sprintf(utils.MessageSecretChanged, secretKey))
+		}
+		dependents = append(dependents, obj)
+	}
+	return dependents
+}
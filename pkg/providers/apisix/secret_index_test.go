@@ -0,0 +1,180 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package apisix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	configv2beta3 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2beta3"
+)
+
+func TestSecretIndexFunc(t *testing.T) {
+	ar := &configv2.ApisixRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "httpbin",
+			Namespace: "qa",
+		},
+		Spec: configv2.ApisixRouteSpec{
+			HTTP: []configv2.ApisixRouteHTTP{
+				{
+					Name: "rule1",
+					Plugins: []configv2.ApisixRoutePlugin{
+						{Name: "echo", Enable: true, SecretRef: "echo"},
+						{Name: "cors", Enable: false, SecretRef: "cors"},
+					},
+				},
+				{
+					Name: "rule2",
+					Plugins: []configv2.ApisixRoutePlugin{
+						{Name: "echo", Enable: true, SecretRef: "echo"},
+					},
+				},
+			},
+			Stream: []configv2.ApisixRouteStream{
+				{
+					Name: "rule3",
+					Plugins: []configv2.ApisixRoutePlugin{
+						{Name: "ip-restriction", Enable: true, SecretRef: "ip"},
+						{
+							Name:   "kafka-logger",
+							Enable: true,
+							SecretKeyRefs: []configv2.ApisixRoutePluginSecretKeyRef{
+								{Name: "kafka", Key: "password", Path: "sasl_config.password"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	keys, err := secretIndexFunc(ar)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"qa/echo", "qa/ip", "qa/kafka"}, keys)
+
+	ac := &configv2.ApisixConsumer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "jack",
+			Namespace: "qa",
+		},
+		Spec: configv2.ApisixConsumerSpec{
+			AuthParameter: configv2.ApisixConsumerAuthParameter{
+				JwtAuth: &configv2.ApisixConsumerJwtAuth{
+					SecretRef: &corev1.LocalObjectReference{Name: "jack-jwt"},
+				},
+			},
+		},
+	}
+	keys, err = secretIndexFunc(ac)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"qa/jack-jwt"}, keys)
+
+	acV2beta3 := &configv2beta3.ApisixConsumer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "jack",
+			Namespace: "qa",
+		},
+		Spec: configv2beta3.ApisixConsumerSpec{
+			AuthParameter: configv2beta3.ApisixConsumerAuthParameter{
+				KeyAuth: &configv2beta3.ApisixConsumerKeyAuth{
+					Value: &configv2beta3.ApisixConsumerKeyAuthValue{Key: "jack"},
+				},
+			},
+		},
+	}
+	keys, err = secretIndexFunc(acV2beta3)
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	agr := &configv2.ApisixGlobalRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "global",
+			Namespace: "default",
+		},
+		Spec: configv2.ApisixGlobalRuleSpec{
+			Plugins: []configv2.ApisixRoutePlugin{
+				{Name: "http-logger", Enable: true, SecretRef: "logger"},
+				{
+					Name:   "openid-connect",
+					Enable: true,
+					SecretKeyRefs: []configv2.ApisixRoutePluginSecretKeyRef{
+						{Name: "oidc", Key: "client_secret", Path: "client_secret", Provider: "vault"},
+					},
+				},
+			},
+		},
+	}
+	keys, err = secretIndexFunc(agr)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default/logger"}, keys)
+
+	asp := &configv2.ApisixSecretProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault",
+			Namespace: "default",
+		},
+		Spec: configv2.ApisixSecretProviderSpec{
+			Vault: &configv2.ApisixSecretProviderVault{
+				URI:    "http://127.0.0.1:8200",
+				Prefix: "kv/apisix",
+				TokenSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "vault-token"},
+					Key:                  "token",
+				},
+			},
+		},
+	}
+	keys, err = secretIndexFunc(asp)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default/vault-token"}, keys)
+}
+
+func TestIsAdminSecret(t *testing.T) {
+	acc := &configv2.ApisixClusterConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "edge",
+		},
+		Spec: configv2.ApisixClusterConfigSpec{
+			Admin: &configv2.ApisixClusterAdminConfig{
+				BaseURL: "https://edge-apisix-admin:9180/apisix/admin",
+				AdminKeySecretRef: &configv2.ApisixSecretKeyRef{
+					Namespace: "apisix",
+					Name:      "admin-key",
+				},
+				TLS: &configv2.ApisixClusterAdminTLSConfig{
+					CASecret:         &configv2.ApisixSecret{Namespace: "apisix", Name: "admin-tls"},
+					ClientCertSecret: &configv2.ApisixSecret{Namespace: "apisix", Name: "admin-tls"},
+				},
+			},
+		},
+	}
+	keys, err := secretIndexFunc(acc)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"apisix/admin-key", "apisix/admin-tls"}, keys)
+
+	informer := cache.NewSharedIndexInformer(&cache.ListWatch{}, &configv2.ApisixClusterConfig{}, 0, cache.Indexers{})
+	assert.Nil(t, addSecretIndexers(informer))
+	assert.Nil(t, informer.GetIndexer().Add(acc))
+
+	assert.True(t, isAdminSecret(informer, "apisix/admin-key"))
+	assert.True(t, isAdminSecret(informer, "apisix/admin-tls"))
+	assert.False(t, isAdminSecret(informer, "default/admin-key"))
+	assert.False(t, isAdminSecret(nil, "apisix/admin-key"))
+}
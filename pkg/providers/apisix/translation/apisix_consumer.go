@@ -17,6 +17,7 @@ package translation
 import (
 	"fmt"
 
+	"github.com/apache/apisix-ingress-controller/pkg/id"
 	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
 	configv2beta3 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2beta3"
 	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
@@ -70,7 +71,13 @@ func (t *translator) TranslateApisixConsumerV2(ac *configv2.ApisixConsumer) (*ap
 	// so here the order is no matter.
 
 	plugins := make(apisixv1.Plugins)
-	if ac.Spec.AuthParameter.KeyAuth != nil {
+	if ac.Spec.SecretProvider != "" && hasConsumerAuthSecretRefV2(&ac.Spec.AuthParameter) {
+		name, cfg, err := translateConsumerAuthReferencesV2(ac.Namespace, ac.Spec.SecretProvider, &ac.Spec.AuthParameter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret provider config: %s", err)
+		}
+		plugins[name] = cfg
+	} else if ac.Spec.AuthParameter.KeyAuth != nil {
 		cfg, err := t.translateConsumerKeyAuthPluginV2(ac.Namespace, ac.Spec.AuthParameter.KeyAuth)
 		if err != nil {
 			return nil, fmt.Errorf("invalid key auth config: %s", err)
@@ -111,5 +118,8 @@ func (t *translator) TranslateApisixConsumerV2(ac *configv2.ApisixConsumer) (*ap
 	consumer := apisixv1.NewDefaultConsumer()
 	consumer.Username = apisixv1.ComposeConsumerName(ac.Namespace, ac.Name)
 	consumer.Plugins = plugins
+	if ac.Spec.GroupRef != "" {
+		consumer.GroupID = id.GenID(apisixv1.ComposeConsumerGroupName(ac.Namespace, ac.Spec.GroupRef))
+	}
 	return consumer, nil
 }
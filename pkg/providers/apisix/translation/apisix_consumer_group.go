@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func (t *translator) TranslateApisixConsumerGroupV2(acg *configv2.ApisixConsumerGroup) (*apisixv1.ConsumerGroup, error) {
+	pluginMap := make(apisixv1.Plugins)
+	for _, plugin := range acg.Spec.Plugins {
+		if !plugin.Enable {
+			continue
+		}
+		// Here, it will override same key.
+		if t, ok := pluginMap[plugin.Name]; ok {
+			log.Infow("TranslateApisixConsumerGroupV2 override same plugin key",
+				zap.String("key", plugin.Name),
+				zap.Any("old", t),
+				zap.Any("new", plugin.Config),
+			)
+		}
+		cfg, err := t.translatePluginConfigV2(acg.Namespace, &plugin)
+		if errors.Is(err, _errInvalidSecretRef) {
+			log.Errorw("The config secretRef is invalid",
+				zap.String("plugin", plugin.Name),
+				zap.Error(err),
+			)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pluginMap[plugin.Name] = cfg
+	}
+
+	cg := apisixv1.NewDefaultConsumerGroup()
+	cg.ID = id.GenID(apisixv1.ComposeConsumerGroupName(acg.Namespace, acg.Name))
+	if acg.Spec.Desc != "" {
+		cg.Desc = acg.Spec.Desc
+	}
+	cg.Plugins = pluginMap
+	return cg, nil
+}
+
+func (t *translator) GenerateApisixConsumerGroupV2DeleteMark(acg *configv2.ApisixConsumerGroup) *apisixv1.ConsumerGroup {
+	cg := apisixv1.NewDefaultConsumerGroup()
+	cg.ID = id.GenID(apisixv1.ComposeConsumerGroupName(acg.Namespace, acg.Name))
+	return cg
+}
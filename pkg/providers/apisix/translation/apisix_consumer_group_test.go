@@ -0,0 +1,126 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestTranslateApisixConsumerGroupV2(t *testing.T) {
+	acg := &configv2.ApisixConsumerGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gold",
+			Namespace: "qa",
+		},
+		Spec: configv2.ApisixConsumerGroupSpec{
+			Desc: "gold plan",
+			Plugins: []configv2.ApisixRoutePlugin{
+				{
+					Name:   "limit-count",
+					Enable: true,
+					Config: configv2.ApisixRoutePluginConfig{
+						"count":       1000,
+						"time_window": 60,
+					},
+				},
+				{
+					Name:   "prometheus",
+					Enable: true,
+				},
+				{
+					Name:   "ip-restriction",
+					Enable: false,
+				},
+			},
+		},
+	}
+	cg, err := (&translator{}).TranslateApisixConsumerGroupV2(acg)
+	assert.Nil(t, err)
+	assert.Equal(t, id.GenID("qa_gold"), cg.ID)
+	assert.Equal(t, "gold plan", cg.Desc)
+	assert.Len(t, cg.Plugins, 2)
+	assert.Equal(t, map[string]interface{}{"count": 1000, "time_window": 60}, cg.Plugins["limit-count"])
+	assert.Equal(t, map[string]interface{}{}, cg.Plugins["prometheus"])
+
+	dm := (&translator{}).GenerateApisixConsumerGroupV2DeleteMark(acg)
+	assert.Equal(t, cg.ID, dm.ID)
+}
+
+func TestTranslateApisixConsumerGroupV2WithInvalidSecretRef(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	tr := &translator{TranslatorOptions: &TranslatorOptions{
+		SecretLister: listerscorev1.NewSecretLister(indexer),
+	}}
+	acg := &configv2.ApisixConsumerGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gold",
+			Namespace: "qa",
+		},
+		Spec: configv2.ApisixConsumerGroupSpec{
+			Plugins: []configv2.ApisixRoutePlugin{
+				{
+					Name:      "kafka-logger",
+					Enable:    true,
+					SecretRef: "kafka",
+				},
+				{
+					Name:   "prometheus",
+					Enable: true,
+				},
+			},
+		},
+	}
+	// The plugin with an invalid secretRef is skipped.
+	cg, err := tr.TranslateApisixConsumerGroupV2(acg)
+	assert.Nil(t, err)
+	assert.Len(t, cg.Plugins, 1)
+	assert.Contains(t, cg.Plugins, "prometheus")
+}
+
+func TestTranslateApisixConsumerV2GroupRef(t *testing.T) {
+	ac := &configv2.ApisixConsumer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "jack",
+			Namespace: "qa",
+		},
+		Spec: configv2.ApisixConsumerSpec{
+			AuthParameter: configv2.ApisixConsumerAuthParameter{
+				KeyAuth: &configv2.ApisixConsumerKeyAuth{
+					Value: &configv2.ApisixConsumerKeyAuthValue{
+						Key: "qwerty",
+					},
+				},
+			},
+			GroupRef: "gold",
+		},
+	}
+	consumer, err := (&translator{}).TranslateApisixConsumerV2(ac)
+	assert.Nil(t, err)
+	assert.Equal(t, id.GenID(apisixv1.ComposeConsumerGroupName("qa", "gold")), consumer.GroupID)
+
+	ac.Spec.GroupRef = ""
+	consumer, err = (&translator{}).TranslateApisixConsumerV2(ac)
+	assert.Nil(t, err)
+	assert.Empty(t, consumer.GroupID)
+}
@@ -45,20 +45,19 @@ func (t *translator) translateGlobalRuleV2(config *configv2.ApisixGlobalRule) (*
 			if !plugin.Enable {
 				continue
 			}
-
-			if plugin.Config != nil {
-				// Here, it will override same key.
-				if t, ok := pluginMap[plugin.Name]; ok {
-					log.Infow("TranslateGlobalRuleV2 override same plugin key",
-						zap.String("key", plugin.Name),
-						zap.Any("old", t),
-						zap.Any("new", plugin.Config),
-					)
-				}
-				pluginMap[plugin.Name] = plugin.Config
-			} else {
-				pluginMap[plugin.Name] = make(map[string]interface{})
+			// Here, it will override same key.
+			if t, ok := pluginMap[plugin.Name]; ok {
+				log.Infow("TranslateGlobalRuleV2 override same plugin key",
+					zap.String("key", plugin.Name),
+					zap.Any("old", t),
+					zap.Any("new", plugin.Config),
+				)
+			}
+			cfg, err := t.translatePluginConfigV2(config.Namespace, &plugin)
+			if err != nil {
+				return nil, err
 			}
+			pluginMap[plugin.Name] = cfg
 		}
 	}
 	pc := apisixv1.NewDefaultGlobalRule()
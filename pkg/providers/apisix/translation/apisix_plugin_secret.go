@@ -0,0 +1,158 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	_secretValueTypeString = "string"
+	_secretValueTypeInt    = "int"
+	_secretValueTypeBool   = "bool"
+	_secretValueTypeJSON   = "json"
+)
+
+// _errInvalidSecretRef is returned if the Secret referred by the secretRef of
+// a plugin can't be found. ApisixRoute and ApisixPluginConfig skip such
+// plugins rather than failing the whole resource.
+var _errInvalidSecretRef = errors.New("invalid secretRef")
+
+// translatePluginConfigV2 returns the configuration of the plugin with the
+// referred Secret data injected. The data of SecretRef is merged as top level
+// string fields, then the values (or references) of SecretKeyRefs are set to
+// the fields on their paths. The plugin itself is left untouched, since it may be shared
+// with the informer cache.
+func (t *translator) translatePluginConfigV2(namespace string, plugin *configv2.ApisixRoutePlugin) (map[string]interface{}, error) {
+	cfg := make(map[string]interface{}, len(plugin.Config))
+	for key, value := range plugin.Config {
+		cfg[key] = value
+	}
+	if plugin.SecretRef != "" {
+		sec, err := t.SecretLister.Secrets(namespace).Get(plugin.SecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s of plugin %s: %s", _errInvalidSecretRef, plugin.SecretRef, plugin.Name, err)
+		}
+		for key, value := range sec.Data {
+			cfg[key] = string(value)
+		}
+	}
+	for _, ref := range plugin.SecretKeyRefs {
+		value, err := t.secretKeyRefValue(namespace, &ref)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secretKeyRef of plugin %s: %s", plugin.Name, err)
+		}
+		if err := setConfigField(cfg, ref.Path, value); err != nil {
+			return nil, fmt.Errorf("failed to inject secret into plugin %s: %s", plugin.Name, err)
+		}
+	}
+	if err := translateProtoRef(namespace, plugin.Name, cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// secretKeyRefValue returns the value referred by the secretKeyRef. For the
+// ApisixSecretProvider and the environment variable, the `$secret://` or
+// `$env://` reference is returned, which is resolved by APISIX.
+func (t *translator) secretKeyRefValue(namespace string, ref *configv2.ApisixRoutePluginSecretKeyRef) (interface{}, error) {
+	if ref.Env == "" && (ref.Name == "" || ref.Key == "") {
+		return nil, fmt.Errorf("name and key are required unless env is set")
+	}
+	if ref.Env != "" || ref.Provider != "" {
+		// References are only resolved as strings.
+		if ref.Type != "" && ref.Type != _secretValueTypeString {
+			return nil, fmt.Errorf("type %s is not supported by references", ref.Type)
+		}
+		if ref.Env != "" {
+			return apisixv1.ComposeEnvReference(ref.Env), nil
+		}
+		return secretProviderReference(namespace, ref.Provider, ref.Name, ref.Key), nil
+	}
+	sec, err := t.SecretLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %s", ref.Name, err)
+	}
+	data, ok := sec.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in secret %s", ref.Key, ref.Name)
+	}
+	value, err := convertSecretValue(data, ref.Type)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value of key %s in secret %s: %s", ref.Key, ref.Name, err)
+	}
+	return value, nil
+}
+
+// convertSecretValue converts the Secret data to the given type.
+func convertSecretValue(data []byte, typ string) (interface{}, error) {
+	switch typ {
+	case "", _secretValueTypeString:
+		return string(data), nil
+	case _secretValueTypeInt:
+		return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	case _secretValueTypeBool:
+		return strconv.ParseBool(strings.TrimSpace(string(data)))
+	case _secretValueTypeJSON:
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+// setConfigField sets the field on the dot separated path to value. Objects
+// on the path are copied before being modified, and the missing ones are
+// created.
+func setConfigField(cfg map[string]interface{}, path string, value interface{}) error {
+	if path == "" {
+		return fmt.Errorf("empty path")
+	}
+	fields := strings.Split(path, ".")
+	obj := cfg
+	for i, field := range fields[:len(fields)-1] {
+		if field == "" {
+			return fmt.Errorf("invalid path %s", path)
+		}
+		child := make(map[string]interface{})
+		if v, ok := obj[field]; ok && v != nil {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("field %s on path %s is not an object", strings.Join(fields[:i+1], "."), path)
+			}
+			for key, value := range m {
+				child[key] = value
+			}
+		}
+		obj[field] = child
+		obj = child
+	}
+	last := fields[len(fields)-1]
+	if last == "" {
+		return fmt.Errorf("invalid path %s", path)
+	}
+	obj[last] = value
+	return nil
+}
@@ -0,0 +1,183 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestConvertSecretValue(t *testing.T) {
+	v, err := convertSecretValue([]byte("abc"), "")
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", v)
+
+	v, err = convertSecretValue([]byte("9092\n"), "int")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9092), v)
+
+	v, err = convertSecretValue([]byte("true"), "bool")
+	assert.Nil(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = convertSecretValue([]byte(`{"brokers":["127.0.0.1:9092"]}`), "json")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"brokers": []interface{}{"127.0.0.1:9092"}}, v)
+
+	_, err = convertSecretValue([]byte("abc"), "int")
+	assert.NotNil(t, err)
+	_, err = convertSecretValue([]byte("abc"), "float")
+	assert.NotNil(t, err)
+}
+
+func TestSetConfigField(t *testing.T) {
+	sasl := map[string]interface{}{"mechanism": "PLAIN"}
+	cfg := map[string]interface{}{"sasl_config": sasl}
+
+	assert.Nil(t, setConfigField(cfg, "sasl_config.password", "admin"))
+	assert.Nil(t, setConfigField(cfg, "auth.basic.user", "jack"))
+	assert.Equal(t, map[string]interface{}{
+		"sasl_config": map[string]interface{}{
+			"mechanism": "PLAIN",
+			"password":  "admin",
+		},
+		"auth": map[string]interface{}{
+			"basic": map[string]interface{}{
+				"user": "jack",
+			},
+		},
+	}, cfg)
+	// The original object should not be modified.
+	assert.Equal(t, map[string]interface{}{"mechanism": "PLAIN"}, sasl)
+
+	assert.NotNil(t, setConfigField(cfg, "", "v"))
+	assert.NotNil(t, setConfigField(cfg, "auth..user", "v"))
+	assert.NotNil(t, setConfigField(cfg, "sasl_config.mechanism.name", "v"))
+}
+
+func TestTranslatePluginConfigV2WithSecret(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	err := indexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kafka",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("admin-secret"),
+			"port":     []byte("9092"),
+		},
+	})
+	assert.Nil(t, err)
+	tr := &translator{TranslatorOptions: &TranslatorOptions{
+		SecretLister: listerscorev1.NewSecretLister(indexer),
+	}}
+
+	plugin := &configv2.ApisixRoutePlugin{
+		Name:   "kafka-logger",
+		Enable: true,
+		Config: configv2.ApisixRoutePluginConfig{
+			"topic": "access-log",
+		},
+		SecretKeyRefs: []configv2.ApisixRoutePluginSecretKeyRef{
+			{Name: "kafka", Key: "password", Path: "sasl_config.password"},
+			{Name: "kafka", Key: "username", Path: "sasl_config.user"},
+			{Name: "kafka", Key: "port", Path: "brokers.port", Type: "int"},
+		},
+	}
+	cfg, err := tr.translatePluginConfigV2("default", plugin)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"topic": "access-log",
+		"sasl_config": map[string]interface{}{
+			"user":     "admin",
+			"password": "admin-secret",
+		},
+		"brokers": map[string]interface{}{
+			"port": int64(9092),
+		},
+	}, cfg)
+	assert.Len(t, plugin.Config, 1)
+
+	plugin.SecretKeyRefs = []configv2.ApisixRoutePluginSecretKeyRef{
+		{Name: "kafka", Key: "token", Path: "token"},
+	}
+	_, err = tr.translatePluginConfigV2("default", plugin)
+	assert.NotNil(t, err)
+
+	plugin.SecretKeyRefs = []configv2.ApisixRoutePluginSecretKeyRef{
+		{Name: "redis", Key: "password", Path: "password"},
+	}
+	_, err = tr.translatePluginConfigV2("default", plugin)
+	assert.NotNil(t, err)
+}
+
+func TestTranslatePluginConfigV2WithInvalidSecretRef(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	tr := &translator{TranslatorOptions: &TranslatorOptions{
+		SecretLister: listerscorev1.NewSecretLister(indexer),
+	}}
+	config := &configv2.ApisixPluginConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "echo",
+			Namespace: "default",
+		},
+		Spec: configv2.ApisixPluginConfigSpec{
+			Plugins: []configv2.ApisixRoutePlugin{
+				{
+					Name:      "kafka-logger",
+					Enable:    true,
+					SecretRef: "kafka",
+				},
+				{
+					Name:   "echo",
+					Enable: true,
+					Config: configv2.ApisixRoutePluginConfig{
+						"body": "hello",
+					},
+				},
+			},
+		},
+	}
+	_, err := tr.translatePluginConfigV2("default", &config.Spec.Plugins[0])
+	assert.ErrorIs(t, err, _errInvalidSecretRef)
+
+	// The plugin with an invalid secretRef is skipped.
+	ctx, err := tr.TranslatePluginConfigV2(config)
+	assert.Nil(t, err)
+	assert.Len(t, ctx.PluginConfigs, 1)
+	assert.Equal(t, apisixv1.Plugins{
+		"echo": map[string]interface{}{"body": "hello"},
+	}, ctx.PluginConfigs[0].Plugins)
+
+	// SecretKeyRefs still fail the resource.
+	config.Spec.Plugins[0] = configv2.ApisixRoutePlugin{
+		Name:   "kafka-logger",
+		Enable: true,
+		SecretKeyRefs: []configv2.ApisixRoutePluginSecretKeyRef{
+			{Name: "kafka", Key: "password", Path: "sasl_config.password"},
+		},
+	}
+	_, err = tr.TranslatePluginConfigV2(config)
+	assert.NotNil(t, err)
+}
@@ -187,7 +187,7 @@ func TestTranslateTrafficSplitPlugin(t *testing.T) {
 	ctx := &translation.TranslateContext{
 		UpstreamMap: make(map[string]struct{}),
 	}
-	cfg, err := tr.translateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
+	cfg, err := tr.TranslateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
 	assert.Nil(t, err)
 
 	assert.Len(t, ctx.Upstreams, 2)
@@ -364,7 +364,7 @@ func TestTranslateTrafficSplitPluginWithSameUpstreams(t *testing.T) {
 	ctx := &translation.TranslateContext{
 		UpstreamMap: make(map[string]struct{}),
 	}
-	cfg, err := tr.translateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
+	cfg, err := tr.TranslateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
 	assert.Nil(t, err)
 
 	assert.Len(t, ctx.Upstreams, 1)
@@ -534,7 +534,7 @@ func TestTranslateTrafficSplitPluginBadCases(t *testing.T) {
 		APIVersion:           config.DefaultAPIVersion,
 	})}
 	ctx := &translation.TranslateContext{UpstreamMap: make(map[string]struct{})}
-	cfg, err := tr.translateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
+	cfg, err := tr.TranslateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
 	assert.Nil(t, cfg)
 	assert.Len(t, ctx.Upstreams, 0)
 	assert.NotNil(t, err)
@@ -543,7 +543,7 @@ func TestTranslateTrafficSplitPluginBadCases(t *testing.T) {
 	backends[0].ServiceName = "svc-1"
 	backends[1].ServicePort.StrVal = "port-not-found"
 	ctx = &translation.TranslateContext{UpstreamMap: make(map[string]struct{})}
-	cfg, err = tr.translateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
+	cfg, err = tr.TranslateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
 	assert.Nil(t, cfg)
 	assert.NotNil(t, err)
 	assert.Equal(t, "service.spec.ports: port not defined", err.Error())
@@ -551,7 +551,7 @@ func TestTranslateTrafficSplitPluginBadCases(t *testing.T) {
 	backends[1].ServicePort.StrVal = "port2"
 	backends[1].ResolveGranularity = "service"
 	ctx = &translation.TranslateContext{UpstreamMap: make(map[string]struct{})}
-	cfg, err = tr.translateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
+	cfg, err = tr.TranslateTrafficSplitPlugin(ctx, ar1.Namespace, 30, backends)
 	assert.Nil(t, cfg)
 	assert.NotNil(t, err)
 	assert.Equal(t, "conflict headless service and backend resolve granularity", err.Error())
@@ -15,6 +15,8 @@
 package translation
 
 import (
+	"errors"
+
 	"go.uber.org/zap"
 
 	"github.com/apache/apisix-ingress-controller/pkg/id"
@@ -73,34 +75,26 @@ func (t *translator) TranslatePluginConfigV2(config *configv2.ApisixPluginConfig
 			if !plugin.Enable {
 				continue
 			}
-			if plugin.Config != nil {
-				// Here, it will override same key.
-				if t, ok := pluginMap[plugin.Name]; ok {
-					log.Infow("TranslatePluginConfigV2 override same plugin key",
-						zap.String("key", plugin.Name),
-						zap.Any("old", t),
-						zap.Any("new", plugin.Config),
-					)
-				}
-				if plugin.SecretRef != "" {
-					sec, err := t.SecretLister.Secrets(config.Namespace).Get(plugin.SecretRef)
-					if err != nil {
-						log.Errorw("The config secretRef is invalid",
-							zap.Any("plugin", plugin.Name),
-							zap.String("secretRef", plugin.SecretRef))
-						break
-					}
-					log.Debugw("Add new items, then override items with the same plugin key",
-						zap.Any("plugin", plugin.Name),
-						zap.String("secretRef", plugin.SecretRef))
-					for key, value := range sec.Data {
-						plugin.Config[key] = string(value)
-					}
-				}
-				pluginMap[plugin.Name] = plugin.Config
-			} else {
-				pluginMap[plugin.Name] = make(map[string]interface{})
+			// Here, it will override same key.
+			if t, ok := pluginMap[plugin.Name]; ok {
+				log.Infow("TranslatePluginConfigV2 override same plugin key",
+					zap.String("key", plugin.Name),
+					zap.Any("old", t),
+					zap.Any("new", plugin.Config),
+				)
+			}
+			cfg, err := t.translatePluginConfigV2(config.Namespace, &plugin)
+			if errors.Is(err, _errInvalidSecretRef) {
+				log.Errorw("The config secretRef is invalid",
+					zap.String("plugin", plugin.Name),
+					zap.Error(err),
+				)
+				continue
+			}
+			if err != nil {
+				return nil, err
 			}
+			pluginMap[plugin.Name] = cfg
 		}
 	}
 	pc := apisixv1.NewDefaultPluginConfig()
@@ -0,0 +1,89 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	_grpcTranscodePlugin = "grpc-transcode"
+	// _protoRefField is the field of the grpc-transcode plugin config which
+	// refers to an ApisixProto by name, it's replaced by the proto_id.
+	_protoRefField = "proto_ref"
+	_protoIDField  = "proto_id"
+)
+
+func (t *translator) TranslateApisixProtoV2(ap *configv2.ApisixProto) (*apisixv1.Proto, error) {
+	content := ap.Spec.Content
+	if ref := ap.Spec.ConfigMapRef; ref != nil {
+		if content != "" {
+			return nil, errors.New("content and configMapRef are exclusive")
+		}
+		cm, err := t.ConfigMapLister.ConfigMaps(ap.Namespace).Get(ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ConfigMap %s: %s", ref.Name, err)
+		}
+		data, ok := cm.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %s not found in ConfigMap %s", ref.Key, ref.Name)
+		}
+		content = data
+	}
+	if content == "" {
+		return nil, errors.New("empty proto content")
+	}
+
+	proto := apisixv1.NewDefaultProto()
+	proto.ID = id.GenID(apisixv1.ComposeProtoName(ap.Namespace, ap.Name))
+	if ap.Spec.Desc != "" {
+		proto.Desc = ap.Spec.Desc
+	}
+	proto.Content = content
+	return proto, nil
+}
+
+func (t *translator) GenerateApisixProtoV2DeleteMark(ap *configv2.ApisixProto) *apisixv1.Proto {
+	proto := apisixv1.NewDefaultProto()
+	proto.ID = id.GenID(apisixv1.ComposeProtoName(ap.Namespace, ap.Name))
+	return proto
+}
+
+// translateProtoRef replaces the proto_ref of the grpc-transcode plugin
+// config with the ID of the referred ApisixProto in the same namespace.
+func translateProtoRef(namespace, plugin string, cfg map[string]interface{}) error {
+	if plugin != _grpcTranscodePlugin {
+		return nil
+	}
+	ref, ok := cfg[_protoRefField]
+	if !ok {
+		return nil
+	}
+	name, ok := ref.(string)
+	if !ok || name == "" {
+		return fmt.Errorf("invalid %s of plugin %s", _protoRefField, plugin)
+	}
+	if _, ok := cfg[_protoIDField]; ok {
+		return fmt.Errorf("%s and %s of plugin %s are exclusive", _protoRefField, _protoIDField, plugin)
+	}
+	delete(cfg, _protoRefField)
+	cfg[_protoIDField] = id.GenID(apisixv1.ComposeProtoName(namespace, name))
+	return nil
+}
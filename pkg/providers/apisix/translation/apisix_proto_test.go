@@ -0,0 +1,136 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+)
+
+const _helloProto = `syntax = "proto3";
+package helloworld;
+service Greeter {
+  rpc SayHello (HelloRequest) returns (HelloReply) {}
+}
+message HelloRequest {
+  string name = 1;
+}
+message HelloReply {
+  string message = 1;
+}`
+
+func TestTranslateApisixProtoV2(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	err := indexer.Add(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "protos",
+			Namespace: "default",
+		},
+		Data: map[string]string{
+			"hello.proto": _helloProto,
+		},
+	})
+	assert.Nil(t, err)
+	tr := &translator{TranslatorOptions: &TranslatorOptions{
+		ConfigMapLister: listerscorev1.NewConfigMapLister(indexer),
+	}}
+
+	ap := &configv2.ApisixProto{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hello",
+			Namespace: "default",
+		},
+		Spec: configv2.ApisixProtoSpec{
+			Content: _helloProto,
+		},
+	}
+	proto, err := tr.TranslateApisixProtoV2(ap)
+	assert.Nil(t, err)
+	assert.Equal(t, id.GenID("default_hello"), proto.ID)
+	assert.Equal(t, _helloProto, proto.Content)
+	assert.Equal(t, "apisix-ingress-controller", proto.Labels["managed-by"])
+
+	ap.Spec.Content = ""
+	ap.Spec.ConfigMapRef = &configv2.ApisixProtoConfigMapRef{
+		Name: "protos",
+		Key:  "hello.proto",
+	}
+	proto, err = tr.TranslateApisixProtoV2(ap)
+	assert.Nil(t, err)
+	assert.Equal(t, _helloProto, proto.Content)
+
+	ap.Spec.ConfigMapRef.Key = "world.proto"
+	_, err = tr.TranslateApisixProtoV2(ap)
+	assert.Equal(t, "key world.proto not found in ConfigMap protos", err.Error())
+
+	ap.Spec.ConfigMapRef.Name = "unknown"
+	_, err = tr.TranslateApisixProtoV2(ap)
+	assert.NotNil(t, err)
+
+	ap.Spec.Content = _helloProto
+	_, err = tr.TranslateApisixProtoV2(ap)
+	assert.Equal(t, "content and configMapRef are exclusive", err.Error())
+
+	ap.Spec.Content = ""
+	ap.Spec.ConfigMapRef = nil
+	_, err = tr.TranslateApisixProtoV2(ap)
+	assert.Equal(t, "empty proto content", err.Error())
+
+	proto = tr.GenerateApisixProtoV2DeleteMark(ap)
+	assert.Equal(t, id.GenID("default_hello"), proto.ID)
+}
+
+func TestTranslatePluginConfigProtoRef(t *testing.T) {
+	tr := &translator{TranslatorOptions: &TranslatorOptions{}}
+	plugin := &configv2.ApisixRoutePlugin{
+		Name:   "grpc-transcode",
+		Enable: true,
+		Config: configv2.ApisixRoutePluginConfig{
+			"proto_ref": "hello",
+			"service":   "helloworld.Greeter",
+			"method":    "SayHello",
+		},
+	}
+	cfg, err := tr.translatePluginConfigV2("default", plugin)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"proto_id": id.GenID("default_hello"),
+		"service":  "helloworld.Greeter",
+		"method":   "SayHello",
+	}, cfg)
+	assert.Equal(t, "hello", plugin.Config["proto_ref"])
+
+	plugin.Config["proto_id"] = "1"
+	_, err = tr.translatePluginConfigV2("default", plugin)
+	assert.NotNil(t, err)
+
+	plugin.Config = configv2.ApisixRoutePluginConfig{"proto_ref": 1}
+	_, err = tr.translatePluginConfigV2("default", plugin)
+	assert.NotNil(t, err)
+
+	// proto_ref is only recognized by the grpc-transcode plugin.
+	plugin.Name = "echo"
+	cfg, err = tr.translatePluginConfigV2("default", plugin)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, cfg["proto_ref"])
+}
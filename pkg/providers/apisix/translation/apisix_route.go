@@ -197,7 +197,7 @@ func (t *translator) translateHTTPRouteV2beta3(ctx *translation.TranslateContext
 			if backend.Weight != nil {
 				weight = *backend.Weight
 			}
-			plugin, err := t.translateTrafficSplitPlugin(ctx, ar.Namespace, weight, backends)
+			plugin, err := t.TranslateTrafficSplitPlugin(ctx, ar.Namespace, weight, backends)
 			if err != nil {
 				log.Errorw("failed to translate traffic-split plugin",
 					zap.Error(err),
@@ -250,26 +250,22 @@ func (t *translator) translateHTTPRouteV2(ctx *translation.TranslateContext, ar
 			if !plugin.Enable {
 				continue
 			}
-			if plugin.Config != nil {
-				if plugin.SecretRef != "" {
-					sec, err := t.SecretLister.Secrets(ar.Namespace).Get(plugin.SecretRef)
-					if err != nil {
-						log.Errorw("The config secretRef is invalid",
-							zap.Any("plugin", plugin.Name),
-							zap.String("secretRef", plugin.SecretRef))
-						break
-					}
-					log.Debugw("Add new items, then override items with the same plugin key",
-						zap.Any("plugin", plugin.Name),
-						zap.String("secretRef", plugin.SecretRef))
-					for key, value := range sec.Data {
-						plugin.Config[key] = string(value)
-					}
-				}
-				pluginMap[plugin.Name] = plugin.Config
-			} else {
-				pluginMap[plugin.Name] = make(map[string]interface{})
+			cfg, err := t.translatePluginConfigV2(ar.Namespace, &plugin)
+			if errors.Is(err, _errInvalidSecretRef) {
+				log.Errorw("The config secretRef is invalid",
+					zap.String("plugin", plugin.Name),
+					zap.Error(err),
+				)
+				continue
+			}
+			if err != nil {
+				log.Errorw("failed to translate plugin config",
+					zap.String("plugin", plugin.Name),
+					zap.Error(err),
+				)
+				return err
 			}
+			pluginMap[plugin.Name] = cfg
 		}
 
 		// add Authentication plugins
@@ -332,6 +328,9 @@ func (t *translator) translateHTTPRouteV2(ctx *translation.TranslateContext, ar
 		if part.PluginConfigName != "" {
 			route.PluginConfigId = id.GenID(apisixv1.ComposePluginConfigName(ar.Namespace, part.PluginConfigName))
 		}
+		if part.ApisixServiceName != "" {
+			route.ServiceId = id.GenID(apisixv1.ComposeServiceName(ar.Namespace, part.ApisixServiceName))
+		}
 
 		for k, v := range ar.ObjectMeta.Labels {
 			route.Metadata.Labels[k] = v
@@ -365,7 +364,7 @@ func (t *translator) translateHTTPRouteV2(ctx *translation.TranslateContext, ar
 				if backend.Weight != nil {
 					weight = *backend.Weight
 				}
-				plugin, err := t.translateTrafficSplitPlugin(ctx, ar.Namespace, weight, backends)
+				plugin, err := t.TranslateTrafficSplitPlugin(ctx, ar.Namespace, weight, backends)
 				if err != nil {
 					log.Errorw("failed to translate traffic-split plugin",
 						zap.Error(err),
@@ -392,7 +391,7 @@ func (t *translator) translateHTTPRouteV2(ctx *translation.TranslateContext, ar
 		// --- translate Upstreams ---
 		var ups []*apisixv1.Upstream
 		for i, au := range part.Upstreams {
-			up, err := t.translateExternalApisixUpstream(ar.Namespace, au.Name)
+			up, err := t.TranslateExternalApisixUpstream(ar.Namespace, au.Name)
 			if err != nil {
 				log.Errorw(fmt.Sprintf("failed to translate ApisixUpstream at Upstream[%v]", i),
 					zap.Error(err),
@@ -685,6 +684,9 @@ func (t *translator) generateHTTPRouteV2DeleteMark(ctx *translation.TranslateCon
 		if part.PluginConfigName != "" {
 			route.PluginConfigId = id.GenID(apisixv1.ComposePluginConfigName(ar.Namespace, part.PluginConfigName))
 		}
+		if part.ApisixServiceName != "" {
+			route.ServiceId = id.GenID(apisixv1.ComposeServiceName(ar.Namespace, part.ApisixServiceName))
+		}
 
 		ctx.AddRoute(route)
 
@@ -778,26 +780,22 @@ func (t *translator) translateStreamRouteV2(ctx *translation.TranslateContext, a
 			if !plugin.Enable {
 				continue
 			}
-			if plugin.Config != nil {
-				if plugin.SecretRef != "" {
-					sec, err := t.SecretLister.Secrets(ar.Namespace).Get(plugin.SecretRef)
-					if err != nil {
-						log.Errorw("The config secretRef is invalid",
-							zap.Any("plugin", plugin.Name),
-							zap.String("secretRef", plugin.SecretRef))
-						break
-					}
-					log.Debugw("Add new items, then override items with the same plugin key",
-						zap.Any("plugin", plugin.Name),
-						zap.String("secretRef", plugin.SecretRef))
-					for key, value := range sec.Data {
-						plugin.Config[key] = string(value)
-					}
-				}
-				pluginMap[plugin.Name] = plugin.Config
-			} else {
-				pluginMap[plugin.Name] = make(map[string]interface{})
+			cfg, err := t.translatePluginConfigV2(ar.Namespace, &plugin)
+			if errors.Is(err, _errInvalidSecretRef) {
+				log.Errorw("The config secretRef is invalid",
+					zap.String("plugin", plugin.Name),
+					zap.Error(err),
+				)
+				continue
+			}
+			if err != nil {
+				log.Errorw("failed to translate plugin config",
+					zap.String("plugin", plugin.Name),
+					zap.Error(err),
+				)
+				return err
 			}
+			pluginMap[plugin.Name] = cfg
 		}
 
 		sr := apisixv1.NewDefaultStreamRoute()
@@ -0,0 +1,127 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+var (
+	_errVaultNotSpecified          = errors.New("vault not specified")
+	_errSecretProviderNotSupported = errors.New("secret provider is not supported by the auth plugin")
+)
+
+func (t *translator) TranslateApisixSecretProviderV2(asp *configv2.ApisixSecretProvider) (*apisixv1.Secret, error) {
+	vault := asp.Spec.Vault
+	if vault == nil {
+		return nil, _errVaultNotSpecified
+	}
+	secret := apisixv1.NewDefaultSecret(apisixv1.SecretManagerVault,
+		id.GenID(apisixv1.ComposeSecretProviderName(asp.Namespace, asp.Name)))
+	secret.URI = vault.URI
+	secret.Prefix = vault.Prefix
+	secret.Token = vault.Token
+	if vault.TokenSecretRef != nil {
+		sec, err := t.SecretLister.Secrets(asp.Namespace).Get(vault.TokenSecretRef.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get token secret %s: %s", vault.TokenSecretRef.Name, err)
+		}
+		raw, ok := sec.Data[vault.TokenSecretRef.Key]
+		if !ok || len(raw) == 0 {
+			return nil, fmt.Errorf("key %s not found in token secret %s", vault.TokenSecretRef.Key, vault.TokenSecretRef.Name)
+		}
+		secret.Token = string(raw)
+	}
+	return secret, nil
+}
+
+func (t *translator) GenerateApisixSecretProviderV2DeleteMark(asp *configv2.ApisixSecretProvider) *apisixv1.Secret {
+	return apisixv1.NewDefaultSecret(apisixv1.SecretManagerVault,
+		id.GenID(apisixv1.ComposeSecretProviderName(asp.Namespace, asp.Name)))
+}
+
+// secretProviderReference returns the `$secret://` reference to the key of
+// the secret stored in the secret manager of the ApisixSecretProvider.
+func secretProviderReference(namespace, provider, name, key string) string {
+	secretID := apisixv1.SecretManagerVault + "/" + id.GenID(apisixv1.ComposeSecretProviderName(namespace, provider))
+	return apisixv1.ComposeSecretReference(secretID, name, key)
+}
+
+// translateConsumerAuthReferencesV2 translates the authParameter, which refers
+// to a Secret, into the plugin config with the credentials referred by the
+// `$secret://` references. The secret with the same name and keys is expected
+// to be stored in the secret manager of the ApisixSecretProvider.
+func translateConsumerAuthReferencesV2(namespace, provider string, auth *configv2.ApisixConsumerAuthParameter) (string, interface{}, error) {
+	ref := func(name, key string) string {
+		return secretProviderReference(namespace, provider, name, key)
+	}
+	if auth.KeyAuth != nil && auth.KeyAuth.SecretRef != nil {
+		name := auth.KeyAuth.SecretRef.Name
+		return "key-auth", &apisixv1.KeyAuthConsumerConfig{
+			Key: ref(name, "key"),
+		}, nil
+	}
+	if auth.BasicAuth != nil && auth.BasicAuth.SecretRef != nil {
+		name := auth.BasicAuth.SecretRef.Name
+		return "basic-auth", &apisixv1.BasicAuthConsumerConfig{
+			Username: ref(name, "username"),
+			Password: ref(name, "password"),
+		}, nil
+	}
+	if auth.JwtAuth != nil && auth.JwtAuth.SecretRef != nil {
+		name := auth.JwtAuth.SecretRef.Name
+		return "jwt-auth", &apisixv1.JwtAuthConsumerConfig{
+			Key:    ref(name, "key"),
+			Secret: ref(name, "secret"),
+			Exp:    _jwtAuthExpDefaultValue,
+		}, nil
+	}
+	if auth.HMACAuth != nil && auth.HMACAuth.SecretRef != nil {
+		name := auth.HMACAuth.SecretRef.Name
+		return "hmac-auth", &apisixv1.HMACAuthConsumerConfig{
+			AccessKey:           ref(name, "access_key"),
+			SecretKey:           ref(name, "secret_key"),
+			Algorithm:           _hmacAuthAlgorithmDefaultValue,
+			ClockSkew:           _hmacAuthClockSkewDefaultValue,
+			KeepHeaders:         _hmacAuthKeepHeadersDefaultValue,
+			EncodeURIParams:     _hmacAuthEncodeURIParamsDefaultValue,
+			ValidateRequestBody: _hmacAuthValidateRequestBodyDefaultValue,
+			MaxReqBody:          _hmacAuthMaxReqBodyDefaultValue,
+		}, nil
+	}
+	if auth.LDAPAuth != nil && auth.LDAPAuth.SecretRef != nil {
+		name := auth.LDAPAuth.SecretRef.Name
+		return "ldap-auth", &apisixv1.LDAPAuthConsumerConfig{
+			UserDN: ref(name, "user_dn"),
+		}, nil
+	}
+	return "", nil, _errSecretProviderNotSupported
+}
+
+// hasConsumerAuthSecretRefV2 reports whether the authParameter refers to a
+// Secret.
+func hasConsumerAuthSecretRefV2(auth *configv2.ApisixConsumerAuthParameter) bool {
+	return (auth.KeyAuth != nil && auth.KeyAuth.SecretRef != nil) ||
+		(auth.BasicAuth != nil && auth.BasicAuth.SecretRef != nil) ||
+		(auth.JwtAuth != nil && auth.JwtAuth.SecretRef != nil) ||
+		(auth.HMACAuth != nil && auth.HMACAuth.SecretRef != nil) ||
+		(auth.LDAPAuth != nil && auth.LDAPAuth.SecretRef != nil) ||
+		(auth.WolfRBAC != nil && auth.WolfRBAC.SecretRef != nil)
+}
@@ -0,0 +1,155 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestTranslateApisixSecretProviderV2(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	err := indexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault-token",
+			Namespace: "qa",
+		},
+		Data: map[string][]byte{
+			"token": []byte("s.abcdef"),
+		},
+	})
+	assert.Nil(t, err)
+	tr := &translator{TranslatorOptions: &TranslatorOptions{
+		SecretLister: listerscorev1.NewSecretLister(indexer),
+	}}
+
+	asp := &configv2.ApisixSecretProvider{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault",
+			Namespace: "qa",
+		},
+		Spec: configv2.ApisixSecretProviderSpec{
+			Vault: &configv2.ApisixSecretProviderVault{
+				URI:    "http://vault.qa.svc:8200",
+				Prefix: "kv/apisix",
+				Token:  "$env://VAULT_TOKEN",
+			},
+		},
+	}
+	secret, err := tr.TranslateApisixSecretProviderV2(asp)
+	assert.Nil(t, err)
+	assert.Equal(t, "vault/"+id.GenID("qa_vault"), secret.ID)
+	assert.Equal(t, "http://vault.qa.svc:8200", secret.URI)
+	assert.Equal(t, "kv/apisix", secret.Prefix)
+	assert.Equal(t, "$env://VAULT_TOKEN", secret.Token)
+
+	asp.Spec.Vault.TokenSecretRef = &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "vault-token"},
+		Key:                  "token",
+	}
+	secret, err = tr.TranslateApisixSecretProviderV2(asp)
+	assert.Nil(t, err)
+	assert.Equal(t, "s.abcdef", secret.Token)
+
+	asp.Spec.Vault.TokenSecretRef.Key = "root-token"
+	_, err = tr.TranslateApisixSecretProviderV2(asp)
+	assert.NotNil(t, err)
+
+	asp.Spec.Vault = nil
+	_, err = tr.TranslateApisixSecretProviderV2(asp)
+	assert.Equal(t, _errVaultNotSpecified, err)
+
+	dm := tr.GenerateApisixSecretProviderV2DeleteMark(asp)
+	assert.Equal(t, "vault/"+id.GenID("qa_vault"), dm.ID)
+}
+
+func TestTranslateApisixConsumerV2SecretProvider(t *testing.T) {
+	ac := &configv2.ApisixConsumer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "jack",
+			Namespace: "qa",
+		},
+		Spec: configv2.ApisixConsumerSpec{
+			AuthParameter: configv2.ApisixConsumerAuthParameter{
+				BasicAuth: &configv2.ApisixConsumerBasicAuth{
+					SecretRef: &corev1.LocalObjectReference{Name: "jack-basic-auth"},
+				},
+			},
+			SecretProvider: "vault",
+		},
+	}
+	consumer, err := (&translator{}).TranslateApisixConsumerV2(ac)
+	assert.Nil(t, err)
+	prefix := "$secret://vault/" + id.GenID("qa_vault") + "/jack-basic-auth/"
+	assert.Equal(t, &apisixv1.BasicAuthConsumerConfig{
+		Username: prefix + "username",
+		Password: prefix + "password",
+	}, consumer.Plugins["basic-auth"])
+
+	// The inlined credentials are kept.
+	ac.Spec.AuthParameter.BasicAuth = &configv2.ApisixConsumerBasicAuth{
+		Value: &configv2.ApisixConsumerBasicAuthValue{
+			Username: "jack",
+			Password: "jacknice",
+		},
+	}
+	consumer, err = (&translator{}).TranslateApisixConsumerV2(ac)
+	assert.Nil(t, err)
+	assert.Equal(t, "jack", consumer.Plugins["basic-auth"].(*apisixv1.BasicAuthConsumerConfig).Username)
+
+	ac.Spec.AuthParameter = configv2.ApisixConsumerAuthParameter{
+		WolfRBAC: &configv2.ApisixConsumerWolfRBAC{
+			SecretRef: &corev1.LocalObjectReference{Name: "jack-wolf-rbac"},
+		},
+	}
+	_, err = (&translator{}).TranslateApisixConsumerV2(ac)
+	assert.NotNil(t, err)
+}
+
+func TestTranslatePluginConfigV2WithReferences(t *testing.T) {
+	plugin := &configv2.ApisixRoutePlugin{
+		Name:   "openid-connect",
+		Enable: true,
+		Config: configv2.ApisixRoutePluginConfig{
+			"client_id": "apisix",
+		},
+		SecretKeyRefs: []configv2.ApisixRoutePluginSecretKeyRef{
+			{Name: "oidc", Key: "client_secret", Path: "client_secret", Provider: "vault"},
+			{Path: "session.secret", Env: "OIDC_SESSION_SECRET"},
+		},
+	}
+	cfg, err := (&translator{}).translatePluginConfigV2("qa", plugin)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"client_id":     "apisix",
+		"client_secret": "$secret://vault/" + id.GenID("qa_vault") + "/oidc/client_secret",
+		"session": map[string]interface{}{
+			"secret": "$env://OIDC_SESSION_SECRET",
+		},
+	}, cfg)
+
+	plugin.SecretKeyRefs[1].Type = "int"
+	_, err = (&translator{}).translatePluginConfigV2("qa", plugin)
+	assert.NotNil(t, err)
+}
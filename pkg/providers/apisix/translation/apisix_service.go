@@ -0,0 +1,103 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func (t *translator) TranslateApisixServiceV2(as *configv2.ApisixService) (*translation.TranslateContext, error) {
+	ctx := translation.DefaultEmptyTranslateContext()
+	pluginMap := make(apisixv1.Plugins)
+	for _, plugin := range as.Spec.Plugins {
+		if !plugin.Enable {
+			continue
+		}
+		// Here, it will override same key.
+		if t, ok := pluginMap[plugin.Name]; ok {
+			log.Infow("TranslateApisixServiceV2 override same plugin key",
+				zap.String("key", plugin.Name),
+				zap.Any("old", t),
+				zap.Any("new", plugin.Config),
+			)
+		}
+		cfg, err := t.translatePluginConfigV2(as.Namespace, &plugin)
+		if errors.Is(err, _errInvalidSecretRef) {
+			log.Errorw("The config secretRef is invalid",
+				zap.String("plugin", plugin.Name),
+				zap.Error(err),
+			)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		pluginMap[plugin.Name] = cfg
+	}
+
+	backend := as.Spec.Backend
+	svcClusterIP, svcPort, err := t.GetServiceClusterIPAndPort(&backend, as.Namespace)
+	if err != nil {
+		log.Errorw("failed to get service port in backend",
+			zap.Any("backend", backend),
+			zap.Any("apisix_service", as),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	ups, err := t.translateService(as.Namespace, backend.ServiceName, backend.Subset, backend.ResolveGranularity, svcClusterIP, svcPort)
+	if err != nil {
+		return nil, err
+	}
+
+	svc := apisixv1.NewDefaultService()
+	svc.Name = apisixv1.ComposeServiceName(as.Namespace, as.Name)
+	svc.ID = id.GenID(svc.Name)
+	if as.Spec.Desc != "" {
+		svc.Desc = as.Spec.Desc
+	}
+	svc.Hosts = as.Spec.Hosts
+	svc.EnableWebsocket = as.Spec.Websocket
+	svc.UpstreamId = ups.ID
+	svc.Plugins = pluginMap
+
+	ctx.AddUpstream(ups)
+	ctx.AddService(svc)
+	return ctx, nil
+}
+
+func (t *translator) GenerateApisixServiceV2DeleteMark(as *configv2.ApisixService) (*translation.TranslateContext, error) {
+	ctx := translation.DefaultEmptyTranslateContext()
+	svc := apisixv1.NewDefaultService()
+	svc.Name = apisixv1.ComposeServiceName(as.Namespace, as.Name)
+	svc.ID = id.GenID(svc.Name)
+	ctx.AddService(svc)
+
+	backend := as.Spec.Backend
+	ups, err := t.generateUpstreamDeleteMark(as.Namespace, backend.ServiceName, backend.Subset, backend.ServicePort.IntVal, backend.ResolveGranularity)
+	if err != nil {
+		return nil, err
+	}
+	ctx.AddUpstream(ups)
+	return ctx, nil
+}
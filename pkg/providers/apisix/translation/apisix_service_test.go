@@ -0,0 +1,158 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestTranslateApisixServiceV2(t *testing.T) {
+	tr, processCh := mockTranslatorV2(t)
+	<-processCh
+	<-processCh
+
+	as := &configv2.ApisixService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "as",
+			Namespace: "test",
+		},
+		Spec: configv2.ApisixServiceSpec{
+			Hosts: []string{"httpbin.org"},
+			Backend: configv2.ApisixRouteHTTPBackend{
+				ServiceName: "svc",
+				ServicePort: intstr.FromInt(80),
+			},
+			Websocket: true,
+			Plugins: []configv2.ApisixRoutePlugin{
+				{
+					Name:   "cors",
+					Enable: true,
+					Config: configv2.ApisixRoutePluginConfig{"allow_origins": "*"},
+				},
+				{
+					Name:   "echo",
+					Enable: false,
+				},
+			},
+		},
+	}
+
+	tctx, err := tr.TranslateApisixServiceV2(as)
+	assert.Nil(t, err)
+	assert.Len(t, tctx.Upstreams, 1)
+	assert.Equal(t, "test_svc_80", tctx.Upstreams[0].Name)
+	assert.Len(t, tctx.Upstreams[0].Nodes, 2)
+
+	assert.Len(t, tctx.Services, 1)
+	svc := tctx.Services[0]
+	assert.Equal(t, "test_as", svc.Name)
+	assert.Equal(t, id.GenID("test_as"), svc.ID)
+	assert.Equal(t, tctx.Upstreams[0].ID, svc.UpstreamId)
+	assert.Equal(t, []string{"httpbin.org"}, svc.Hosts)
+	assert.True(t, svc.EnableWebsocket)
+	assert.Len(t, svc.Plugins, 1)
+	assert.Contains(t, svc.Plugins, "cors")
+
+	tctx, err = tr.GenerateApisixServiceV2DeleteMark(as)
+	assert.Nil(t, err)
+	assert.Len(t, tctx.Services, 1)
+	assert.Equal(t, id.GenID("test_as"), tctx.Services[0].ID)
+	assert.Len(t, tctx.Upstreams, 1)
+	assert.Equal(t, id.GenID("test_svc_80"), tctx.Upstreams[0].ID)
+}
+
+func TestTranslateApisixServiceV2WithInvalidSecretRef(t *testing.T) {
+	tr, processCh := mockTranslatorV2(t)
+	<-processCh
+	<-processCh
+	tr.SecretLister = listerscorev1.NewSecretLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+
+	as := &configv2.ApisixService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "as",
+			Namespace: "test",
+		},
+		Spec: configv2.ApisixServiceSpec{
+			Backend: configv2.ApisixRouteHTTPBackend{
+				ServiceName: "svc",
+				ServicePort: intstr.FromInt(80),
+			},
+			Plugins: []configv2.ApisixRoutePlugin{
+				{
+					Name:      "kafka-logger",
+					Enable:    true,
+					SecretRef: "kafka",
+				},
+				{
+					Name:   "cors",
+					Enable: true,
+					Config: configv2.ApisixRoutePluginConfig{"allow_origins": "*"},
+				},
+			},
+		},
+	}
+
+	// The plugin with an invalid secretRef is skipped.
+	tctx, err := tr.TranslateApisixServiceV2(as)
+	assert.Nil(t, err)
+	assert.Len(t, tctx.Services, 1)
+	assert.Len(t, tctx.Services[0].Plugins, 1)
+	assert.Contains(t, tctx.Services[0].Plugins, "cors")
+}
+
+func TestTranslateApisixRouteV2WithServiceName(t *testing.T) {
+	tr, processCh := mockTranslatorV2(t)
+	<-processCh
+	<-processCh
+
+	ar := &configv2.ApisixRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ar",
+			Namespace: "test",
+		},
+		Spec: configv2.ApisixRouteSpec{
+			HTTP: []configv2.ApisixRouteHTTP{
+				{
+					Name: "rule1",
+					Match: configv2.ApisixRouteHTTPMatch{
+						Paths: []string{"/*"},
+					},
+					ApisixServiceName: "as",
+				},
+			},
+		},
+	}
+
+	tctx, err := tr.TranslateRouteV2(ar)
+	assert.Nil(t, err)
+	assert.Len(t, tctx.Routes, 1)
+	assert.Len(t, tctx.Upstreams, 0)
+	assert.Equal(t, id.GenID(apisixv1.ComposeServiceName("test", "as")), tctx.Routes[0].ServiceId)
+	assert.Equal(t, "", tctx.Routes[0].UpstreamId)
+
+	tctx, err = tr.GenerateRouteV2DeleteMark(ar)
+	assert.Nil(t, err)
+	assert.Equal(t, id.GenID(apisixv1.ComposeServiceName("test", "as")), tctx.Routes[0].ServiceId)
+}
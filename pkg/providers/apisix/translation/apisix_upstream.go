@@ -121,7 +121,7 @@ func (t *translator) TranslateApisixUpstreamExternalNodes(au *v2.ApisixUpstream)
 }
 
 // TODO: Retry when ApisixUpstream/ExternalName service not found
-func (t *translator) translateExternalApisixUpstream(namespace, upstream string) (*apisixv1.Upstream, error) {
+func (t *translator) TranslateExternalApisixUpstream(namespace, upstream string) (*apisixv1.Upstream, error) {
 	multiVersioned, err := t.ApisixUpstreamLister.V2(namespace, upstream)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
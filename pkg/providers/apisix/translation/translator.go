@@ -34,6 +34,7 @@ type TranslatorOptions struct {
 	ApisixUpstreamLister kube.ApisixUpstreamLister
 	ServiceLister        listerscorev1.ServiceLister
 	SecretLister         listerscorev1.SecretLister
+	ConfigMapLister      listerscorev1.ConfigMapLister
 }
 
 type translator struct {
@@ -75,6 +76,30 @@ type ApisixTranslator interface {
 	// TranslateApisixConsumerV2 translates the configv2beta3.APisixConsumer object into the APISIX Consumer
 	// resource.
 	TranslateApisixConsumerV2(ac *configv2.ApisixConsumer) (*apisixv1.Consumer, error)
+	// TranslateApisixConsumerGroupV2 translates the configv2.ApisixConsumerGroup object into the APISIX
+	// ConsumerGroup resource.
+	TranslateApisixConsumerGroupV2(*configv2.ApisixConsumerGroup) (*apisixv1.ConsumerGroup, error)
+	// GenerateApisixConsumerGroupV2DeleteMark translates the configv2.ApisixConsumerGroup object into the
+	// APISIX ConsumerGroup resource not strictly, only used for delete event.
+	GenerateApisixConsumerGroupV2DeleteMark(*configv2.ApisixConsumerGroup) *apisixv1.ConsumerGroup
+	// TranslateApisixSecretProviderV2 translates the configv2.ApisixSecretProvider object into the APISIX
+	// Secret resource.
+	TranslateApisixSecretProviderV2(*configv2.ApisixSecretProvider) (*apisixv1.Secret, error)
+	// GenerateApisixSecretProviderV2DeleteMark translates the configv2.ApisixSecretProvider object into the
+	// APISIX Secret resource not strictly, only used for delete event.
+	GenerateApisixSecretProviderV2DeleteMark(*configv2.ApisixSecretProvider) *apisixv1.Secret
+	// TranslateApisixServiceV2 translates the configv2.ApisixService object into the APISIX
+	// Service resource and the Upstream it refers to.
+	TranslateApisixServiceV2(*configv2.ApisixService) (*translation.TranslateContext, error)
+	// GenerateApisixServiceV2DeleteMark translates the configv2.ApisixService object into the
+	// APISIX Service and Upstream resources not strictly, only used for delete event.
+	GenerateApisixServiceV2DeleteMark(*configv2.ApisixService) (*translation.TranslateContext, error)
+	// TranslateApisixProtoV2 translates the configv2.ApisixProto object into the APISIX
+	// Proto resource.
+	TranslateApisixProtoV2(*configv2.ApisixProto) (*apisixv1.Proto, error)
+	// GenerateApisixProtoV2DeleteMark translates the configv2.ApisixProto object into the
+	// APISIX Proto resource not strictly, only used for delete event.
+	GenerateApisixProtoV2DeleteMark(*configv2.ApisixProto) *apisixv1.Proto
 	// TranslatePluginConfigV2beta3 translates the configv2.ApisixPluginConfig object into several PluginConfig
 	// resources.
 	TranslatePluginConfigV2beta3(*configv2beta3.ApisixPluginConfig) (*translation.TranslateContext, error)
@@ -89,9 +114,16 @@ type ApisixTranslator interface {
 	GeneratePluginConfigV2DeleteMark(*configv2.ApisixPluginConfig) (*translation.TranslateContext, error)
 
 	TranslateRouteMatchExprs(nginxVars []configv2.ApisixRouteHTTPMatchExpr) ([][]apisixv1.StringOrSlice, error)
+	// TranslateTrafficSplitPlugin translates the weighted backends into the traffic-split plugin,
+	// the default upstream of the route takes the defaultBackendWeight.
+	TranslateTrafficSplitPlugin(ctx *translation.TranslateContext, ns string, defaultBackendWeight int,
+		backends []configv2.ApisixRouteHTTPBackend) (*apisixv1.TrafficSplitConfig, error)
 
 	// TranslateApisixUpstreamExternalNodes translates an ApisixUpstream with external nodes to APISIX nodes.
 	TranslateApisixUpstreamExternalNodes(au *configv2.ApisixUpstream) ([]apisixv1.UpstreamNode, error)
+	// TranslateExternalApisixUpstream translates the ApisixUpstream which has external nodes or
+	// discovery configuration to APISIX Upstream.
+	TranslateExternalApisixUpstream(namespace, upstream string) (*apisixv1.Upstream, error)
 
 	TranslateGlobalRule(kube.ApisixGlobalRule) (*translation.TranslateContext, error)
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -27,6 +28,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
 	extensionsv1beta1 "k8s.io/client-go/listers/extensions/v1beta1"
 	networkingv1 "k8s.io/client-go/listers/networking/v1"
 	networkingv1beta1 "k8s.io/client-go/listers/networking/v1beta1"
@@ -34,6 +36,7 @@ import (
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/client-go/tools/record"
+	gatewayscheme "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/scheme"
 
 	"github.com/apache/apisix-ingress-controller/pkg/api"
 	"github.com/apache/apisix-ingress-controller/pkg/apisix"
@@ -90,6 +93,10 @@ type Controller struct {
 	gatewayProvider   *gateway.Provider
 	apisixProvider    apisixprovider.Provider
 	ingressProvider   ingressprovider.Provider
+
+	manifestTranslatorsLock sync.RWMutex
+	// kind of resources -> provider, it's only available on the leader.
+	manifestTranslators map[string]providertypes.ManifestTranslator
 }
 
 // NewController creates an ingress apisix controller object.
@@ -109,6 +116,14 @@ func NewController(cfg *config.Config) (*Controller, error) {
 		return nil, err
 	}
 
+	if cfg.APISIX.DefaultClusterAdminKeySecret != "" {
+		adminKey, err := getDefaultClusterAdminKey(kubeClient, cfg)
+		if err != nil {
+			return nil, err
+		}
+		cfg.APISIX.SetDefaultClusterAdminKey(adminKey)
+	}
+
 	apiSrv, err := api.NewServer(cfg)
 	if err != nil {
 		return nil, err
@@ -116,6 +131,7 @@ func NewController(cfg *config.Config) (*Controller, error) {
 
 	// recorder
 	utilruntime.Must(apisixscheme.AddToScheme(scheme.Scheme))
+	utilruntime.Must(gatewayscheme.AddToScheme(scheme.Scheme))
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.Client.CoreV1().Events("")})
 
@@ -129,9 +145,36 @@ func NewController(cfg *config.Config) (*Controller, error) {
 		kubeClient:       kubeClient,
 		recorder:         eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: _component}),
 	}
+	if cfg.EnableDiff {
+		apiSrv.MountDiff(c)
+	}
 	return c, nil
 }
 
+// getDefaultClusterAdminKey fetches the admin key of the default cluster from
+// the Secret, later changes of the Secret are handled by the Secret controller.
+func getDefaultClusterAdminKey(kubeClient *kube.KubeClient, cfg *config.Config) (string, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(cfg.APISIX.DefaultClusterAdminKeySecret)
+	if err != nil {
+		return "", err
+	}
+	secret, err := kubeClient.Client.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get admin key secret %s: %s", cfg.APISIX.DefaultClusterAdminKeySecret, err)
+	}
+	return utils.AdminKeyFromSecret(secret, cfg.APISIX.DefaultClusterAdminKeySecretKey)
+}
+
+// newStandaloneRenderer returns the renderer of APISIX standalone config,
+// the ConfigMap takes precedence over the file.
+func (c *Controller) newStandaloneRenderer() apisix.StandaloneRenderer {
+	if c.cfg.APISIX.StandaloneConfigMap != "" {
+		namespace, name, _ := cache.SplitMetaNamespaceKey(c.cfg.APISIX.StandaloneConfigMap)
+		return apisix.NewConfigMapRenderer(c.kubeClient.Client, namespace, name, c.cfg.APISIX.StandaloneConfigMapKey)
+	}
+	return apisix.NewFileRenderer(c.cfg.APISIX.StandaloneConfigFile)
+}
+
 // Eventf implements the resourcelock.EventRecorder interface.
 func (c *Controller) Eventf(_ runtime.Object, eventType string, reason string, message string, _ ...interface{}) {
 	log.Infow(reason, zap.String("message", message), zap.String("event_type", eventType))
@@ -223,7 +266,9 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 	apisixFactory := c.kubeClient.NewAPISIXSharedIndexInformerFactory()
 
 	var (
-		ingressInformer cache.SharedIndexInformer
+		ingressInformer      cache.SharedIndexInformer
+		ingressClassInformer cache.SharedIndexInformer
+		ingressClassLister   networkingv1.IngressClassLister
 
 		ingressListerV1                networkingv1.IngressLister
 		ingressListerV1beta1           networkingv1beta1.IngressLister
@@ -231,13 +276,18 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 	)
 
 	var (
-		apisixUpstreamInformer      cache.SharedIndexInformer
-		apisixRouteInformer         cache.SharedIndexInformer
-		apisixPluginConfigInformer  cache.SharedIndexInformer
-		apisixConsumerInformer      cache.SharedIndexInformer
-		apisixTlsInformer           cache.SharedIndexInformer
-		apisixClusterConfigInformer cache.SharedIndexInformer
-		ApisixGlobalRuleInformer    cache.SharedIndexInformer
+		apisixUpstreamInformer           cache.SharedIndexInformer
+		apisixRouteInformer              cache.SharedIndexInformer
+		apisixPluginConfigInformer       cache.SharedIndexInformer
+		apisixConsumerInformer           cache.SharedIndexInformer
+		apisixTlsInformer                cache.SharedIndexInformer
+		apisixClusterConfigInformer      cache.SharedIndexInformer
+		ApisixGlobalRuleInformer         cache.SharedIndexInformer
+		ApisixConsumerGroupInformer      cache.SharedIndexInformer
+		ApisixSecretProviderInformer     cache.SharedIndexInformer
+		ApisixServiceInformer            cache.SharedIndexInformer
+		ApisixProtoInformer              cache.SharedIndexInformer
+		ApisixIngressClassConfigInformer cache.SharedIndexInformer
 
 		apisixRouteListerV2beta3         v2beta3.ApisixRouteLister
 		apisixUpstreamListerV2beta3      v2beta3.ApisixUpstreamLister
@@ -246,13 +296,18 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 		apisixConsumerListerV2beta3      v2beta3.ApisixConsumerLister
 		apisixPluginConfigListerV2beta3  v2beta3.ApisixPluginConfigLister
 
-		apisixRouteListerV2         v2.ApisixRouteLister
-		apisixUpstreamListerV2      v2.ApisixUpstreamLister
-		apisixTlsListerV2           v2.ApisixTlsLister
-		apisixClusterConfigListerV2 v2.ApisixClusterConfigLister
-		apisixConsumerListerV2      v2.ApisixConsumerLister
-		apisixPluginConfigListerV2  v2.ApisixPluginConfigLister
-		ApisixGlobalRuleListerV2    v2.ApisixGlobalRuleLister
+		apisixRouteListerV2              v2.ApisixRouteLister
+		apisixUpstreamListerV2           v2.ApisixUpstreamLister
+		apisixTlsListerV2                v2.ApisixTlsLister
+		apisixClusterConfigListerV2      v2.ApisixClusterConfigLister
+		apisixConsumerListerV2           v2.ApisixConsumerLister
+		apisixPluginConfigListerV2       v2.ApisixPluginConfigLister
+		ApisixGlobalRuleListerV2         v2.ApisixGlobalRuleLister
+		ApisixConsumerGroupListerV2      v2.ApisixConsumerGroupLister
+		ApisixSecretProviderListerV2     v2.ApisixSecretProviderLister
+		ApisixServiceListerV2            v2.ApisixServiceLister
+		ApisixProtoListerV2              v2.ApisixProtoLister
+		ApisixIngressClassConfigListerV2 v2.ApisixIngressClassConfigLister
 	)
 
 	switch c.cfg.Kubernetes.APIVersion {
@@ -278,6 +333,11 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 		apisixPluginConfigInformer = apisixFactory.Apisix().V2().ApisixPluginConfigs().Informer()
 		apisixUpstreamInformer = apisixFactory.Apisix().V2().ApisixUpstreams().Informer()
 		ApisixGlobalRuleInformer = apisixFactory.Apisix().V2().ApisixGlobalRules().Informer()
+		ApisixConsumerGroupInformer = apisixFactory.Apisix().V2().ApisixConsumerGroups().Informer()
+		ApisixSecretProviderInformer = apisixFactory.Apisix().V2().ApisixSecretProviders().Informer()
+		ApisixServiceInformer = apisixFactory.Apisix().V2().ApisixServices().Informer()
+		ApisixProtoInformer = apisixFactory.Apisix().V2().ApisixProtos().Informer()
+		ApisixIngressClassConfigInformer = apisixFactory.Apisix().V2().ApisixIngressClassConfigs().Informer()
 
 		apisixRouteListerV2 = apisixFactory.Apisix().V2().ApisixRoutes().Lister()
 		apisixUpstreamListerV2 = apisixFactory.Apisix().V2().ApisixUpstreams().Lister()
@@ -286,6 +346,11 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 		apisixConsumerListerV2 = apisixFactory.Apisix().V2().ApisixConsumers().Lister()
 		apisixPluginConfigListerV2 = apisixFactory.Apisix().V2().ApisixPluginConfigs().Lister()
 		ApisixGlobalRuleListerV2 = apisixFactory.Apisix().V2().ApisixGlobalRules().Lister()
+		ApisixConsumerGroupListerV2 = apisixFactory.Apisix().V2().ApisixConsumerGroups().Lister()
+		ApisixSecretProviderListerV2 = apisixFactory.Apisix().V2().ApisixSecretProviders().Lister()
+		ApisixServiceListerV2 = apisixFactory.Apisix().V2().ApisixServices().Lister()
+		ApisixProtoListerV2 = apisixFactory.Apisix().V2().ApisixProtos().Lister()
+		ApisixIngressClassConfigListerV2 = apisixFactory.Apisix().V2().ApisixIngressClassConfigs().Lister()
 
 	default:
 		panic(fmt.Errorf("unsupported API version %v", c.cfg.Kubernetes.APIVersion))
@@ -298,6 +363,11 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 	apisixConsumerLister := kube.NewApisixConsumerLister(apisixConsumerListerV2beta3, apisixConsumerListerV2)
 	apisixPluginConfigLister := kube.NewApisixPluginConfigLister(apisixPluginConfigListerV2beta3, apisixPluginConfigListerV2)
 	ApisixGlobalRuleLister := kube.NewApisixGlobalRuleLister(c.cfg.Kubernetes.APIVersion, ApisixGlobalRuleListerV2)
+	ApisixConsumerGroupLister := kube.NewApisixConsumerGroupLister(c.cfg.Kubernetes.APIVersion, ApisixConsumerGroupListerV2)
+	ApisixSecretProviderLister := kube.NewApisixSecretProviderLister(c.cfg.Kubernetes.APIVersion, ApisixSecretProviderListerV2)
+	ApisixServiceLister := kube.NewApisixServiceLister(c.cfg.Kubernetes.APIVersion, ApisixServiceListerV2)
+	ApisixProtoLister := kube.NewApisixProtoLister(c.cfg.Kubernetes.APIVersion, ApisixProtoListerV2)
+	ApisixIngressClassConfigLister := kube.NewApisixIngressClassConfigLister(c.cfg.Kubernetes.APIVersion, ApisixIngressClassConfigListerV2)
 
 	epLister, epInformer := kube.NewEndpointListerAndInformer(kubeFactory, c.cfg.Kubernetes.WatchEndpointSlices)
 	svcInformer := kubeFactory.Core().V1().Services().Informer()
@@ -312,10 +382,24 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 	configmapInformer := kubeFactory.Core().V1().ConfigMaps().Informer()
 	configmapLister := kubeFactory.Core().V1().ConfigMaps().Lister()
 
+	var (
+		namespaceInformer cache.SharedIndexInformer
+		namespaceLister   listerscorev1.NamespaceLister
+	)
+	if c.cfg.Kubernetes.EnableGatewayAPI {
+		// Listeners of Gateways select the namespaces of routes by labels.
+		namespaceInformer = kubeFactory.Core().V1().Namespaces().Informer()
+		namespaceLister = kubeFactory.Core().V1().Namespaces().Lister()
+	}
+
 	switch c.cfg.Kubernetes.IngressVersion {
 	case config.IngressNetworkingV1:
 		ingressInformer = kubeFactory.Networking().V1().Ingresses().Informer()
 		ingressListerV1 = kubeFactory.Networking().V1().Ingresses().Lister()
+		// IngressClass in networking/v1 is available since Kubernetes v1.19.0,
+		// as well as Ingress in networking/v1.
+		ingressClassInformer = kubeFactory.Networking().V1().IngressClasses().Informer()
+		ingressClassLister = kubeFactory.Networking().V1().IngressClasses().Lister()
 	case config.IngressNetworkingV1beta1:
 		ingressInformer = kubeFactory.Networking().V1beta1().Ingresses().Informer()
 		ingressListerV1beta1 = kubeFactory.Networking().V1beta1().Ingresses().Lister()
@@ -330,6 +414,8 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 		ApisixFactory: apisixFactory,
 		KubeFactory:   kubeFactory,
 
+		NamespaceInformer: namespaceInformer,
+		NamespaceLister:   namespaceLister,
 		EpLister:          epLister,
 		EpInformer:        epInformer,
 		SvcLister:         svcLister,
@@ -343,21 +429,34 @@ func (c *Controller) initSharedInformers() *providertypes.ListerInformer {
 		IngressInformer:   ingressInformer,
 		IngressLister:     ingressLister,
 
-		ApisixUpstreamLister:      apisixUpstreamLister,
-		ApisixRouteLister:         apisixRouteLister,
-		ApisixConsumerLister:      apisixConsumerLister,
-		ApisixTlsLister:           apisixTlsLister,
-		ApisixPluginConfigLister:  apisixPluginConfigLister,
-		ApisixClusterConfigLister: apisixClusterConfigLister,
-		ApisixGlobalRuleLister:    ApisixGlobalRuleLister,
-
-		ApisixUpstreamInformer:      apisixUpstreamInformer,
-		ApisixPluginConfigInformer:  apisixPluginConfigInformer,
-		ApisixRouteInformer:         apisixRouteInformer,
-		ApisixClusterConfigInformer: apisixClusterConfigInformer,
-		ApisixConsumerInformer:      apisixConsumerInformer,
-		ApisixTlsInformer:           apisixTlsInformer,
-		ApisixGlobalRuleInformer:    ApisixGlobalRuleInformer,
+		IngressClassInformer: ingressClassInformer,
+		IngressClassLister:   ingressClassLister,
+
+		ApisixUpstreamLister:           apisixUpstreamLister,
+		ApisixRouteLister:              apisixRouteLister,
+		ApisixConsumerLister:           apisixConsumerLister,
+		ApisixTlsLister:                apisixTlsLister,
+		ApisixPluginConfigLister:       apisixPluginConfigLister,
+		ApisixClusterConfigLister:      apisixClusterConfigLister,
+		ApisixGlobalRuleLister:         ApisixGlobalRuleLister,
+		ApisixConsumerGroupLister:      ApisixConsumerGroupLister,
+		ApisixSecretProviderLister:     ApisixSecretProviderLister,
+		ApisixServiceLister:            ApisixServiceLister,
+		ApisixProtoLister:              ApisixProtoLister,
+		ApisixIngressClassConfigLister: ApisixIngressClassConfigLister,
+
+		ApisixUpstreamInformer:           apisixUpstreamInformer,
+		ApisixPluginConfigInformer:       apisixPluginConfigInformer,
+		ApisixRouteInformer:              apisixRouteInformer,
+		ApisixClusterConfigInformer:      apisixClusterConfigInformer,
+		ApisixConsumerInformer:           apisixConsumerInformer,
+		ApisixTlsInformer:                apisixTlsInformer,
+		ApisixGlobalRuleInformer:         ApisixGlobalRuleInformer,
+		ApisixConsumerGroupInformer:      ApisixConsumerGroupInformer,
+		ApisixSecretProviderInformer:     ApisixSecretProviderInformer,
+		ApisixServiceInformer:            ApisixServiceInformer,
+		ApisixProtoInformer:              ApisixProtoInformer,
+		ApisixIngressClassConfigInformer: ApisixIngressClassConfigInformer,
 	}
 
 	return listerInformer
@@ -376,14 +475,15 @@ func (c *Controller) run(ctx context.Context) {
 	// give up leader
 	defer c.leaderContextCancelFunc()
 
-	clusterOpts := &apisix.ClusterOptions{
-		AdminAPIVersion:  c.cfg.APISIX.AdminAPIVersion,
-		Name:             c.cfg.APISIX.DefaultClusterName,
-		AdminKey:         c.cfg.APISIX.DefaultClusterAdminKey,
-		BaseURL:          c.cfg.APISIX.DefaultClusterBaseURL,
-		MetricsCollector: c.MetricsCollector,
+	clusterOpts, err := utils.DefaultClusterOptions(&c.cfg.APISIX, c.MetricsCollector)
+	if err != nil {
+		log.Errorf("failed to build default cluster options: %s", err)
+		return
+	}
+	if c.cfg.APISIX.ConfigProvider == config.ConfigProviderYAML {
+		clusterOpts.StandaloneRenderer = c.newStandaloneRenderer()
 	}
-	err := c.apisix.AddCluster(ctx, clusterOpts)
+	err = c.apisix.AddCluster(ctx, clusterOpts)
 	if err != nil && err != apisix.ErrDuplicatedCluster {
 		// TODO give up the leader role
 		log.Errorf("failed to add default cluster: %s", err)
@@ -466,6 +566,7 @@ func (c *Controller) run(ctx context.Context) {
 			MetricsCollector:  c.MetricsCollector,
 			NamespaceProvider: c.namespaceProvider,
 			ListerInformer:    common.ListerInformer,
+			Recorder:          c.recorder,
 		})
 		if err != nil {
 			ctx.Done()
@@ -491,6 +592,25 @@ func (c *Controller) run(ctx context.Context) {
 		ctx.Done()
 		return
 	}
+	c.collectGarbage(ctx)
+
+	translators := map[string]providertypes.ManifestTranslator{
+		"ApisixRoute":        c.apisixProvider,
+		"ApisixPluginConfig": c.apisixProvider,
+		"ApisixTls":          c.apisixProvider,
+		"Ingress":            c.ingressProvider,
+	}
+	if c.cfg.Kubernetes.APIVersion == config.ApisixV2 {
+		translators["ApisixGlobalRule"] = c.apisixProvider
+		translators["ApisixService"] = c.apisixProvider
+	}
+	if c.cfg.Kubernetes.EnableGatewayAPI {
+		for _, kind := range []string{"HTTPRoute", "GRPCRoute", "TLSRoute", "TCPRoute", "UDPRoute", "Gateway"} {
+			translators[kind] = c.gatewayProvider
+		}
+	}
+	c.setManifestTranslators(translators)
+	defer c.setManifestTranslators(nil)
 
 	// Run Phase
 
@@ -525,6 +645,10 @@ func (c *Controller) run(ctx context.Context) {
 	e.Add(func() {
 		c.resourceSyncLoop(ctx, c.cfg.ApisixResourceSyncInterval.Duration)
 	})
+
+	e.Add(func() {
+		c.driftDetectionLoop(ctx, common.SelectClusters)
+	})
 	c.MetricsCollector.ResetLeader(true)
 
 	log.Infow("controller now is running as leader",
@@ -567,6 +691,30 @@ func (c *Controller) checkClusterHealth(ctx context.Context, cancelFunc context.
 		}
 		log.Debugf("success check health for default cluster")
 		c.MetricsCollector.IncrCheckClusterHealth(c.name)
+
+		c.checkAdditionalClustersHealth(ctx)
+	}
+}
+
+// checkAdditionalClustersHealth checks the health of clusters registered by
+// ApisixClusterConfig, unlike the default cluster, an unhealthy additional
+// cluster doesn't make the controller give up leader.
+func (c *Controller) checkAdditionalClustersHealth(ctx context.Context) {
+	defaultCluster := c.apisix.Cluster(c.cfg.APISIX.DefaultClusterName)
+	for _, cluster := range c.apisix.ListClusters() {
+		if cluster == defaultCluster {
+			continue
+		}
+		if err := cluster.HealthCheck(ctx); err != nil {
+			log.Warnw("failed to check health for cluster",
+				zap.String("cluster", cluster.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		log.Debugw("success check health for cluster",
+			zap.String("cluster", cluster.String()),
+		)
 	}
 }
 
@@ -597,6 +745,7 @@ func (c *Controller) resourceSyncLoop(ctx context.Context, interval time.Duratio
 		select {
 		case <-ticker.C:
 			c.syncAllResources()
+			c.collectGarbage(ctx)
 			continue
 		case <-ctx.Done():
 			return
@@ -0,0 +1,115 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package providers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+
+	providertypes "github.com/apache/apisix-ingress-controller/pkg/providers/types"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+)
+
+// setManifestTranslators sets the providers which translate each kind of
+// resources, nil means the pending changes are unavailable.
+func (c *Controller) setManifestTranslators(translators map[string]providertypes.ManifestTranslator) {
+	c.manifestTranslatorsLock.Lock()
+	defer c.manifestTranslatorsLock.Unlock()
+	c.manifestTranslators = translators
+}
+
+// Diff returns the pending changes of the resources against the cache of the
+// given APISIX cluster, nothing is applied. All the supported kinds are
+// translated if kind is empty, and all the resources of the kind are translated
+// if key is empty. Managed objects which are translated from the supported kinds
+// but not produced by any resource are reported as deleted only if both kind
+// and key are empty.
+func (c *Controller) Diff(ctx context.Context, cluster, kind, key string) (*utils.ManifestDiff, error) {
+	c.manifestTranslatorsLock.RLock()
+	translators := c.manifestTranslators
+	c.manifestTranslatorsLock.RUnlock()
+	if translators == nil {
+		return nil, utils.ErrNotLeader
+	}
+
+	if cluster == "" {
+		cluster = c.cfg.APISIX.DefaultClusterName
+	}
+	if !c.hasCluster(cluster) {
+		return nil, fmt.Errorf("%w: cluster %s not found", utils.ErrBadDiffRequest, cluster)
+	}
+
+	var kinds []string
+	if kind != "" {
+		if _, ok := translators[kind]; !ok {
+			return nil, fmt.Errorf("%w: unsupported resource kind %s", utils.ErrBadDiffRequest, kind)
+		}
+		kinds = append(kinds, kind)
+	} else {
+		if key != "" {
+			return nil, fmt.Errorf("%w: kind is required when key is specified", utils.ErrBadDiffRequest)
+		}
+		for k := range translators {
+			kinds = append(kinds, k)
+		}
+		sort.Strings(kinds)
+	}
+
+	m := &utils.Manifest{}
+	var errs []string
+	for _, k := range kinds {
+		err := translators[k].TranslateManifests(k, key, m)
+		if err == nil {
+			continue
+		}
+		if key != "" {
+			return nil, err
+		}
+		if merr, ok := err.(*multierror.Error); ok {
+			for _, e := range merr.Errors {
+				errs = append(errs, e.Error())
+			}
+		} else {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	var pruneKinds []string
+	if kind == "" && key == "" {
+		// Only the objects translated from the supported kinds are pruned, the
+		// others can't be told whether they are still desired.
+		pruneKinds = kinds
+	}
+	diff, err := utils.DiffManifestWithCache(c.apisix.Cluster(cluster).Cache(), m, pruneKinds)
+	if err != nil {
+		return nil, err
+	}
+	diff.Cluster = cluster
+	diff.Errors = errs
+	return diff, nil
+}
+
+func (c *Controller) hasCluster(name string) bool {
+	target := c.apisix.Cluster(name)
+	for _, cluster := range c.apisix.ListClusters() {
+		if cluster == target {
+			return true
+		}
+	}
+	return false
+}
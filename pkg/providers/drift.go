@@ -0,0 +1,315 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package providers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	providertypes "github.com/apache/apisix-ingress-controller/pkg/providers/types"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+)
+
+const (
+	_driftDetected  = "DriftDetected"
+	_driftCorrected = "DriftCorrected"
+)
+
+var (
+	// _clusterSelectableKinds are the kinds of resources which can be synced
+	// to the clusters other than the default one.
+	_clusterSelectableKinds = map[string]struct{}{
+		"ApisixRoute":        {},
+		"ApisixPluginConfig": {},
+		"ApisixGlobalRule":   {},
+		"ApisixService":      {},
+	}
+	_driftTypes = []string{utils.DriftTypeModified, utils.DriftTypeMissing, utils.DriftTypeOrphaned}
+)
+
+// driftState is the desired state of an APISIX cluster, and the resources
+// which the desired objects are translated from.
+type driftState struct {
+	desired *utils.Manifest
+	// "resource type/id" -> resources
+	owners map[string][]runtime.Object
+}
+
+func (c *Controller) driftDetectionLoop(ctx context.Context, selectClusters func(metav1.Object) ([]string, error)) {
+	interval := c.cfg.DriftDetection.Interval.Duration
+	if interval == 0 {
+		log.Info("drift detection interval set to 0, drift detection disabled.")
+		return
+	}
+	// The interval shall not be less than 60 seconds.
+	if interval < _mininumApisixResourceSyncInterval {
+		log.Warnw("The drift detection interval shall not be less than 60 seconds.",
+			zap.String("interval", interval.String()),
+		)
+		interval = _mininumApisixResourceSyncInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.detectDrifts(ctx, selectClusters)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// detectDrifts lists objects from each APISIX cluster and compares them to
+// the desired state, the drifted objects are reported via metrics and events,
+// and corrected if their resource types are configured to.
+func (c *Controller) detectDrifts(ctx context.Context, selectClusters func(metav1.Object) ([]string, error)) {
+	c.manifestTranslatorsLock.RLock()
+	translators := c.manifestTranslators
+	c.manifestTranslatorsLock.RUnlock()
+	if translators == nil {
+		return
+	}
+
+	states, complete := c.collectDriftStates(translators, selectClusters)
+	// Only the objects translated from the supported kinds can be told whether
+	// they are orphaned.
+	var orphanKinds []string
+	if complete {
+		for kind := range translators {
+			orphanKinds = append(orphanKinds, kind)
+		}
+		sort.Strings(orphanKinds)
+	} else {
+		log.Warn("some resources failed to be translated, skip detecting orphaned objects")
+	}
+
+	clusters := make([]string, 0, len(states))
+	for name := range states {
+		clusters = append(clusters, name)
+	}
+	sort.Strings(clusters)
+	for _, name := range clusters {
+		if !c.hasCluster(name) {
+			continue
+		}
+		if err := c.detectClusterDrifts(ctx, name, states[name], orphanKinds); err != nil {
+			log.Errorw("failed to detect drifts",
+				zap.String("cluster", name),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// collectDriftStates translates all the resources and groups the translated
+// objects by cluster, complete is false if any resource failed to be translated.
+func (c *Controller) collectDriftStates(translators map[string]providertypes.ManifestTranslator,
+	selectClusters func(metav1.Object) ([]string, error)) (states map[string]*driftState, complete bool) {
+	complete = true
+	states = map[string]*driftState{
+		c.cfg.APISIX.DefaultClusterName: newDriftState(),
+	}
+
+	kinds := make([]string, 0, len(translators))
+	for kind := range translators {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		objs, err := translators[kind].ListManifestResources(kind)
+		if err != nil {
+			log.Errorw("failed to list resources",
+				zap.String("kind", kind),
+				zap.Error(err),
+			)
+			complete = false
+			continue
+		}
+		for _, obj := range objs {
+			key, err := cache.MetaNamespaceKeyFunc(obj)
+			if err != nil {
+				continue
+			}
+			m := &utils.Manifest{}
+			if err := translators[kind].TranslateManifests(kind, key, m); err != nil {
+				log.Warnw("failed to translate resource",
+					zap.String("kind", kind),
+					zap.String("key", key),
+					zap.Error(err),
+				)
+				complete = false
+				continue
+			}
+			clusters := []string{c.cfg.APISIX.DefaultClusterName}
+			if _, ok := _clusterSelectableKinds[kind]; ok {
+				if meta, ok := obj.(metav1.Object); ok {
+					if clusters, err = selectClusters(meta); err != nil {
+						log.Warnw("failed to select clusters",
+							zap.String("kind", kind),
+							zap.String("key", key),
+							zap.Error(err),
+						)
+						complete = false
+						continue
+					}
+				}
+			}
+			for _, cluster := range clusters {
+				if states[cluster] == nil {
+					states[cluster] = newDriftState()
+				}
+				states[cluster].add(m, obj)
+			}
+		}
+	}
+	return states, complete
+}
+
+func (c *Controller) detectClusterDrifts(ctx context.Context, cluster string, state *driftState, orphanKinds []string) error {
+	live, err := utils.ListManifest(ctx, c.apisix.Cluster(cluster))
+	if err != nil {
+		return err
+	}
+	report, err := utils.DetectDrifts(c.apisix.Cluster(cluster).Cache(), state.desired, live, orphanKinds)
+	if err != nil {
+		return err
+	}
+
+	counts := report.Counts()
+	for _, resource := range config.DriftResourceTypes {
+		for _, driftType := range _driftTypes {
+			c.MetricsCollector.SetDriftObjects(cluster, resource, driftType, counts[resource][driftType])
+		}
+	}
+	for _, obj := range report.Objects {
+		log.Warnw("object drifted from the desired state",
+			zap.String("cluster", cluster),
+			zap.Any("object", obj),
+		)
+		msg := fmt.Sprintf("%s %s (id: %s) is %s in cluster %s", obj.Kind, obj.Name, obj.ID, obj.Type, cluster)
+		for _, owner := range state.owners[obj.Kind+"/"+obj.ID] {
+			c.recorder.Event(owner, corev1.EventTypeWarning, _driftDetected, msg)
+		}
+	}
+
+	added, updated, deleted := report.Corrections(c.cfg.DriftDetection.AutoCorrect, c.cfg.DriftDetection.GarbageCollect)
+	if err := c.syncClusterManifests(ctx, cluster, added, updated, deleted); err != nil {
+		reason := utils.SyncFailedReason(err)
+		for _, obj := range report.Objects {
+			if _, ok := c.driftCorrection(obj); !ok {
+				continue
+			}
+			msg := fmt.Sprintf("failed to correct %s %s (id: %s) in cluster %s: %s", obj.Kind, obj.Name, obj.ID, cluster, err)
+			for _, owner := range state.owners[obj.Kind+"/"+obj.ID] {
+				c.recorder.Event(owner, corev1.EventTypeWarning, reason, msg)
+			}
+		}
+		return err
+	}
+	for _, obj := range report.Objects {
+		action, ok := c.driftCorrection(obj)
+		if !ok {
+			continue
+		}
+		c.MetricsCollector.IncrDriftCorrection(obj.Kind, action)
+		msg := fmt.Sprintf("%s %s (id: %s) is corrected in cluster %s", obj.Kind, obj.Name, obj.ID, cluster)
+		for _, owner := range state.owners[obj.Kind+"/"+obj.ID] {
+			c.recorder.Event(owner, corev1.EventTypeNormal, _driftCorrected, msg)
+		}
+	}
+	return nil
+}
+
+// syncClusterManifests applies the drift corrections in the same way as the
+// resource controllers, they are rolled back as a whole if transactional sync
+// is enabled.
+func (c *Controller) syncClusterManifests(ctx context.Context, cluster string, added, updated, deleted *utils.Manifest) error {
+	if c.cfg.APISIX.TransactionalSync {
+		return utils.ApplyManifests(ctx, c.apisix, cluster, added, updated, deleted)
+	}
+	return utils.SyncManifests(ctx, c.apisix, cluster, added, updated, deleted)
+}
+
+// driftCorrection returns the action which corrects the drifted object, ok
+// is false if the object isn't configured to be corrected.
+func (c *Controller) driftCorrection(obj utils.ObjectDrift) (action string, ok bool) {
+	resourceTypes := c.cfg.DriftDetection.AutoCorrect
+	switch obj.Type {
+	case utils.DriftTypeModified:
+		action = utils.DiffActionUpdate
+	case utils.DriftTypeMissing:
+		action = utils.DiffActionAdd
+	case utils.DriftTypeOrphaned:
+		action = utils.DiffActionDelete
+		resourceTypes = c.cfg.DriftDetection.GarbageCollect
+	}
+	for _, rt := range resourceTypes {
+		if rt == obj.Kind {
+			return action, true
+		}
+	}
+	return "", false
+}
+
+func newDriftState() *driftState {
+	return &driftState{
+		desired: &utils.Manifest{},
+		owners:  make(map[string][]runtime.Object),
+	}
+}
+
+func (s *driftState) add(m *utils.Manifest, obj interface{}) {
+	s.desired.Append(m)
+	owner, ok := obj.(runtime.Object)
+	if !ok {
+		return
+	}
+	var keys []string
+	for _, r := range m.Routes {
+		keys = append(keys, "route/"+r.ID)
+	}
+	for _, u := range m.Upstreams {
+		keys = append(keys, "upstream/"+u.ID)
+	}
+	for _, sr := range m.StreamRoutes {
+		keys = append(keys, "stream_route/"+sr.ID)
+	}
+	for _, ssl := range m.SSLs {
+		keys = append(keys, "ssl/"+ssl.ID)
+	}
+	for _, pc := range m.PluginConfigs {
+		keys = append(keys, "plugin_config/"+pc.ID)
+	}
+	for _, gr := range m.GlobalRules {
+		keys = append(keys, "global_rule/"+gr.ID)
+	}
+	for _, svc := range m.Services {
+		keys = append(keys, "service/"+svc.ID)
+	}
+	for _, key := range keys {
+		s.owners[key] = append(s.owners[key], owner)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,8 +28,11 @@ import (
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/apache/apisix-ingress-controller/pkg/log"
+	gatewaytranslation "github.com/apache/apisix-ingress-controller/pkg/providers/gateway/translation"
+	gatewaytypes "github.com/apache/apisix-ingress-controller/pkg/providers/gateway/types"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
 	"github.com/apache/apisix-ingress-controller/pkg/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
 type gatewayController struct {
@@ -49,6 +53,18 @@ func newGatewayController(c *Provider) *gatewayController {
 		UpdateFunc: ctl.onUpdate,
 		DeleteFunc: ctl.OnDelete,
 	})
+	ctl.controller.ListerInformer.SecretInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: ctl.onSecretChange,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			prev := oldObj.(*corev1.Secret)
+			curr := newObj.(*corev1.Secret)
+			if prev.ResourceVersion >= curr.ResourceVersion {
+				return
+			}
+			ctl.onSecretChange(newObj)
+		},
+		DeleteFunc: ctl.onSecretChange,
+	})
 	return ctl
 }
 
@@ -109,6 +125,11 @@ func (c *gatewayController) sync(ctx context.Context, ev *types.Event) error {
 		}
 	}
 
+	var (
+		listeners  map[string]*gatewaytypes.ListenerConf
+		certErrors map[gatewayv1beta1.SectionName]error
+		ssls       []*apisixv1.Ssl
+	)
 	if ev.Type == types.EventDelete {
 		if gateway != nil {
 			// We still find the resource while we are processing the DELETE event,
@@ -125,19 +146,26 @@ func (c *gatewayController) sync(ctx context.Context, ev *types.Event) error {
 		if err != nil {
 			return err
 		}
-	} else {
-		if c.controller.HasGatewayClass(string(gateway.Spec.GatewayClassName)) {
-			// TODO: handle listeners
-			listeners, err := c.controller.translator.TranslateGatewayV1beta1(gateway)
-			if err != nil {
-				return err
-			}
+		c.syncRoutes(gateway.Namespace, gateway.Name)
+	} else if c.controller.HasGatewayClass(string(gateway.Spec.GatewayClassName)) {
+		// TODO: handle listeners
+		listeners, err = c.controller.translator.TranslateGatewayV1beta1(gateway)
+		if err != nil {
+			return err
+		}
 
-			err = c.controller.AddListeners(gateway.Namespace, gateway.Name, listeners)
-			if err != nil {
-				return err
-			}
+		err = c.controller.AddListeners(gateway.Namespace, gateway.Name, listeners)
+		if err != nil {
+			return err
 		}
+		c.syncRoutes(gateway.Namespace, gateway.Name)
+
+		ssls, certErrors = c.controller.translateListenerSSLs(gateway, listeners)
+	}
+
+	// The SSLs are removed if the Gateway is deleted or not managed any more.
+	if err = c.syncSSLs(ctx, key, ssls); err != nil {
+		return err
 	}
 
 	// TODO The current implementation does not fully support the definition of Gateway.
@@ -145,7 +173,7 @@ func (c *gatewayController) sync(ctx context.Context, ev *types.Event) error {
 	// At present, we choose to directly update `GatewayStatus.Addresses`
 	// to indicate that we have picked the Gateway resource.
 
-	c.recordStatus(gateway, string(gatewayv1beta1.ListenerReasonReady), metav1.ConditionTrue, gateway.Generation)
+	c.recordStatus(gateway, listeners, certErrors, string(gatewayv1beta1.ListenerReasonReady), metav1.ConditionTrue, gateway.Generation)
 	return nil
 }
 
@@ -194,7 +222,53 @@ func (c *gatewayController) onAdd(obj interface{}) {
 	})
 }
 func (c *gatewayController) onUpdate(oldObj, newObj interface{}) {
+	oldGateway := oldObj.(*gatewayv1beta1.Gateway)
+	newGateway := newObj.(*gatewayv1beta1.Gateway)
+	if oldGateway.ResourceVersion >= newGateway.ResourceVersion {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(newObj)
+	if err != nil {
+		log.Errorw("found gateway resource with bad meta namespace key",
+			zap.Error(err),
+			zap.Any("obj", newObj),
+		)
+		return
+	}
+	if !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("gateway update event arrived",
+		zap.Any("old object", oldObj),
+		zap.Any("new object", newObj),
+	)
 
+	c.workqueue.Add(&types.Event{
+		Type:   types.EventUpdate,
+		Object: key,
+	})
+}
+
+// syncRoutes enqueues the routes attached to the Gateway, so that their
+// parent statuses are refreshed after the listeners changed.
+func (c *gatewayController) syncRoutes(namespace, name string) {
+	queues := c.controller.routeWorkqueues()
+	for kind, indexer := range c.controller.RouteIndexers() {
+		for _, obj := range indexer.List() {
+			route, ok := obj.(metav1.Object)
+			if !ok || !routeReferencesGateway(obj, route.GetNamespace(), namespace, name) {
+				continue
+			}
+			key, err := cache.MetaNamespaceKeyFunc(obj)
+			if err != nil {
+				continue
+			}
+			queues[kind].Add(&types.Event{
+				Type:   types.EventAdd,
+				Object: key,
+			})
+		}
+	}
 }
 
 func (c *gatewayController) OnDelete(obj interface{}) {
@@ -228,8 +302,11 @@ func (c *gatewayController) OnDelete(obj interface{}) {
 }
 
 // recordStatus record resources status
-func (c *gatewayController) recordStatus(v *gatewayv1beta1.Gateway, reason string, status metav1.ConditionStatus, generation int64) {
+func (c *gatewayController) recordStatus(v *gatewayv1beta1.Gateway, listeners map[string]*gatewaytypes.ListenerConf, certErrors map[gatewayv1beta1.SectionName]error, reason string, status metav1.ConditionStatus, generation int64) {
 	v = v.DeepCopy()
+	if listeners != nil {
+		v.Status.Listeners = c.listenerStatuses(v, listeners, certErrors)
+	}
 
 	gatewayCondition := metav1.Condition{
 		Type:               string(gatewayv1beta1.ListenerConditionReady),
@@ -262,3 +339,117 @@ func (c *gatewayController) recordStatus(v *gatewayv1beta1.Gateway, reason strin
 		)
 	}
 }
+
+// listenerStatuses computes the statuses of the Gateway listeners, listeners
+// contains the accepted ones, and certErrors contains the errors of
+// translating their certificateRefs.
+func (c *gatewayController) listenerStatuses(gateway *gatewayv1beta1.Gateway, listeners map[string]*gatewaytypes.ListenerConf,
+	certErrors map[gatewayv1beta1.SectionName]error) []gatewayv1beta1.ListenerStatus {
+	statuses := make([]gatewayv1beta1.ListenerStatus, 0, len(gateway.Spec.Listeners))
+	for _, listener := range gateway.Spec.Listeners {
+		status := gatewayv1beta1.ListenerStatus{
+			Name:           listener.Name,
+			SupportedKinds: []gatewayv1beta1.RouteGroupKind{},
+		}
+		for _, ls := range gateway.Status.Listeners {
+			if ls.Name == listener.Name {
+				status.Conditions = ls.Conditions
+				status.AttachedRoutes = ls.AttachedRoutes
+				break
+			}
+		}
+
+		accepted := metav1.Condition{
+			Type:               string(gatewayv1beta1.ListenerConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayv1beta1.ListenerReasonAccepted),
+			ObservedGeneration: gateway.Generation,
+		}
+		resolvedRefs := metav1.Condition{
+			Type:               string(gatewayv1beta1.ListenerConditionResolvedRefs),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayv1beta1.ListenerReasonResolvedRefs),
+			ObservedGeneration: gateway.Generation,
+		}
+		if conf, ok := listeners[string(listener.Name)]; ok {
+			status.SupportedKinds = conf.AllowedKinds
+			if err, ok := certErrors[listener.Name]; ok {
+				resolvedRefs.Status = metav1.ConditionFalse
+				resolvedRefs.Reason = string(gatewayv1beta1.ListenerReasonInvalidCertificateRef)
+				resolvedRefs.Message = err.Error()
+			}
+		} else if !gatewaytranslation.CertificateRefsPermitted(c.controller.translator, gateway, listener) {
+			resolvedRefs.Status = metav1.ConditionFalse
+			resolvedRefs.Reason = string(gatewayv1beta1.ListenerReasonRefNotPermitted)
+			resolvedRefs.Message = "The certificateRefs are not permitted by any ReferenceGrant"
+		} else {
+			accepted.Status = metav1.ConditionFalse
+			accepted.Reason = string(gatewayv1beta1.ListenerReasonInvalid)
+			accepted.Message = "The listener configuration is invalid"
+		}
+		meta.SetStatusCondition(&status.Conditions, accepted)
+		meta.SetStatusCondition(&status.Conditions, resolvedRefs)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// syncSSLs syncs the SSLs translated from the listeners of the Gateway, the old
+// ones are found in the APISIX cache.
+func (c *gatewayController) syncSSLs(ctx context.Context, key string, ssls []*apisixv1.Ssl) error {
+	m := &utils.Manifest{
+		SSLs: ssls,
+	}
+	m.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "Gateway", key)
+	om, err := c.controller.ownedManifest("Gateway", key)
+	if err != nil {
+		return err
+	}
+	added, updated, deleted := m.Diff(om)
+	return c.controller.syncManifests(ctx, added, updated, deleted)
+}
+
+// onSecretChange re-syncs the Gateways whose listeners refer to the changed
+// Secret, so that the rotated certificates take effect.
+func (c *gatewayController) onSecretChange(obj interface{}) {
+	secretKey, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	for _, obj := range c.controller.gatewayInformer.GetIndexer().List() {
+		gateway := obj.(*gatewayv1beta1.Gateway)
+		if !gatewayRefersToSecret(gateway, secretKey) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(gateway)
+		if err != nil || !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		log.Infow("secret changed, re-sync Gateway",
+			zap.String("secret", secretKey),
+			zap.String("gateway", key),
+		)
+		c.workqueue.Add(&types.Event{
+			Type:   types.EventUpdate,
+			Object: key,
+		})
+	}
+}
+
+func gatewayRefersToSecret(gateway *gatewayv1beta1.Gateway, secretKey string) bool {
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		for _, ref := range listener.TLS.CertificateRefs {
+			ns := gateway.Namespace
+			if ref.Namespace != nil {
+				ns = string(*ref.Namespace)
+			}
+			if ns+"/"+string(ref.Name) == secretKey {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,341 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gateway
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+)
+
+type gatewayGRPCRouteController struct {
+	controller *Provider
+	workqueue  workqueue.RateLimitingInterface
+	workers    int
+}
+
+func newGatewayGRPCRouteController(c *Provider) *gatewayGRPCRouteController {
+	ctrl := &gatewayGRPCRouteController{
+		controller: c,
+		workqueue:  workqueue.NewNamedRateLimitingQueue(workqueue.NewItemFastSlowRateLimiter(1*time.Second, 60*time.Second, 5), "GatewayGRPCRoute"),
+		workers:    1,
+	}
+
+	ctrl.controller.gatewayGRPCRouteInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    ctrl.onAdd,
+		UpdateFunc: ctrl.onUpdate,
+		DeleteFunc: ctrl.OnDelete,
+	})
+	return ctrl
+}
+
+func (c *gatewayGRPCRouteController) run(ctx context.Context) {
+	log.Info("gateway GRPCRoute controller started")
+	defer log.Info("gateway GRPCRoute controller exited")
+	defer c.workqueue.ShutDown()
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.controller.gatewayGRPCRouteInformer.HasSynced) {
+		log.Error("sync Gateway GRPCRoute cache failed")
+		return
+	}
+
+	for i := 0; i < c.workers; i++ {
+		go c.runWorker(ctx)
+	}
+	<-ctx.Done()
+}
+
+func (c *gatewayGRPCRouteController) runWorker(ctx context.Context) {
+	for {
+		obj, quit := c.workqueue.Get()
+		if quit {
+			return
+		}
+		err := c.sync(ctx, obj.(*types.Event))
+		c.workqueue.Done(obj)
+		c.handleSyncErr(obj, err)
+	}
+}
+
+func (c *gatewayGRPCRouteController) sync(ctx context.Context, ev *types.Event) error {
+	key := ev.Object.(string)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Errorw("found Gateway GRPCRoute resource with invalid key",
+			zap.Error(err),
+			zap.String("key", key),
+		)
+		return err
+	}
+
+	log.Debugw("sync GRPCRoute", zap.String("key", key))
+
+	grpcRoute, err := c.controller.gatewayGRPCRouteLister.GRPCRoutes(namespace).Get(name)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Errorw("failed to get Gateway GRPCRoute",
+				zap.Error(err),
+				zap.String("key", key),
+			)
+			return err
+		}
+		if ev.Type != types.EventDelete {
+			log.Warnw("Gateway GRPCRoute was deleted before process",
+				zap.String("key", key),
+			)
+			// Don't need to retry.
+			return nil
+		}
+	}
+
+	if ev.Type == types.EventDelete {
+		if grpcRoute != nil {
+			// We still find the resource while we are processing the DELETE event,
+			// that means object with same namespace and name was created, discarding
+			// this stale DELETE event.
+			log.Warnw("discard the stale Gateway delete event since it exists",
+				zap.String("key", key),
+			)
+			return nil
+		}
+		grpcRoute = ev.Tombstone.(*gatewayv1alpha2.GRPCRoute)
+	}
+
+	tctx, err := c.controller.translator.TranslateGatewayGRPCRouteV1Alpha2(grpcRoute)
+	if err != nil {
+		if ev.Type != types.EventDelete {
+			c.recordStatus(grpcRoute, err, nil)
+		}
+		log.Errorw("failed to translate gateway GRPCRoute",
+			zap.Error(err),
+			zap.Any("object", grpcRoute),
+		)
+		return err
+	}
+
+	log.Debugw("translated GRPCRoute",
+		zap.Any("routes", tctx.Routes),
+		zap.Any("upstreams", tctx.Upstreams),
+	)
+	m := &utils.Manifest{
+		Routes:    tctx.Routes,
+		Upstreams: tctx.Upstreams,
+	}
+	m.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "GRPCRoute", key)
+
+	var (
+		added   *utils.Manifest
+		updated *utils.Manifest
+		deleted *utils.Manifest
+	)
+
+	if ev.Type == types.EventDelete {
+		deleted = m
+	} else if ev.Type == types.EventAdd {
+		added = m
+	} else if ev.OldObject == nil {
+		// The GRPCRoute is re-synced without the old object, e.g. when the
+		// ReferenceGrants change, compare with the objects in the cache.
+		var om *utils.Manifest
+		om, err = c.controller.ownedManifest("GRPCRoute", key)
+		if err != nil {
+			return err
+		}
+		added, updated, deleted = m.Diff(om)
+	} else {
+		var oldCtx *translation.TranslateContext
+		oldObj := ev.OldObject.(*gatewayv1alpha2.GRPCRoute)
+		oldCtx, err = c.controller.translator.TranslateGatewayGRPCRouteV1Alpha2(oldObj)
+		if err != nil {
+			log.Errorw("failed to translate old GRPCRoute",
+				zap.String("version", oldObj.APIVersion),
+				zap.String("event_type", "update"),
+				zap.Any("GRPCRoute", oldObj),
+				zap.Error(err),
+			)
+			return err
+		}
+
+		om := &utils.Manifest{
+			Routes:    oldCtx.Routes,
+			Upstreams: oldCtx.Upstreams,
+		}
+		om.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "GRPCRoute", key)
+		added, updated, deleted = m.Diff(om)
+	}
+
+	err = c.controller.syncManifests(ctx, added, updated, deleted)
+	if ev.Type != types.EventDelete {
+		if err != nil {
+			c.controller.recordEvent(grpcRoute, corev1.EventTypeWarning, utils.SyncFailedReason(err), err)
+		}
+		c.recordStatus(grpcRoute, nil, err)
+	}
+	return err
+}
+
+func (c *gatewayGRPCRouteController) handleSyncErr(obj interface{}, err error) {
+	if err == nil {
+		c.workqueue.Forget(obj)
+		c.controller.MetricsCollector.IncrSyncOperation("gateway_grpcroute", "success")
+		return
+	}
+	event := obj.(*types.Event)
+	if k8serrors.IsNotFound(err) && event.Type != types.EventDelete {
+		log.Infow("sync gateway GRPCRoute but not found, ignore",
+			zap.String("event_type", event.Type.String()),
+			zap.String("GRPCRoute ", event.Object.(string)),
+		)
+		c.workqueue.Forget(event)
+		return
+	}
+	log.Warnw("sync gateway GRPCRoute failed, will retry",
+		zap.Any("object", obj),
+		zap.Error(err),
+	)
+	c.workqueue.AddRateLimited(obj)
+	c.controller.MetricsCollector.IncrSyncOperation("gateway_grpcroute", "failure")
+}
+
+func (c *gatewayGRPCRouteController) onAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorw("found gateway GRPCRoute resource with bad meta namespace key",
+			zap.Error(err),
+		)
+		return
+	}
+	if !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("gateway GRPCRoute add event arrived",
+		zap.String("key", key),
+		zap.Any("object", obj),
+	)
+
+	c.workqueue.Add(&types.Event{
+		Type:   types.EventAdd,
+		Object: key,
+	})
+}
+
+func (c *gatewayGRPCRouteController) onUpdate(oldObj, newObj interface{}) {
+	oldGRPCRoute := oldObj.(*gatewayv1alpha2.GRPCRoute)
+	newGRPCRoute := newObj.(*gatewayv1alpha2.GRPCRoute)
+	if oldGRPCRoute.ResourceVersion >= newGRPCRoute.ResourceVersion {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(oldObj)
+	if err != nil {
+		log.Errorw("found gateway GRPCRoute resource with bad meta namespace key",
+			zap.Error(err),
+		)
+		return
+	}
+	if !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("Gateway GRPCRoute update event arrived",
+		zap.String("key", key),
+		zap.Any("old object", oldObj),
+		zap.Any("new object", newObj),
+	)
+
+	c.workqueue.Add(&types.Event{
+		Type:      types.EventUpdate,
+		Object:    key,
+		OldObject: oldGRPCRoute,
+	})
+}
+
+func (c *gatewayGRPCRouteController) OnDelete(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorw("found Gateway GRPCRoute resource with bad meta namespace key",
+			zap.Error(err),
+		)
+		return
+	}
+	grpcRoute, ok := obj.(*gatewayv1alpha2.GRPCRoute)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		grpcRoute = tombstone.Obj.(*gatewayv1alpha2.GRPCRoute)
+	}
+	if !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("Gateway GRPCRoute delete event arrived",
+		zap.String("key", key),
+		zap.Any("object", obj),
+	)
+
+	c.workqueue.Add(&types.Event{
+		Type:      types.EventDelete,
+		Object:    key,
+		Tombstone: grpcRoute,
+	})
+}
+
+// recordStatus records the parent statuses of the GRPCRoute.
+func (c *gatewayGRPCRouteController) recordStatus(grpcRoute *gatewayv1alpha2.GRPCRoute, err, syncErr error) {
+	if c.controller.Cfg.Kubernetes.DisableStatusUpdates {
+		return
+	}
+	route := &routeInfo{
+		kind:       "GRPCRoute",
+		namespace:  grpcRoute.Namespace,
+		generation: grpcRoute.Generation,
+		hostnames:  grpcRoute.Spec.Hostnames,
+		parentRefs: grpcRoute.Spec.ParentRefs,
+	}
+	for _, rule := range grpcRoute.Spec.Rules {
+		for _, backend := range rule.BackendRefs {
+			route.backendRefs = append(route.backendRefs, backend.BackendObjectReference)
+		}
+		for _, filter := range rule.Filters {
+			if filter.RequestMirror != nil {
+				route.backendRefs = append(route.backendRefs, filter.RequestMirror.BackendRef)
+			}
+		}
+	}
+	v := grpcRoute.DeepCopy()
+	v.Status.Parents = c.controller.routeParentStatuses(route, v.Status.Parents, err, syncErr)
+	if reflect.DeepEqual(v.Status, grpcRoute.Status) {
+		return
+	}
+	if _, errRecord := c.controller.gatewayClient.GatewayV1alpha2().GRPCRoutes(v.Namespace).UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+		log.Errorw("failed to record status change for GRPCRoute resource",
+			zap.Error(errRecord),
+			zap.String("name", v.Name),
+			zap.String("namespace", v.Namespace),
+		)
+	}
+}
@@ -16,10 +16,13 @@ package gateway
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -124,8 +127,10 @@ func (c *gatewayHTTPRouteController) sync(ctx context.Context, ev *types.Event)
 	}
 
 	tctx, err := c.controller.translator.TranslateGatewayHTTPRouteV1beta1(httpRoute)
-
 	if err != nil {
+		if ev.Type != types.EventDelete {
+			c.recordStatus(httpRoute, err, nil)
+		}
 		log.Errorw("failed to translate gateway HTTPRoute",
 			zap.Error(err),
 			zap.Any("object", httpRoute),
@@ -141,6 +146,7 @@ func (c *gatewayHTTPRouteController) sync(ctx context.Context, ev *types.Event)
 		Routes:    tctx.Routes,
 		Upstreams: tctx.Upstreams,
 	}
+	m.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "HTTPRoute", key)
 
 	var (
 		added   *utils.Manifest
@@ -152,6 +158,15 @@ func (c *gatewayHTTPRouteController) sync(ctx context.Context, ev *types.Event)
 		deleted = m
 	} else if ev.Type == types.EventAdd {
 		added = m
+	} else if ev.OldObject == nil {
+		// The HTTPRoute is re-synced without the old object, e.g. when the
+		// ReferenceGrants change, compare with the objects in the cache.
+		var om *utils.Manifest
+		om, err = c.controller.ownedManifest("HTTPRoute", key)
+		if err != nil {
+			return err
+		}
+		added, updated, deleted = m.Diff(om)
 	} else {
 		var oldCtx *translation.TranslateContext
 		oldObj := ev.OldObject.(*gatewayv1beta1.HTTPRoute)
@@ -170,10 +185,18 @@ func (c *gatewayHTTPRouteController) sync(ctx context.Context, ev *types.Event)
 			Routes:    oldCtx.Routes,
 			Upstreams: oldCtx.Upstreams,
 		}
+		om.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "HTTPRoute", key)
 		added, updated, deleted = m.Diff(om)
 	}
 
-	return utils.SyncManifests(ctx, c.controller.APISIX, c.controller.APISIXClusterName, added, updated, deleted)
+	err = c.controller.syncManifests(ctx, added, updated, deleted)
+	if ev.Type != types.EventDelete {
+		if err != nil {
+			c.controller.recordEvent(httpRoute, corev1.EventTypeWarning, utils.SyncFailedReason(err), err)
+		}
+		c.recordStatus(httpRoute, nil, err)
+	}
+	return err
 }
 
 func (c *gatewayHTTPRouteController) handleSyncErr(obj interface{}, err error) {
@@ -244,8 +267,9 @@ func (c *gatewayHTTPRouteController) onUpdate(oldObj, newObj interface{}) {
 	)
 
 	c.workqueue.Add(&types.Event{
-		Type:   types.EventUpdate,
-		Object: key,
+		Type:      types.EventUpdate,
+		Object:    key,
+		OldObject: oldHTTPRoute,
 	})
 }
 
@@ -271,3 +295,39 @@ func (c *gatewayHTTPRouteController) OnDelete(obj interface{}) {
 		Tombstone: obj,
 	})
 }
+
+// recordStatus records the parent statuses of the HTTPRoute.
+func (c *gatewayHTTPRouteController) recordStatus(httpRoute *gatewayv1beta1.HTTPRoute, err, syncErr error) {
+	if c.controller.Cfg.Kubernetes.DisableStatusUpdates {
+		return
+	}
+	route := &routeInfo{
+		kind:       "HTTPRoute",
+		namespace:  httpRoute.Namespace,
+		generation: httpRoute.Generation,
+		hostnames:  httpRoute.Spec.Hostnames,
+		parentRefs: httpRoute.Spec.ParentRefs,
+	}
+	for _, rule := range httpRoute.Spec.Rules {
+		for _, backend := range rule.BackendRefs {
+			route.backendRefs = append(route.backendRefs, backend.BackendObjectReference)
+		}
+		for _, filter := range rule.Filters {
+			if filter.RequestMirror != nil {
+				route.backendRefs = append(route.backendRefs, filter.RequestMirror.BackendRef)
+			}
+		}
+	}
+	v := httpRoute.DeepCopy()
+	v.Status.Parents = c.controller.routeParentStatuses(route, v.Status.Parents, err, syncErr)
+	if reflect.DeepEqual(v.Status, httpRoute.Status) {
+		return
+	}
+	if _, errRecord := c.controller.gatewayClient.GatewayV1beta1().HTTPRoutes(v.Namespace).UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+		log.Errorw("failed to record status change for HTTPRoute resource",
+			zap.Error(errRecord),
+			zap.String("name", v.Name),
+			zap.String("namespace", v.Namespace),
+		)
+	}
+}
@@ -0,0 +1,131 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gateway
+
+import (
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+)
+
+// gatewayReferenceGrantController re-translates the resources referencing
+// other namespaces when the ReferenceGrants change.
+type gatewayReferenceGrantController struct {
+	controller *Provider
+}
+
+func newGatewayReferenceGrantController(c *Provider) *gatewayReferenceGrantController {
+	ctrl := &gatewayReferenceGrantController{
+		controller: c,
+	}
+
+	ctrl.controller.gatewayReferenceGrantInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    ctrl.onAdd,
+		UpdateFunc: ctrl.onUpdate,
+		DeleteFunc: ctrl.OnDelete,
+	})
+	return ctrl
+}
+
+func (c *gatewayReferenceGrantController) onAdd(obj interface{}) {
+	grant := obj.(*gatewayv1beta1.ReferenceGrant)
+	log.Debugw("ReferenceGrant add event arrived",
+		zap.Any("object", obj),
+	)
+	c.resync(grant)
+}
+
+func (c *gatewayReferenceGrantController) onUpdate(oldObj, newObj interface{}) {
+	oldGrant := oldObj.(*gatewayv1beta1.ReferenceGrant)
+	newGrant := newObj.(*gatewayv1beta1.ReferenceGrant)
+	if oldGrant.ResourceVersion >= newGrant.ResourceVersion {
+		return
+	}
+	log.Debugw("ReferenceGrant update event arrived",
+		zap.Any("old object", oldObj),
+		zap.Any("new object", newObj),
+	)
+	c.resync(oldGrant, newGrant)
+}
+
+func (c *gatewayReferenceGrantController) OnDelete(obj interface{}) {
+	grant, ok := obj.(*gatewayv1beta1.ReferenceGrant)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		grant = tombstone.Obj.(*gatewayv1beta1.ReferenceGrant)
+	}
+	log.Debugw("ReferenceGrant delete event arrived",
+		zap.Any("object", obj),
+	)
+	c.resync(grant)
+}
+
+// resync enqueues the Gateways and routes which might be affected by the
+// ReferenceGrants, i.e. the ones matching the "from" of the grants.
+func (c *gatewayReferenceGrantController) resync(grants ...*gatewayv1beta1.ReferenceGrant) {
+	froms := make(map[gatewayv1beta1.ReferenceGrantFrom]struct{})
+	for _, grant := range grants {
+		for _, from := range grant.Spec.From {
+			if from.Group == gatewayv1beta1.GroupName {
+				froms[from] = struct{}{}
+			}
+		}
+	}
+
+	indexers := c.controller.RouteIndexers()
+	queues := c.controller.routeWorkqueues()
+	for from := range froms {
+		if from.Kind == "Gateway" {
+			c.enqueue(c.controller.gatewayInformer.GetIndexer(), c.controller.gatewayController.workqueue, string(from.Namespace))
+			continue
+		}
+		indexer, ok := indexers[string(from.Kind)]
+		if !ok {
+			continue
+		}
+		c.enqueue(indexer, queues[string(from.Kind)], string(from.Namespace))
+	}
+}
+
+// enqueue adds the resources in the namespace to the workqueue. The update
+// events don't carry the old objects, so that the translation results are
+// compared with the objects in the APISIX cache.
+func (c *gatewayReferenceGrantController) enqueue(indexer cache.Indexer, queue workqueue.RateLimitingInterface, namespace string) {
+	objs, err := indexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		log.Errorw("failed to list resources by namespace",
+			zap.Error(err),
+			zap.String("namespace", namespace),
+		)
+		return
+	}
+	for _, obj := range objs {
+		key, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil || !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+			continue
+		}
+		queue.Add(&types.Event{
+			Type:   types.EventUpdate,
+			Object: key,
+		})
+	}
+}
@@ -0,0 +1,289 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/types"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+)
+
+// routeInfo is the part of a route, regardless of its kind, which decides the
+// parent statuses. backendRefs include the targets of request mirror filters.
+type routeInfo struct {
+	kind        gatewayv1beta1.Kind
+	namespace   string
+	generation  int64
+	hostnames   []gatewayv1beta1.Hostname
+	parentRefs  []gatewayv1beta1.ParentReference
+	backendRefs []gatewayv1beta1.BackendObjectReference
+}
+
+// routeParentStatuses computes the parent statuses of a route, err is the
+// error of its translation and syncErr is the error of syncing its objects to
+// APISIX, in which case the reason tells whether the changes were rolled back.
+// The statuses written by other controllers are
+// kept, and the ones of this controller are replaced according to the
+// parentRefs, parents not managed by this controller are skipped.
+func (p *Provider) routeParentStatuses(route *routeInfo, current []gatewayv1beta1.RouteParentStatus, err, syncErr error) []gatewayv1beta1.RouteParentStatus {
+	var statuses []gatewayv1beta1.RouteParentStatus
+	for _, ps := range current {
+		if ps.ControllerName != GatewayClassName {
+			statuses = append(statuses, ps)
+		}
+	}
+
+	resolvedRefs := p.resolvedRefsCondition(route, err)
+	for _, ref := range route.parentRefs {
+		accepted, ok := p.acceptedCondition(route, ref)
+		if !ok {
+			continue
+		}
+		var translateErr *translation.TranslateError
+		if err != nil && !errors.As(err, &translateErr) && accepted.Status == metav1.ConditionTrue {
+			accepted.Status = metav1.ConditionFalse
+			accepted.Reason = string(gatewayv1beta1.RouteReasonUnsupportedValue)
+			accepted.Message = err.Error()
+		}
+		if syncErr != nil && accepted.Status == metav1.ConditionTrue {
+			accepted.Status = metav1.ConditionFalse
+			accepted.Reason = utils.SyncFailedReason(syncErr)
+			accepted.Message = syncErr.Error()
+		}
+
+		var conditions []metav1.Condition
+		for _, ps := range current {
+			if ps.ControllerName == GatewayClassName && reflect.DeepEqual(ps.ParentRef, ref) {
+				conditions = append(conditions, ps.Conditions...)
+				break
+			}
+		}
+		meta.SetStatusCondition(&conditions, accepted)
+		meta.SetStatusCondition(&conditions, resolvedRefs)
+		statuses = append(statuses, gatewayv1beta1.RouteParentStatus{
+			ParentRef:      ref,
+			ControllerName: GatewayClassName,
+			Conditions:     conditions,
+		})
+	}
+	return statuses
+}
+
+// acceptedCondition checks whether the route is attached to a listener of the
+// parent. The second return value is false if the parent is not a Gateway
+// managed by this controller.
+func (p *Provider) acceptedCondition(route *routeInfo, ref gatewayv1beta1.ParentReference) (metav1.Condition, bool) {
+	condition := metav1.Condition{
+		Type:               string(gatewayv1beta1.RouteConditionAccepted),
+		Status:             metav1.ConditionFalse,
+		Reason:             string(gatewayv1beta1.RouteReasonNoMatchingParent),
+		Message:            "No listener matches the parent reference",
+		ObservedGeneration: route.generation,
+	}
+	if (ref.Group != nil && *ref.Group != gatewayv1beta1.GroupName) || (ref.Kind != nil && *ref.Kind != "Gateway") {
+		return condition, false
+	}
+	ns := route.namespace
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+	listeners, ok := p.GatewayListeners(ns, string(ref.Name))
+	if !ok {
+		return condition, false
+	}
+
+	for _, listener := range listeners {
+		if ref.SectionName != nil && string(*ref.SectionName) != listener.SectionName {
+			continue
+		}
+		if ref.Port != nil && *ref.Port != listener.Port {
+			continue
+		}
+		if !listenerAllowsKind(listener, route.kind) || !p.listenerAllowsNamespace(listener, route.namespace) {
+			condition.Reason = string(gatewayv1beta1.RouteReasonNotAllowedByListeners)
+			condition.Message = "Route is not allowed by the listeners"
+			continue
+		}
+		if !listenerMatchesHostnames(listener, route.hostnames) {
+			condition.Reason = string(gatewayv1beta1.RouteReasonNoMatchingListenerHostname)
+			condition.Message = "No listener hostname matches the route hostnames"
+			continue
+		}
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = string(gatewayv1beta1.RouteReasonAccepted)
+		condition.Message = "Route is accepted"
+		break
+	}
+	return condition, true
+}
+
+// resolvedRefsCondition checks whether all the backends of the route are
+// resolved and permitted, err is the error of the translation.
+func (p *Provider) resolvedRefsCondition(route *routeInfo, err error) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               string(gatewayv1beta1.RouteConditionResolvedRefs),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayv1beta1.RouteReasonResolvedRefs),
+		Message:            "All references are resolved",
+		ObservedGeneration: route.generation,
+	}
+	for _, ref := range route.backendRefs {
+		if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Service") {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = string(gatewayv1beta1.RouteReasonInvalidKind)
+			condition.Message = "Only Service backends are supported"
+			return condition
+		}
+	}
+	from := gatewayv1beta1.ReferenceGrantFrom{
+		Group:     gatewayv1beta1.GroupName,
+		Kind:      route.kind,
+		Namespace: gatewayv1beta1.Namespace(route.namespace),
+	}
+	for _, ref := range route.backendRefs {
+		ns := route.namespace
+		if ref.Namespace != nil {
+			ns = string(*ref.Namespace)
+		}
+		to := gatewayv1beta1.ReferenceGrantTo{
+			Kind: "Service",
+			Name: &ref.Name,
+		}
+		if !p.translator.IsReferenceGrantedV1beta1(from, to, ns) {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = string(gatewayv1beta1.RouteReasonRefNotPermitted)
+			condition.Message = fmt.Sprintf("Reference to Service %s/%s is not permitted by any ReferenceGrant", ns, ref.Name)
+			return condition
+		}
+	}
+	var translateErr *translation.TranslateError
+	if errors.As(err, &translateErr) {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = string(gatewayv1beta1.RouteReasonBackendNotFound)
+		condition.Message = err.Error()
+	}
+	return condition
+}
+
+// routeReferencesGateway checks whether the route has a parentRef pointing at
+// the Gateway.
+func routeReferencesGateway(obj interface{}, routeNamespace, namespace, name string) bool {
+	var parentRefs []gatewayv1beta1.ParentReference
+	switch route := obj.(type) {
+	case *gatewayv1beta1.HTTPRoute:
+		parentRefs = route.Spec.ParentRefs
+	case *gatewayv1alpha2.GRPCRoute:
+		parentRefs = route.Spec.ParentRefs
+	case *gatewayv1alpha2.TLSRoute:
+		parentRefs = route.Spec.ParentRefs
+	case *gatewayv1alpha2.TCPRoute:
+		parentRefs = route.Spec.ParentRefs
+	case *gatewayv1alpha2.UDPRoute:
+		parentRefs = route.Spec.ParentRefs
+	}
+	for _, ref := range parentRefs {
+		if ref.Kind != nil && *ref.Kind != "Gateway" {
+			continue
+		}
+		ns := routeNamespace
+		if ref.Namespace != nil {
+			ns = string(*ref.Namespace)
+		}
+		if ns == namespace && string(ref.Name) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func listenerAllowsKind(listener *types.ListenerConf, kind gatewayv1beta1.Kind) bool {
+	for _, allowed := range listener.AllowedKinds {
+		if allowed.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Provider) listenerAllowsNamespace(listener *types.ListenerConf, namespace string) bool {
+	if listener.RouteNamespace == nil || listener.RouteNamespace.From == nil {
+		return namespace == listener.Namespace
+	}
+	switch *listener.RouteNamespace.From {
+	case gatewayv1beta1.NamespacesFromAll:
+		return true
+	case gatewayv1beta1.NamespacesFromSelector:
+		if listener.RouteNamespace.Selector == nil {
+			return false
+		}
+		selector, err := metav1.LabelSelectorAsSelector(listener.RouteNamespace.Selector)
+		if err != nil {
+			log.Warnw("invalid namespace selector of listener",
+				zap.Error(err),
+				zap.String("gateway", listener.Namespace+"/"+listener.Name),
+				zap.String("listener", listener.SectionName),
+			)
+			return false
+		}
+		ns, err := p.ListerInformer.NamespaceLister.Get(namespace)
+		if err != nil {
+			log.Errorw("failed to get namespace",
+				zap.Error(err),
+				zap.String("namespace", namespace),
+			)
+			return false
+		}
+		return selector.Matches(labels.Set(ns.Labels))
+	default:
+		return namespace == listener.Namespace
+	}
+}
+
+// listenerMatchesHostnames checks whether the hostnames of the route intersect
+// with the hostname of the listener, wildcard hostnames are considered.
+func listenerMatchesHostnames(listener *types.ListenerConf, hostnames []gatewayv1beta1.Hostname) bool {
+	if listener.Hostname == nil || *listener.Hostname == "" || len(hostnames) == 0 {
+		return true
+	}
+	for _, hostname := range hostnames {
+		if hostnamesIntersect(string(*listener.Hostname), string(hostname)) {
+			return true
+		}
+	}
+	return false
+}
+
+func hostnamesIntersect(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if strings.HasPrefix(a, "*.") && strings.HasSuffix(b, a[1:]) {
+		return true
+	}
+	return strings.HasPrefix(b, "*.") && strings.HasSuffix(a, b[1:])
+}
@@ -0,0 +1,163 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gateway
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	gatewaytranslation "github.com/apache/apisix-ingress-controller/pkg/providers/gateway/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/types"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	providertypes "github.com/apache/apisix-ingress-controller/pkg/providers/types"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+)
+
+func TestListenerMatchesHostnames(t *testing.T) {
+	hostname := gatewayv1beta1.Hostname("*.example.com")
+	listener := &types.ListenerConf{Hostname: &hostname}
+
+	assert.True(t, listenerMatchesHostnames(listener, nil))
+	assert.True(t, listenerMatchesHostnames(listener, []gatewayv1beta1.Hostname{"foo.example.com"}))
+	assert.True(t, listenerMatchesHostnames(listener, []gatewayv1beta1.Hostname{"bar.org", "*.example.com"}))
+	assert.False(t, listenerMatchesHostnames(listener, []gatewayv1beta1.Hostname{"example.com", "foo.example.org"}))
+
+	hostname = "foo.example.com"
+	assert.True(t, listenerMatchesHostnames(listener, []gatewayv1beta1.Hostname{"*.example.com"}))
+	assert.False(t, listenerMatchesHostnames(listener, []gatewayv1beta1.Hostname{"*.foo.example.com"}))
+
+	assert.True(t, listenerMatchesHostnames(&types.ListenerConf{}, []gatewayv1beta1.Hostname{"bar.org"}))
+}
+
+func TestResolvedRefsCondition(t *testing.T) {
+	p := &Provider{
+		translator: gatewaytranslation.NewTranslator(&gatewaytranslation.TranslatorOptions{}),
+	}
+	route := &routeInfo{
+		kind:       "HTTPRoute",
+		namespace:  "default",
+		generation: 2,
+		backendRefs: []gatewayv1beta1.BackendObjectReference{
+			{Name: "httpbin"},
+		},
+	}
+	condition := p.resolvedRefsCondition(route, nil)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, int64(2), condition.ObservedGeneration)
+
+	condition = p.resolvedRefsCondition(route, &translation.TranslateError{Field: "service", Reason: "not found"})
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, string(gatewayv1beta1.RouteReasonBackendNotFound), condition.Reason)
+
+	ns := gatewayv1beta1.Namespace("test")
+	route.backendRefs = append(route.backendRefs, gatewayv1beta1.BackendObjectReference{Name: "foo", Namespace: &ns})
+	condition = p.resolvedRefsCondition(route, nil)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, string(gatewayv1beta1.RouteReasonRefNotPermitted), condition.Reason)
+
+	kind := gatewayv1beta1.Kind("ConfigMap")
+	route.backendRefs = append(route.backendRefs, gatewayv1beta1.BackendObjectReference{Name: "foo", Kind: &kind})
+	condition = p.resolvedRefsCondition(route, nil)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, string(gatewayv1beta1.RouteReasonInvalidKind), condition.Reason)
+}
+
+func TestListenerAllowsNamespace(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, indexer.Add(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "test",
+			Labels: map[string]string{"gateway": "apisix"},
+		},
+	}))
+	assert.Nil(t, indexer.Add(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "other"},
+	}))
+	p := &Provider{
+		ProviderOptions: &ProviderOptions{
+			ListerInformer: &providertypes.ListerInformer{
+				NamespaceLister: listerscorev1.NewNamespaceLister(indexer),
+			},
+		},
+	}
+
+	listener := &types.ListenerConf{Namespace: "default", Name: "gateway"}
+	assert.True(t, p.listenerAllowsNamespace(listener, "default"))
+	assert.False(t, p.listenerAllowsNamespace(listener, "test"))
+
+	from := gatewayv1beta1.NamespacesFromSelector
+	listener.RouteNamespace = &gatewayv1beta1.RouteNamespaces{
+		From: &from,
+		Selector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"gateway": "apisix"},
+		},
+	}
+	assert.True(t, p.listenerAllowsNamespace(listener, "test"))
+	assert.False(t, p.listenerAllowsNamespace(listener, "other"))
+	assert.False(t, p.listenerAllowsNamespace(listener, "missing"))
+
+	from = gatewayv1beta1.NamespacesFromAll
+	assert.True(t, p.listenerAllowsNamespace(listener, "missing"))
+}
+
+func TestRouteParentStatusesSyncFailed(t *testing.T) {
+	p := &Provider{
+		translator: gatewaytranslation.NewTranslator(&gatewaytranslation.TranslatorOptions{}),
+		listeners: map[string]map[string]*types.ListenerConf{
+			"default/gateway": {
+				"http": {
+					Namespace:    "default",
+					Name:         "gateway",
+					SectionName:  "http",
+					AllowedKinds: []gatewayv1beta1.RouteGroupKind{{Kind: "HTTPRoute"}},
+				},
+			},
+		},
+	}
+	route := &routeInfo{
+		kind:       "HTTPRoute",
+		namespace:  "default",
+		generation: 1,
+		parentRefs: []gatewayv1beta1.ParentReference{{Name: "gateway"}},
+	}
+	accepted := func(statuses []gatewayv1beta1.RouteParentStatus) *metav1.Condition {
+		assert.Len(t, statuses, 1)
+		return meta.FindStatusCondition(statuses[0].Conditions, string(gatewayv1beta1.RouteConditionAccepted))
+	}
+
+	condition := accepted(p.routeParentStatuses(route, nil, nil, nil))
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+
+	syncErr := &utils.RollbackError{Err: errors.New("timeout")}
+	condition = accepted(p.routeParentStatuses(route, nil, nil, syncErr))
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, utils.ResourceSyncRolledBack, condition.Reason)
+	assert.Equal(t, syncErr.Error(), condition.Message)
+
+	syncErr.RollbackErr = errors.New("connection refused")
+	condition = accepted(p.routeParentStatuses(route, nil, nil, syncErr))
+	assert.Equal(t, utils.ResourceSyncRollbackFailed, condition.Reason)
+
+	condition = accepted(p.routeParentStatuses(route, nil, nil, errors.New("timeout")))
+	assert.Equal(t, utils.ResourceSyncAborted, condition.Reason)
+}
@@ -18,10 +18,13 @@ package gateway
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -96,6 +99,9 @@ func (c *gatewayTCPRouteController) sync(ctx context.Context, ev *types.Event) e
 	}
 	tctx, err := c.controller.translator.TranslateGatewayTCPRouteV1Alpha2(tcpRoute)
 	if err != nil {
+		if ev.Type != types.EventDelete {
+			c.recordStatus(tcpRoute, err, nil)
+		}
 		log.Errorw("failed to translate gateway TCPRoute",
 			zap.Error(err),
 			zap.Any("object", tcpRoute),
@@ -111,6 +117,7 @@ func (c *gatewayTCPRouteController) sync(ctx context.Context, ev *types.Event) e
 		StreamRoutes: tctx.StreamRoutes,
 		Upstreams:    tctx.Upstreams,
 	}
+	m.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "TCPRoute", key)
 
 	var (
 		added   *utils.Manifest
@@ -122,6 +129,15 @@ func (c *gatewayTCPRouteController) sync(ctx context.Context, ev *types.Event) e
 		deleted = m
 	} else if ev.Type == types.EventAdd {
 		added = m
+	} else if ev.OldObject == nil {
+		// The TCPRoute is re-synced without the old object, e.g. when the
+		// ReferenceGrants change, compare with the objects in the cache.
+		var om *utils.Manifest
+		om, err = c.controller.ownedManifest("TCPRoute", key)
+		if err != nil {
+			return err
+		}
+		added, updated, deleted = m.Diff(om)
 	} else {
 		var oldCtx *translation.TranslateContext
 		oldObj := ev.OldObject.(*gatewayv1alpha2.TCPRoute)
@@ -140,10 +156,18 @@ func (c *gatewayTCPRouteController) sync(ctx context.Context, ev *types.Event) e
 			StreamRoutes: oldCtx.StreamRoutes,
 			Upstreams:    oldCtx.Upstreams,
 		}
+		om.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "TCPRoute", key)
 		added, updated, deleted = m.Diff(om)
 	}
 
-	return utils.SyncManifests(ctx, c.controller.APISIX, c.controller.APISIXClusterName, added, updated, deleted)
+	err = c.controller.syncManifests(ctx, added, updated, deleted)
+	if ev.Type != types.EventDelete {
+		if err != nil {
+			c.controller.recordEvent(tcpRoute, corev1.EventTypeWarning, utils.SyncFailedReason(err), err)
+		}
+		c.recordStatus(tcpRoute, nil, err)
+	}
+	return err
 }
 
 func (c *gatewayTCPRouteController) run(ctx context.Context) {
@@ -237,8 +261,9 @@ func (c *gatewayTCPRouteController) onUpdate(oldObj, newObj interface{}) {
 		zap.Any("new object", newObj),
 	)
 	c.workqueue.Add(&types.Event{
-		Type:   types.EventUpdate,
-		Object: key,
+		Type:      types.EventUpdate,
+		Object:    key,
+		OldObject: oldTCPRoute,
 	})
 }
 
@@ -262,3 +287,33 @@ func (c *gatewayTCPRouteController) OnDelete(obj interface{}) {
 		Tombstone: obj,
 	})
 }
+
+// recordStatus records the parent statuses of the TCPRoute.
+func (c *gatewayTCPRouteController) recordStatus(tcpRoute *gatewayv1alpha2.TCPRoute, err, syncErr error) {
+	if c.controller.Cfg.Kubernetes.DisableStatusUpdates {
+		return
+	}
+	route := &routeInfo{
+		kind:       "TCPRoute",
+		namespace:  tcpRoute.Namespace,
+		generation: tcpRoute.Generation,
+		parentRefs: tcpRoute.Spec.ParentRefs,
+	}
+	for _, rule := range tcpRoute.Spec.Rules {
+		for _, backend := range rule.BackendRefs {
+			route.backendRefs = append(route.backendRefs, backend.BackendObjectReference)
+		}
+	}
+	v := tcpRoute.DeepCopy()
+	v.Status.Parents = c.controller.routeParentStatuses(route, v.Status.Parents, err, syncErr)
+	if reflect.DeepEqual(v.Status, tcpRoute.Status) {
+		return
+	}
+	if _, errRecord := c.controller.gatewayClient.GatewayV1alpha2().TCPRoutes(v.Namespace).UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+		log.Errorw("failed to record status change for TCPRoute resource",
+			zap.Error(errRecord),
+			zap.String("name", v.Name),
+			zap.String("namespace", v.Namespace),
+		)
+	}
+}
@@ -33,10 +33,13 @@ package gateway
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -141,8 +144,10 @@ func (c *gatewayTLSRouteController) sync(ctx context.Context, ev *types.Event) e
 	}
 
 	tctx, err := c.controller.translator.TranslateGatewayTLSRouteV1Alpha2(tlsRoute)
-
 	if err != nil {
+		if ev.Type != types.EventDelete {
+			c.recordStatus(tlsRoute, err, nil)
+		}
 		log.Warnw("failed to translate gateway TLSRoute",
 			zap.Error(err),
 			zap.Any("object", tlsRoute),
@@ -158,6 +163,7 @@ func (c *gatewayTLSRouteController) sync(ctx context.Context, ev *types.Event) e
 		StreamRoutes: tctx.StreamRoutes,
 		Upstreams:    tctx.Upstreams,
 	}
+	m.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "TLSRoute", key)
 
 	var (
 		added   *utils.Manifest
@@ -169,6 +175,15 @@ func (c *gatewayTLSRouteController) sync(ctx context.Context, ev *types.Event) e
 		deleted = m
 	} else if ev.Type == types.EventAdd {
 		added = m
+	} else if ev.OldObject == nil {
+		// The TLSRoute is re-synced without the old object, e.g. when the
+		// ReferenceGrants change, compare with the objects in the cache.
+		var om *utils.Manifest
+		om, err = c.controller.ownedManifest("TLSRoute", key)
+		if err != nil {
+			return err
+		}
+		added, updated, deleted = m.Diff(om)
 	} else {
 		var oldCtx *translation.TranslateContext
 		oldObj := ev.OldObject.(*gatewayv1alpha2.TLSRoute)
@@ -187,10 +202,18 @@ func (c *gatewayTLSRouteController) sync(ctx context.Context, ev *types.Event) e
 			StreamRoutes: oldCtx.StreamRoutes,
 			Upstreams:    oldCtx.Upstreams,
 		}
+		om.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "TLSRoute", key)
 		added, updated, deleted = m.Diff(om)
 	}
 
-	return utils.SyncManifests(ctx, c.controller.APISIX, c.controller.APISIXClusterName, added, updated, deleted)
+	err = c.controller.syncManifests(ctx, added, updated, deleted)
+	if ev.Type != types.EventDelete {
+		if err != nil {
+			c.controller.recordEvent(tlsRoute, corev1.EventTypeWarning, utils.SyncFailedReason(err), err)
+		}
+		c.recordStatus(tlsRoute, nil, err)
+	}
+	return err
 }
 
 func (c *gatewayTLSRouteController) handleSyncErr(obj interface{}, err error) {
@@ -237,5 +260,90 @@ func (c *gatewayTLSRouteController) onAdd(obj interface{}) {
 		Object: key,
 	})
 }
-func (c *gatewayTLSRouteController) onUpdate(oldObj, newObj interface{}) {}
-func (c *gatewayTLSRouteController) OnDelete(obj interface{})            {}
+
+func (c *gatewayTLSRouteController) onUpdate(oldObj, newObj interface{}) {
+	oldTLSRoute := oldObj.(*gatewayv1alpha2.TLSRoute)
+	newTLSRoute := newObj.(*gatewayv1alpha2.TLSRoute)
+	if oldTLSRoute.ResourceVersion >= newTLSRoute.ResourceVersion {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(oldObj)
+	if err != nil {
+		log.Errorw("found gateway TLSRoute resource with bad meta namespace key",
+			zap.Error(err),
+		)
+		return
+	}
+	if !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("gateway TLSRoute update event arrived",
+		zap.Any("old object", oldObj),
+		zap.Any("new object", newObj),
+	)
+	c.workqueue.Add(&types.Event{
+		Type:      types.EventUpdate,
+		Object:    key,
+		OldObject: oldTLSRoute,
+	})
+}
+
+func (c *gatewayTLSRouteController) OnDelete(obj interface{}) {
+	tlsRoute, ok := obj.(*gatewayv1alpha2.TLSRoute)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		tlsRoute = tombstone.Obj.(*gatewayv1alpha2.TLSRoute)
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorw("found gateway TLSRoute resource with bad meta namespace key",
+			zap.Error(err),
+		)
+		return
+	}
+	if !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("gateway TLSRoute delete event arrived",
+		zap.Any("object", obj),
+	)
+	c.workqueue.Add(&types.Event{
+		Type:      types.EventDelete,
+		Object:    key,
+		Tombstone: tlsRoute,
+	})
+}
+
+// recordStatus records the parent statuses of the TLSRoute.
+func (c *gatewayTLSRouteController) recordStatus(tlsRoute *gatewayv1alpha2.TLSRoute, err, syncErr error) {
+	if c.controller.Cfg.Kubernetes.DisableStatusUpdates {
+		return
+	}
+	route := &routeInfo{
+		kind:       "TLSRoute",
+		namespace:  tlsRoute.Namespace,
+		generation: tlsRoute.Generation,
+		hostnames:  tlsRoute.Spec.Hostnames,
+		parentRefs: tlsRoute.Spec.ParentRefs,
+	}
+	for _, rule := range tlsRoute.Spec.Rules {
+		for _, backend := range rule.BackendRefs {
+			route.backendRefs = append(route.backendRefs, backend.BackendObjectReference)
+		}
+	}
+	v := tlsRoute.DeepCopy()
+	v.Status.Parents = c.controller.routeParentStatuses(route, v.Status.Parents, err, syncErr)
+	if reflect.DeepEqual(v.Status, tlsRoute.Status) {
+		return
+	}
+	if _, errRecord := c.controller.gatewayClient.GatewayV1alpha2().TLSRoutes(v.Namespace).UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+		log.Errorw("failed to record status change for TLSRoute resource",
+			zap.Error(errRecord),
+			zap.String("name", v.Name),
+			zap.String("namespace", v.Namespace),
+		)
+	}
+}
@@ -16,10 +16,13 @@ package gateway
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -124,8 +127,10 @@ func (c *gatewayUDPRouteController) sync(ctx context.Context, ev *types.Event) e
 	}
 
 	tctx, err := c.controller.translator.TranslateGatewayUDPRouteV1Alpha2(udpRoute)
-
 	if err != nil {
+		if ev.Type != types.EventDelete {
+			c.recordStatus(udpRoute, err, nil)
+		}
 		log.Errorw("failed to translate gateway UDPRoute",
 			zap.Error(err),
 			zap.Any("object", udpRoute),
@@ -141,6 +146,7 @@ func (c *gatewayUDPRouteController) sync(ctx context.Context, ev *types.Event) e
 		StreamRoutes: tctx.StreamRoutes,
 		Upstreams:    tctx.Upstreams,
 	}
+	m.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "UDPRoute", key)
 
 	var (
 		added   *utils.Manifest
@@ -152,6 +158,15 @@ func (c *gatewayUDPRouteController) sync(ctx context.Context, ev *types.Event) e
 		deleted = m
 	} else if ev.Type == types.EventAdd {
 		added = m
+	} else if ev.OldObject == nil {
+		// The UDPRoute is re-synced without the old object, e.g. when the
+		// ReferenceGrants change, compare with the objects in the cache.
+		var om *utils.Manifest
+		om, err = c.controller.ownedManifest("UDPRoute", key)
+		if err != nil {
+			return err
+		}
+		added, updated, deleted = m.Diff(om)
 	} else {
 		var oldCtx *translation.TranslateContext
 		oldObj := ev.OldObject.(*gatewayv1alpha2.UDPRoute)
@@ -170,10 +185,18 @@ func (c *gatewayUDPRouteController) sync(ctx context.Context, ev *types.Event) e
 			Routes:    oldCtx.Routes,
 			Upstreams: oldCtx.Upstreams,
 		}
+		om.SetOwnerLabels(c.controller.Cfg.Kubernetes.ElectionID, "UDPRoute", key)
 		added, updated, deleted = m.Diff(om)
 	}
 
-	return utils.SyncManifests(ctx, c.controller.APISIX, c.controller.APISIXClusterName, added, updated, deleted)
+	err = c.controller.syncManifests(ctx, added, updated, deleted)
+	if ev.Type != types.EventDelete {
+		if err != nil {
+			c.controller.recordEvent(udpRoute, corev1.EventTypeWarning, utils.SyncFailedReason(err), err)
+		}
+		c.recordStatus(udpRoute, nil, err)
+	}
+	return err
 }
 
 func (c *gatewayUDPRouteController) handleSyncErr(obj interface{}, err error) {
@@ -220,5 +243,89 @@ func (c *gatewayUDPRouteController) onAdd(obj interface{}) {
 		Object: key,
 	})
 }
-func (c *gatewayUDPRouteController) onUpdate(oldObj, newObj interface{}) {}
-func (c *gatewayUDPRouteController) OnDelete(obj interface{})            {}
+
+func (c *gatewayUDPRouteController) onUpdate(oldObj, newObj interface{}) {
+	oldUDPRoute := oldObj.(*gatewayv1alpha2.UDPRoute)
+	newUDPRoute := newObj.(*gatewayv1alpha2.UDPRoute)
+	if oldUDPRoute.ResourceVersion >= newUDPRoute.ResourceVersion {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(oldObj)
+	if err != nil {
+		log.Errorw("found gateway UDPRoute resource with bad meta namespace key",
+			zap.Error(err),
+		)
+		return
+	}
+	if !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("gateway UDPRoute update event arrived",
+		zap.Any("old object", oldObj),
+		zap.Any("new object", newObj),
+	)
+	c.workqueue.Add(&types.Event{
+		Type:      types.EventUpdate,
+		Object:    key,
+		OldObject: oldUDPRoute,
+	})
+}
+
+func (c *gatewayUDPRouteController) OnDelete(obj interface{}) {
+	udpRoute, ok := obj.(*gatewayv1alpha2.UDPRoute)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		udpRoute = tombstone.Obj.(*gatewayv1alpha2.UDPRoute)
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.Errorw("found gateway UDPRoute resource with bad meta namespace key",
+			zap.Error(err),
+		)
+		return
+	}
+	if !c.controller.NamespaceProvider.IsWatchingNamespace(key) {
+		return
+	}
+	log.Debugw("gateway UDPRoute delete event arrived",
+		zap.Any("object", obj),
+	)
+	c.workqueue.Add(&types.Event{
+		Type:      types.EventDelete,
+		Object:    key,
+		Tombstone: udpRoute,
+	})
+}
+
+// recordStatus records the parent statuses of the UDPRoute.
+func (c *gatewayUDPRouteController) recordStatus(udpRoute *gatewayv1alpha2.UDPRoute, err, syncErr error) {
+	if c.controller.Cfg.Kubernetes.DisableStatusUpdates {
+		return
+	}
+	route := &routeInfo{
+		kind:       "UDPRoute",
+		namespace:  udpRoute.Namespace,
+		generation: udpRoute.Generation,
+		parentRefs: udpRoute.Spec.ParentRefs,
+	}
+	for _, rule := range udpRoute.Spec.Rules {
+		for _, backend := range rule.BackendRefs {
+			route.backendRefs = append(route.backendRefs, backend.BackendObjectReference)
+		}
+	}
+	v := udpRoute.DeepCopy()
+	v.Status.Parents = c.controller.routeParentStatuses(route, v.Status.Parents, err, syncErr)
+	if reflect.DeepEqual(v.Status, udpRoute.Status) {
+		return
+	}
+	if _, errRecord := c.controller.gatewayClient.GatewayV1alpha2().UDPRoutes(v.Namespace).UpdateStatus(context.TODO(), v, metav1.UpdateOptions{}); errRecord != nil {
+		log.Errorw("failed to record status change for UDPRoute resource",
+			zap.Error(errRecord),
+			zap.String("name", v.Name),
+			zap.String("namespace", v.Namespace),
+		)
+	}
+}
@@ -19,11 +19,18 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
+	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	gatewayclientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 	gatewayexternalversions "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
@@ -31,8 +38,10 @@ import (
 	gatewaylistersv1beta1 "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
 
 	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	apisixcache "github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
 	"github.com/apache/apisix-ingress-controller/pkg/config"
 	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
 	"github.com/apache/apisix-ingress-controller/pkg/metrics"
 	gatewaytranslation "github.com/apache/apisix-ingress-controller/pkg/providers/gateway/translation"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/types"
@@ -40,6 +49,7 @@ import (
 	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
 	providertypes "github.com/apache/apisix-ingress-controller/pkg/providers/types"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
 const (
@@ -75,6 +85,10 @@ type Provider struct {
 	gatewayHTTPRouteInformer   cache.SharedIndexInformer
 	gatewayHTTPRouteLister     gatewaylistersv1beta1.HTTPRouteLister
 
+	gatewayGRPCRouteController *gatewayGRPCRouteController
+	gatewayGRPCRouteInformer   cache.SharedIndexInformer
+	gatewayGRPCRouteLister     gatewaylistersv1alpha2.GRPCRouteLister
+
 	gatewayTLSRouteController *gatewayTLSRouteController
 	gatewayTLSRouteInformer   cache.SharedIndexInformer
 	gatewayTLSRouteLister     gatewaylistersv1alpha2.TLSRouteLister
@@ -86,6 +100,10 @@ type Provider struct {
 	gatewayUDPRouteController *gatewayUDPRouteController
 	gatewayUDPRouteInformer   cache.SharedIndexInformer
 	gatewayUDPRouteLister     gatewaylistersv1alpha2.UDPRouteLister
+
+	gatewayReferenceGrantController *gatewayReferenceGrantController
+	gatewayReferenceGrantInformer   cache.SharedIndexInformer
+	gatewayReferenceGrantLister     gatewaylistersv1beta1.ReferenceGrantLister
 }
 
 type ProviderOptions struct {
@@ -98,6 +116,7 @@ type ProviderOptions struct {
 	MetricsCollector  metrics.Collector
 	NamespaceProvider namespace.WatchingNamespaceProvider
 	ListerInformer    *providertypes.ListerInformer
+	Recorder          record.EventRecorder
 }
 
 func NewGatewayProvider(opts *ProviderOptions) (*Provider, error) {
@@ -125,10 +144,6 @@ func NewGatewayProvider(opts *ProviderOptions) (*Provider, error) {
 
 		ProviderOptions: opts,
 		gatewayClient:   gatewayKubeClient,
-
-		translator: gatewaytranslation.NewTranslator(&gatewaytranslation.TranslatorOptions{
-			KubeTranslator: opts.KubeTranslator,
-		}),
 	}
 
 	gatewayFactory := gatewayexternalversions.NewSharedInformerFactory(p.gatewayClient, p.Cfg.Kubernetes.ResyncInterval.Duration)
@@ -142,6 +157,9 @@ func NewGatewayProvider(opts *ProviderOptions) (*Provider, error) {
 	p.gatewayHTTPRouteLister = gatewayFactory.Gateway().V1beta1().HTTPRoutes().Lister()
 	p.gatewayHTTPRouteInformer = gatewayFactory.Gateway().V1beta1().HTTPRoutes().Informer()
 
+	p.gatewayGRPCRouteLister = gatewayFactory.Gateway().V1alpha2().GRPCRoutes().Lister()
+	p.gatewayGRPCRouteInformer = gatewayFactory.Gateway().V1alpha2().GRPCRoutes().Informer()
+
 	p.gatewayTLSRouteLister = gatewayFactory.Gateway().V1alpha2().TLSRoutes().Lister()
 	p.gatewayTLSRouteInformer = gatewayFactory.Gateway().V1alpha2().TLSRoutes().Informer()
 
@@ -151,6 +169,15 @@ func NewGatewayProvider(opts *ProviderOptions) (*Provider, error) {
 	p.gatewayUDPRouteLister = gatewayFactory.Gateway().V1alpha2().UDPRoutes().Lister()
 	p.gatewayUDPRouteInformer = gatewayFactory.Gateway().V1alpha2().UDPRoutes().Informer()
 
+	p.gatewayReferenceGrantLister = gatewayFactory.Gateway().V1beta1().ReferenceGrants().Lister()
+	p.gatewayReferenceGrantInformer = gatewayFactory.Gateway().V1beta1().ReferenceGrants().Informer()
+
+	p.translator = gatewaytranslation.NewTranslator(&gatewaytranslation.TranslatorOptions{
+		KubeTranslator:       opts.KubeTranslator,
+		SecretLister:         opts.ListerInformer.SecretLister,
+		ReferenceGrantLister: p.gatewayReferenceGrantLister,
+	})
+
 	p.gatewayController = newGatewayController(p)
 
 	p.gatewayClassController, err = newGatewayClassController(p)
@@ -159,12 +186,15 @@ func NewGatewayProvider(opts *ProviderOptions) (*Provider, error) {
 	}
 
 	p.gatewayHTTPRouteController = newGatewayHTTPRouteController(p)
+	p.gatewayGRPCRouteController = newGatewayGRPCRouteController(p)
 
 	p.gatewayTLSRouteController = newGatewayTLSRouteController(p)
 	p.gatewayUDPRouteController = newGatewayUDPRouteController(p)
 
 	p.gatewayTCPRouteController = newGatewayTCPRouteController(p)
 
+	p.gatewayReferenceGrantController = newGatewayReferenceGrantController(p)
+
 	return p, nil
 }
 
@@ -181,6 +211,9 @@ func (p *Provider) Run(ctx context.Context) {
 	e.Add(func() {
 		p.gatewayHTTPRouteInformer.Run(ctx.Done())
 	})
+	e.Add(func() {
+		p.gatewayGRPCRouteInformer.Run(ctx.Done())
+	})
 	e.Add(func() {
 		p.gatewayTLSRouteInformer.Run(ctx.Done())
 	})
@@ -192,6 +225,9 @@ func (p *Provider) Run(ctx context.Context) {
 	e.Add(func() {
 		p.gatewayUDPRouteInformer.Run(ctx.Done())
 	})
+	e.Add(func() {
+		p.gatewayReferenceGrantInformer.Run(ctx.Done())
+	})
 
 	e.Add(func() {
 		p.gatewayController.run(ctx)
@@ -202,6 +238,9 @@ func (p *Provider) Run(ctx context.Context) {
 	e.Add(func() {
 		p.gatewayHTTPRouteController.run(ctx)
 	})
+	e.Add(func() {
+		p.gatewayGRPCRouteController.run(ctx)
+	})
 	e.Add(func() {
 		p.gatewayTLSRouteController.run(ctx)
 	})
@@ -246,7 +285,8 @@ func (p *Provider) AddListeners(ns, name string, listeners map[string]*types.Lis
 
 	// Check port conflicts
 	for _, listenerConf := range listeners {
-		if allocated, found := p.portListeners[listenerConf.Port]; found {
+		if allocated, found := p.portListeners[listenerConf.Port]; found &&
+			(allocated.Namespace != ns || allocated.Name != name) {
 			// TODO: support multi-error
 			return fmt.Errorf("port %d already allocated by %s/%s section %s",
 				listenerConf.Port, allocated.Namespace, allocated.Name, allocated.SectionName)
@@ -272,11 +312,256 @@ func (p *Provider) AddListeners(ns, name string, listeners map[string]*types.Lis
 }
 
 func (p *Provider) RemoveListeners(ns, name string) error {
+	p.listenersLock.Lock()
+	defer p.listenersLock.Unlock()
 
+	key := ns + "/" + name
+	for _, listenerConf := range p.listeners[key] {
+		if allocated, found := p.portListeners[listenerConf.Port]; found && allocated == listenerConf {
+			delete(p.portListeners, listenerConf.Port)
+		}
+	}
+	delete(p.listeners, key)
 	return nil
 }
 
 func (p *Provider) FindListener(ns, name, sectionName string) (*types.ListenerConf, error) {
+	p.listenersLock.RLock()
+	defer p.listenersLock.RUnlock()
+
+	listenerConf, ok := p.listeners[ns+"/"+name][sectionName]
+	if !ok {
+		return nil, fmt.Errorf("listener %s of Gateway %s/%s not found", sectionName, ns, name)
+	}
+	return listenerConf, nil
+}
+
+// GatewayListeners returns the listeners of the Gateway, the second return
+// value is false if the Gateway is not managed by this controller.
+func (p *Provider) GatewayListeners(ns, name string) ([]*types.ListenerConf, bool) {
+	p.listenersLock.RLock()
+	defer p.listenersLock.RUnlock()
+
+	listeners, ok := p.listeners[ns+"/"+name]
+	if !ok {
+		return nil, false
+	}
+	confs := make([]*types.ListenerConf, 0, len(listeners))
+	for _, listenerConf := range listeners {
+		confs = append(confs, listenerConf)
+	}
+	sort.Slice(confs, func(i, j int) bool {
+		return confs[i].SectionName < confs[j].SectionName
+	})
+	return confs, true
+}
+
+func (p *Provider) syncManifests(ctx context.Context, added, updated, deleted *utils.Manifest) error {
+	if p.Cfg.APISIX.TransactionalSync {
+		return utils.ApplyManifests(ctx, p.APISIX, p.APISIXClusterName, added, updated, deleted)
+	}
+	return utils.SyncManifests(ctx, p.APISIX, p.APISIXClusterName, added, updated, deleted)
+}
+
+// recordEvent records an event for the Gateway API resource, it's a no-op if
+// the provider has no event recorder.
+func (p *Provider) recordEvent(object runtime.Object, eventtype, reason string, err error) {
+	if p.Recorder == nil {
+		return
+	}
+	utils.RecorderEvent(p.Recorder, object, eventtype, reason, err)
+}
+
+// TranslateManifests translates Gateway API route and Gateway resources.
+func (p *Provider) TranslateManifests(kind, key string, m *utils.Manifest) error {
+	indexer, ok := p.manifestIndexers()[kind]
+	if !ok {
+		return fmt.Errorf("unsupported resource kind %s", kind)
+	}
+	objs, err := utils.ListObjects(indexer, key)
+	if err != nil {
+		return err
+	}
+	var merr *multierror.Error
+	for _, obj := range objs {
+		objKey, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil || !p.NamespaceProvider.IsWatchingNamespace(objKey) {
+			continue
+		}
+		om, err := p.translateManifest(obj)
+		if err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("%s %s: %s", kind, objKey, err))
+			continue
+		}
+		om.SetOwnerLabels(p.Cfg.Kubernetes.ElectionID, kind, objKey)
+		m.Append(om)
+	}
+	return merr.ErrorOrNil()
+}
+
+// translateManifest translates a Gateway API route or Gateway resource to
+// APISIX objects.
+func (p *Provider) translateManifest(obj interface{}) (*utils.Manifest, error) {
+	var (
+		tctx *translation.TranslateContext
+		err  error
+	)
+	switch obj := obj.(type) {
+	case *gatewayv1beta1.HTTPRoute:
+		tctx, err = p.translator.TranslateGatewayHTTPRouteV1beta1(obj)
+	case *gatewayv1alpha2.GRPCRoute:
+		tctx, err = p.translator.TranslateGatewayGRPCRouteV1Alpha2(obj)
+	case *gatewayv1alpha2.TLSRoute:
+		tctx, err = p.translator.TranslateGatewayTLSRouteV1Alpha2(obj)
+	case *gatewayv1alpha2.TCPRoute:
+		tctx, err = p.translator.TranslateGatewayTCPRouteV1Alpha2(obj)
+	case *gatewayv1alpha2.UDPRoute:
+		tctx, err = p.translator.TranslateGatewayUDPRouteV1Alpha2(obj)
+	case *gatewayv1beta1.Gateway:
+		m := &utils.Manifest{}
+		if !p.HasGatewayClass(string(obj.Spec.GatewayClassName)) {
+			return m, nil
+		}
+		listeners, err := p.translator.TranslateGatewayV1beta1(obj)
+		if err != nil {
+			return nil, err
+		}
+		m.SSLs, _ = p.translateListenerSSLs(obj, listeners)
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unsupported object %T", obj)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &utils.Manifest{
+		Routes:       tctx.Routes,
+		StreamRoutes: tctx.StreamRoutes,
+		Upstreams:    tctx.Upstreams,
+	}, nil
+}
+
+// translateListenerSSLs translates the certificateRefs of the accepted listeners
+// of the Gateway, the listeners which failed to be translated are returned with
+// their errors.
+func (p *Provider) translateListenerSSLs(gateway *gatewayv1beta1.Gateway, listeners map[string]*types.ListenerConf) (
+	[]*apisixv1.Ssl, map[gatewayv1beta1.SectionName]error) {
+	var ssls []*apisixv1.Ssl
+	certErrors := make(map[gatewayv1beta1.SectionName]error)
+	for _, listener := range gateway.Spec.Listeners {
+		if _, ok := listeners[string(listener.Name)]; !ok {
+			continue
+		}
+		ssl, err := p.translator.TranslateGatewayListenerSSLV1beta1(gateway, listener)
+		if err != nil {
+			log.Warnw("failed to translate certificateRefs of listener",
+				zap.Error(err),
+				zap.String("key", gateway.Namespace+"/"+gateway.Name),
+				zap.String("listener", string(listener.Name)),
+			)
+			certErrors[listener.Name] = err
+			continue
+		}
+		if ssl != nil {
+			ssls = append(ssls, ssl)
+		}
+	}
+	return ssls, certErrors
+}
+
+// RouteIndexers returns the indexers of Gateway API route resources, keyed
+// by kind.
+func (p *Provider) RouteIndexers() map[string]cache.Indexer {
+	return map[string]cache.Indexer{
+		"HTTPRoute": p.gatewayHTTPRouteInformer.GetIndexer(),
+		"GRPCRoute": p.gatewayGRPCRouteInformer.GetIndexer(),
+		"TLSRoute":  p.gatewayTLSRouteInformer.GetIndexer(),
+		"TCPRoute":  p.gatewayTCPRouteInformer.GetIndexer(),
+		"UDPRoute":  p.gatewayUDPRouteInformer.GetIndexer(),
+	}
+}
+
+// GatewayIndexer returns the indexer of Gateway resources.
+func (p *Provider) GatewayIndexer() cache.Indexer {
+	return p.gatewayInformer.GetIndexer()
+}
+
+// routeWorkqueues returns the workqueues of Gateway API route controllers,
+// keyed by kind.
+func (p *Provider) routeWorkqueues() map[string]workqueue.RateLimitingInterface {
+	return map[string]workqueue.RateLimitingInterface{
+		"HTTPRoute": p.gatewayHTTPRouteController.workqueue,
+		"GRPCRoute": p.gatewayGRPCRouteController.workqueue,
+		"TLSRoute":  p.gatewayTLSRouteController.workqueue,
+		"TCPRoute":  p.gatewayTCPRouteController.workqueue,
+		"UDPRoute":  p.gatewayUDPRouteController.workqueue,
+	}
+}
+
+// ownedManifest returns the objects in the APISIX cache which are translated
+// from the resource.
+func (p *Provider) ownedManifest(kind, key string) (*utils.Manifest, error) {
+	return ownedManifest(p.APISIX.Cluster(p.APISIXClusterName).Cache(), p.Cfg.Kubernetes.ElectionID, kind, key)
+}
+
+// ownedManifest returns the routes, stream routes, upstreams and SSLs in c
+// which are translated from the resource and managed by the instance. An
+// upstream shared with other resources is only labelled by the last one which
+// synced it, and it's kept by APISIX on deletion if it's still referenced.
+func ownedManifest(c apisixcache.Cache, instance, kind, key string) (*utils.Manifest, error) {
+	routes, err := c.ListRoutes()
+	if err != nil {
+		return nil, err
+	}
+	streamRoutes, err := c.ListStreamRoutes()
+	if err != nil {
+		return nil, err
+	}
+	upstreams, err := c.ListUpstreams()
+	if err != nil {
+		return nil, err
+	}
+	ssls, err := c.ListSSL()
+	if err != nil {
+		return nil, err
+	}
+	m := &utils.Manifest{}
+	for _, r := range routes {
+		if utils.IsOwnedBy(r.Labels, instance, kind, key) {
+			m.Routes = append(m.Routes, r)
+		}
+	}
+	for _, sr := range streamRoutes {
+		if utils.IsOwnedBy(sr.Labels, instance, kind, key) {
+			m.StreamRoutes = append(m.StreamRoutes, sr)
+		}
+	}
+	for _, u := range upstreams {
+		if utils.IsOwnedBy(u.Labels, instance, kind, key) {
+			m.Upstreams = append(m.Upstreams, u)
+		}
+	}
+	for _, ssl := range ssls {
+		if utils.IsOwnedBy(ssl.Labels, instance, kind, key) {
+			m.SSLs = append(m.SSLs, ssl)
+		}
+	}
+	return m, nil
+}
+
+// ListManifestResources lists Gateway API route and Gateway resources.
+func (p *Provider) ListManifestResources(kind string) ([]interface{}, error) {
+	indexer, ok := p.manifestIndexers()[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported resource kind %s", kind)
+	}
+	return indexer.List(), nil
+}
 
-	return nil, nil
+// manifestIndexers returns the indexers of the resources which are translated
+// to APISIX objects, keyed by kind.
+func (p *Provider) manifestIndexers() map[string]cache.Indexer {
+	indexers := p.RouteIndexers()
+	indexers["Gateway"] = p.GatewayIndexer()
+	return indexers
 }
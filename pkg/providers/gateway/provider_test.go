@@ -0,0 +1,131 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+	gatewayexternalversions "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
+
+	apisixcache "github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/k8s/namespace"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func newManifestTestProvider() *Provider {
+	factory := gatewayexternalversions.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	return &Provider{
+		gatewayClasses: make(map[string]struct{}),
+		ProviderOptions: &ProviderOptions{
+			Cfg:               config.NewDefaultConfig(),
+			NamespaceProvider: namespace.NewMockWatchingNamespaceProvider([]string{"default"}),
+		},
+		gatewayInformer:          factory.Gateway().V1beta1().Gateways().Informer(),
+		gatewayHTTPRouteInformer: factory.Gateway().V1beta1().HTTPRoutes().Informer(),
+		gatewayGRPCRouteInformer: factory.Gateway().V1alpha2().GRPCRoutes().Informer(),
+		gatewayTLSRouteInformer:  factory.Gateway().V1alpha2().TLSRoutes().Informer(),
+		gatewayTCPRouteInformer:  factory.Gateway().V1alpha2().TCPRoutes().Informer(),
+		gatewayUDPRouteInformer:  factory.Gateway().V1alpha2().UDPRoutes().Informer(),
+	}
+}
+
+func TestListManifestResources(t *testing.T) {
+	p := newManifestTestProvider()
+	meta := metav1.ObjectMeta{Namespace: "default", Name: "foo"}
+	assert.Nil(t, p.gatewayGRPCRouteInformer.GetIndexer().Add(&gatewayv1alpha2.GRPCRoute{ObjectMeta: meta}))
+	assert.Nil(t, p.gatewayInformer.GetIndexer().Add(&gatewayv1beta1.Gateway{ObjectMeta: meta}))
+
+	for _, kind := range []string{"HTTPRoute", "GRPCRoute", "TLSRoute", "TCPRoute", "UDPRoute", "Gateway"} {
+		_, err := p.ListManifestResources(kind)
+		assert.Nil(t, err, kind)
+	}
+	objs, err := p.ListManifestResources("GRPCRoute")
+	assert.Nil(t, err)
+	assert.Len(t, objs, 1)
+
+	_, err = p.ListManifestResources("ReferenceGrant")
+	assert.NotNil(t, err)
+}
+
+func TestTranslateManifestsGatewayOfOtherClass(t *testing.T) {
+	p := newManifestTestProvider()
+	gateway := &gatewayv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+		Spec: gatewayv1beta1.GatewaySpec{
+			GatewayClassName: "other",
+		},
+	}
+	assert.Nil(t, p.gatewayInformer.GetIndexer().Add(gateway))
+
+	m := &utils.Manifest{}
+	assert.Nil(t, p.TranslateManifests("Gateway", "", m))
+	assert.Len(t, m.SSLs, 0)
+	assert.Len(t, m.Routes, 0)
+}
+
+func TestOwnedManifestAfterReferenceGrantRevoked(t *testing.T) {
+	c, err := apisixcache.NewMemDBCache()
+	assert.Nil(t, err)
+
+	newRoute := func(id, upstreamID string) *apisixv1.Route {
+		r := apisixv1.NewDefaultRoute()
+		r.ID = id
+		r.Name = "default_httpbin_" + id
+		r.UpstreamId = upstreamID
+		r.Labels = utils.OwnerLabels(r.Labels, "leader", "HTTPRoute", "default/httpbin")
+		return r
+	}
+	newUpstream := func(id, key string) *apisixv1.Upstream {
+		u := apisixv1.NewDefaultUpstream()
+		u.ID = id
+		u.Name = id
+		u.Labels = utils.OwnerLabels(u.Labels, "leader", "HTTPRoute", key)
+		return u
+	}
+	// The second rule of the HTTPRoute refers to a Service in another
+	// namespace, which was permitted by a ReferenceGrant.
+	local := newUpstream("local", "default/httpbin")
+	cross := newUpstream("cross", "default/httpbin")
+	other := newUpstream("other", "default/other")
+	for _, u := range []*apisixv1.Upstream{local, cross, other} {
+		assert.Nil(t, c.InsertUpstream(u))
+	}
+	for _, r := range []*apisixv1.Route{newRoute("0", "local"), newRoute("1", "cross")} {
+		assert.Nil(t, c.InsertRoute(r))
+	}
+
+	om, err := ownedManifest(c, "leader", "HTTPRoute", "default/httpbin")
+	assert.Nil(t, err)
+	assert.Len(t, om.Routes, 2)
+	assert.ElementsMatch(t, []*apisixv1.Upstream{local, cross}, om.Upstreams)
+
+	// After the ReferenceGrant is revoked, the second rule is ignored.
+	m := &utils.Manifest{
+		Routes:    []*apisixv1.Route{newRoute("0", "local")},
+		Upstreams: []*apisixv1.Upstream{newUpstream("local", "default/httpbin")},
+	}
+	_, _, deleted := m.Diff(om)
+	assert.Len(t, deleted.Routes, 1)
+	assert.Equal(t, "1", deleted.Routes[0].ID)
+	assert.Len(t, deleted.Upstreams, 1)
+	assert.Equal(t, "cross", deleted.Upstreams[0].ID)
+}
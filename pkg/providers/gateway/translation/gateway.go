@@ -17,13 +17,19 @@
 package translation
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
+	"fmt"
 
 	"go.uber.org/zap"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/apache/apisix-ingress-controller/pkg/id"
 	"github.com/apache/apisix-ingress-controller/pkg/log"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/types"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
 const (
@@ -31,6 +37,7 @@ const (
 	kindTCPRoute  gatewayv1beta1.Kind = "TCPRoute"
 	kindTLSRoute  gatewayv1beta1.Kind = "TLSRoute"
 	kindHTTPRoute gatewayv1beta1.Kind = "HTTPRoute"
+	kindGRPCRoute gatewayv1beta1.Kind = "GRPCRoute"
 )
 
 func (t *translator) TranslateGatewayV1beta1(gateway *gatewayv1beta1.Gateway) (map[string]*types.ListenerConf, error) {
@@ -61,6 +68,14 @@ func (t *translator) TranslateGatewayV1beta1(gateway *gatewayv1beta1.Gateway) (m
 			)
 			continue
 		}
+		if !CertificateRefsPermitted(t, gateway, listener) {
+			log.Warnw("ignore listener whose certificateRefs are not permitted by any ReferenceGrant",
+				zap.String("gateway", gateway.Name),
+				zap.String("namespace", gateway.Namespace),
+				zap.Int("listener_index", i),
+			)
+			continue
+		}
 
 		conf := &types.ListenerConf{
 			Namespace:      gateway.Namespace,
@@ -68,6 +83,7 @@ func (t *translator) TranslateGatewayV1beta1(gateway *gatewayv1beta1.Gateway) (m
 			SectionName:    string(listener.Name),
 			Protocol:       listener.Protocol,
 			Port:           listener.Port,
+			Hostname:       listener.Hostname,
 			RouteNamespace: nil,
 			AllowedKinds:   allowedKinds,
 		}
@@ -82,6 +98,75 @@ func (t *translator) TranslateGatewayV1beta1(gateway *gatewayv1beta1.Gateway) (m
 	return listeners, nil
 }
 
+func (t *translator) TranslateGatewayListenerSSLV1beta1(gateway *gatewayv1beta1.Gateway, listener gatewayv1beta1.Listener) (*apisixv1.Ssl, error) {
+	if listener.TLS == nil || len(listener.TLS.CertificateRefs) == 0 {
+		return nil, nil
+	}
+	// The mode is Terminate by default
+	if listener.TLS.Mode != nil && *listener.TLS.Mode != gatewayv1beta1.TLSModeTerminate {
+		return nil, nil
+	}
+
+	// Only the first certificateRef takes effect
+	ref := listener.TLS.CertificateRefs[0]
+	if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Secret") {
+		return nil, fmt.Errorf("unsupported kind of certificateRef %s", ref.Name)
+	}
+	ns := gateway.Namespace
+	if ref.Namespace != nil {
+		ns = string(*ref.Namespace)
+	}
+	secret, err := t.SecretLister.Secrets(ns).Get(string(ref.Name))
+	if err != nil {
+		return nil, err
+	}
+	cert, key, err := translation.ExtractKeyPair(secret, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var snis []string
+	if listener.Hostname != nil && *listener.Hostname != "" {
+		snis = []string{string(*listener.Hostname)}
+	} else {
+		// The listener accepts all the hostnames, so the ones in the
+		// certificate are used.
+		snis, err = certificateDNSNames(cert)
+		if err != nil {
+			return nil, err
+		}
+		if len(snis) == 0 {
+			return nil, fmt.Errorf("no hostname is specified by the listener or the certificate %s/%s", ns, ref.Name)
+		}
+	}
+
+	return &apisixv1.Ssl{
+		ID:     id.GenID(gateway.Namespace + "_" + gateway.Name + "_" + string(listener.Name)),
+		Snis:   snis,
+		Cert:   string(cert),
+		Key:    string(key),
+		Status: 1,
+		Labels: map[string]string{
+			translation.MetaSecretNamespace: ns,
+			translation.MetaSecretName:      string(ref.Name),
+			"managed-by":                    "apisix-ingress-controller",
+		},
+	}, nil
+}
+
+// certificateDNSNames returns the DNS names in the PEM encoded certificate.
+func certificateDNSNames(cert []byte) ([]string, error) {
+	block, _ := pem.Decode(cert)
+	if block == nil {
+		return nil, errors.New("failed to decode the certificate")
+	}
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return c.DNSNames, nil
+}
+
 func validateListenerConfigurations(gateway *gatewayv1beta1.Gateway, idx int, allowedKinds []gatewayv1beta1.RouteGroupKind,
 	listener gatewayv1beta1.Listener) error {
 	// Check protocols and allowedKinds
@@ -92,8 +177,10 @@ func validateListenerConfigurations(gateway *gatewayv1beta1.Gateway, idx int, al
 			return errors.New("non-empty TLS conf for protocol " + string(protocol))
 		}
 		if protocol == gatewayv1beta1.HTTPProtocolType {
-			if len(allowedKinds) != 1 || allowedKinds[0].Kind != kindHTTPRoute {
-				return errors.New("HTTP protocol must allow route type HTTPRoute")
+			for _, kind := range allowedKinds {
+				if kind.Kind != kindHTTPRoute && kind.Kind != kindGRPCRoute {
+					return errors.New("HTTP protocol only support route type HTTPRoute and GRPCRoute")
+				}
 			}
 		} else if protocol == gatewayv1beta1.TCPProtocolType {
 			if len(allowedKinds) != 1 || allowedKinds[0].Kind != kindTCPRoute {
@@ -137,8 +224,10 @@ func validateListenerConfigurations(gateway *gatewayv1beta1.Gateway, idx int, al
 			if *listener.TLS.Mode != gatewayv1beta1.TLSModeTerminate {
 				return errors.New("TLS mode for HTTPS protocol must be Terminate")
 			}
-			if len(allowedKinds) != 1 || allowedKinds[0].Kind != kindHTTPRoute {
-				return errors.New("HTTP protocol must allow route type HTTPRoute")
+			for _, kind := range allowedKinds {
+				if kind.Kind != kindHTTPRoute && kind.Kind != kindGRPCRoute {
+					return errors.New("HTTPS protocol only support route type HTTPRoute and GRPCRoute")
+				}
 			}
 		} else if protocol == gatewayv1beta1.TLSProtocolType {
 			for _, kind := range allowedKinds {
@@ -156,25 +245,25 @@ func getAllowedKinds(listener gatewayv1beta1.Listener) ([]gatewayv1beta1.RouteGr
 	var expectedKinds []gatewayv1beta1.RouteGroupKind
 	group := gatewayv1beta1.Group(gatewayv1beta1.GroupName)
 
-	var kind gatewayv1beta1.Kind
+	var kinds []gatewayv1beta1.Kind
 	switch listener.Protocol {
 	case gatewayv1beta1.HTTPProtocolType, gatewayv1beta1.HTTPSProtocolType:
-		kind = kindHTTPRoute
+		kinds = []gatewayv1beta1.Kind{kindHTTPRoute, kindGRPCRoute}
 	case gatewayv1beta1.TLSProtocolType:
-		kind = kindTLSRoute
+		kinds = []gatewayv1beta1.Kind{kindTLSRoute}
 	case gatewayv1beta1.TCPProtocolType:
-		kind = kindTCPRoute
+		kinds = []gatewayv1beta1.Kind{kindTCPRoute}
 	case gatewayv1beta1.UDPProtocolType:
-		kind = kindUDPRoute
+		kinds = []gatewayv1beta1.Kind{kindUDPRoute}
 	default:
 		return nil, errors.New("unknown protocol " + string(listener.Protocol))
 	}
 
-	expectedKinds = []gatewayv1beta1.RouteGroupKind{
-		{
+	for _, kind := range kinds {
+		expectedKinds = append(expectedKinds, gatewayv1beta1.RouteGroupKind{
 			Group: &group,
 			Kind:  kind,
-		},
+		})
 	}
 
 	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
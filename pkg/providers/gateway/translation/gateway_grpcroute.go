@@ -0,0 +1,273 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+// generatePluginsFromGRPCRouteFilter translates the GRPCRoute filters, which
+// share the definitions with the HTTPRoute ones.
+func (t *translator) generatePluginsFromGRPCRouteFilter(from gatewayv1beta1.ReferenceGrantFrom, filters []gatewayv1alpha2.GRPCRouteFilter) apisixv1.Plugins {
+	httpFilters := make([]gatewayv1beta1.HTTPRouteFilter, 0, len(filters))
+	for _, filter := range filters {
+		httpFilters = append(httpFilters, gatewayv1beta1.HTTPRouteFilter{
+			Type:                   gatewayv1beta1.HTTPRouteFilterType(filter.Type),
+			RequestHeaderModifier:  filter.RequestHeaderModifier,
+			ResponseHeaderModifier: filter.ResponseHeaderModifier,
+			RequestMirror:          filter.RequestMirror,
+			ExtensionRef:           filter.ExtensionRef,
+		})
+	}
+	return t.generatePluginsFromHTTPRouteFilter(from, httpFilters, nil)
+}
+
+func (t *translator) TranslateGatewayGRPCRouteV1Alpha2(grpcRoute *gatewayv1alpha2.GRPCRoute) (*translation.TranslateContext, error) {
+	ctx := translation.DefaultEmptyTranslateContext()
+
+	var hosts []string
+	for _, hostname := range grpcRoute.Spec.Hostnames {
+		hosts = append(hosts, string(hostname))
+	}
+
+	for i, rule := range grpcRoute.Spec.Rules {
+		backends := rule.BackendRefs
+		if len(backends) == 0 {
+			continue
+		}
+
+		var ruleUpstreams []*apisixv1.Upstream
+		var weightedUpstreams []apisixv1.TrafficSplitConfigRuleWeightedUpstream
+
+		filters := rule.Filters
+		for j, backend := range backends {
+			// APISIX applies plugins to routes rather than upstreams, so the
+			// filters of a backend can only be honored if it's the only one.
+			if len(backend.Filters) > 0 {
+				if len(backends) == 1 {
+					filters = append(append([]gatewayv1alpha2.GRPCRouteFilter{}, rule.Filters...), backend.Filters...)
+				} else {
+					log.Warnw(fmt.Sprintf("ignore filters at Rules[%v].BackendRefs[%v] since the rule has multiple backends", i, j),
+						zap.Any("filters", backend.Filters),
+					)
+				}
+			}
+
+			var kind string
+			if backend.Kind == nil {
+				kind = "service"
+			} else {
+				kind = strings.ToLower(string(*backend.Kind))
+			}
+			if kind != "service" {
+				log.Warnw(fmt.Sprintf("ignore non-service kind at Rules[%v].BackendRefs[%v]", i, j),
+					zap.String("kind", kind),
+				)
+				continue
+			}
+
+			var ns string
+			if backend.Namespace == nil {
+				ns = grpcRoute.Namespace
+			} else {
+				ns = string(*backend.Namespace)
+			}
+			if !t.IsReferenceGrantedV1beta1(referenceFrom(kindGRPCRoute, grpcRoute.Namespace), referenceToService(backend.Name), ns) {
+				log.Warnw(fmt.Sprintf("ignore Rules[%v].BackendRefs[%v] which is not permitted by any ReferenceGrant", i, j),
+					zap.String("namespace", ns),
+				)
+				continue
+			}
+
+			if backend.Port == nil {
+				log.Warnw(fmt.Sprintf("ignore nil port at Rules[%v].BackendRefs[%v]", i, j),
+					zap.String("kind", kind),
+				)
+				continue
+			}
+
+			ups, err := t.KubeTranslator.TranslateService(ns, string(backend.Name), "", int32(*backend.Port))
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to translate Rules[%v].BackendRefs[%v]", i, j))
+			}
+			// Backends are proxied with the grpc scheme, unless TLS is required
+			// by the ApisixUpstream of the Service.
+			if ups.Scheme == apisixv1.SchemeGRPCS || ups.Scheme == apisixv1.SchemeHTTPS {
+				ups.Scheme = apisixv1.SchemeGRPCS
+			} else {
+				ups.Scheme = apisixv1.SchemeGRPC
+			}
+			// The scheme is a part of the name, so that the upstream doesn't
+			// collide with the one of an HTTPRoute using the same Service port.
+			name := apisixv1.ComposeGRPCUpstreamName(
+				apisixv1.ComposeUpstreamName(ns, string(backend.Name), "", int32(*backend.Port), types.ResolveGranularity.Endpoint),
+				ups.Scheme,
+			)
+
+			// APISIX limits max length of label value
+			// https://github.com/apache/apisix/blob/5b95b85faea3094d5e466ee2d39a52f1f805abbb/apisix/schema_def.lua#L85
+			ups.Labels["meta_namespace"] = utils.TruncateString(ns, 64)
+			ups.Labels["meta_backend"] = utils.TruncateString(string(backend.Name), 64)
+			ups.Labels["meta_port"] = fmt.Sprintf("%v", int32(*backend.Port))
+
+			ups.Name = name
+			ups.ID = id.GenID(name)
+			ctx.AddUpstream(ups)
+			ruleUpstreams = append(ruleUpstreams, ups)
+
+			weight := 1 // 1 is default value of BackendRef
+			if backend.Weight != nil {
+				weight = int(*backend.Weight)
+			}
+			weightedUpstreams = append(weightedUpstreams, apisixv1.TrafficSplitConfigRuleWeightedUpstream{
+				UpstreamID: ups.ID,
+				Weight:     weight,
+			})
+		}
+		if len(ruleUpstreams) == 0 {
+			log.Warnw(fmt.Sprintf("ignore all-failed backend refs at Rules[%v]", i),
+				zap.Any("BackendRefs", rule.BackendRefs),
+			)
+			continue
+		}
+
+		matches := rule.Matches
+		if len(matches) == 0 {
+			// Match all the gRPC services and methods.
+			matches = []gatewayv1alpha2.GRPCRouteMatch{{}}
+		}
+
+		for j, match := range matches {
+			route, err := t.translateGatewayGRPCRouteMatch(&match)
+			if err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("failed to translate Rules[%v].Matches[%v]", i, j))
+			}
+
+			name := apisixv1.ComposeRouteName(grpcRoute.Namespace, grpcRoute.Name, fmt.Sprintf("%d-%d", i, j))
+			route.ID = id.GenID(name)
+			route.Hosts = hosts
+			route.Plugins = t.generatePluginsFromGRPCRouteFilter(referenceFrom(kindGRPCRoute, grpcRoute.Namespace), filters)
+
+			// Bind Upstream
+			if len(ruleUpstreams) == 1 {
+				route.UpstreamId = ruleUpstreams[0].ID
+			} else {
+				route.Plugins["traffic-split"] = &apisixv1.TrafficSplitConfig{
+					Rules: []apisixv1.TrafficSplitConfigRule{
+						{
+							WeightedUpstreams: weightedUpstreams,
+						},
+					},
+				}
+			}
+
+			ctx.AddRoute(route)
+		}
+	}
+
+	return ctx, nil
+}
+
+// translateGatewayGRPCRouteMatch translates the match to an APISIX route, the
+// path of a gRPC request is in the form of "/<service>/<method>".
+func (t *translator) translateGatewayGRPCRouteMatch(match *gatewayv1alpha2.GRPCRouteMatch) (*apisixv1.Route, error) {
+	route := apisixv1.NewDefaultRoute()
+	route.Uri = "/*"
+
+	if match.Method != nil {
+		matchType := gatewayv1alpha2.GRPCMethodMatchExact
+		if match.Method.Type != nil {
+			matchType = *match.Method.Type
+		}
+		var service, method string
+		if match.Method.Service != nil {
+			service = *match.Method.Service
+		}
+		if match.Method.Method != nil {
+			method = *match.Method.Method
+		}
+
+		switch matchType {
+		case gatewayv1alpha2.GRPCMethodMatchExact:
+			if service != "" && method != "" {
+				route.Uri = "/" + service + "/" + method
+			} else if service != "" {
+				route.Uri = "/" + service + "/*"
+			} else if method != "" {
+				route.Vars = append(route.Vars, []apisixv1.StringOrSlice{
+					{StrVal: "uri"},
+					{StrVal: "~~"},
+					{StrVal: "^/[^/]+/" + regexp.QuoteMeta(method) + "$"},
+				})
+			}
+		case gatewayv1alpha2.GRPCMethodMatchRegularExpression:
+			if service == "" {
+				service = "[^/]+"
+			}
+			if method == "" {
+				method = "[^/]+"
+			}
+			route.Vars = append(route.Vars, []apisixv1.StringOrSlice{
+				{StrVal: "uri"},
+				{StrVal: "~~"},
+				{StrVal: "^/(" + service + ")/(" + method + ")$"},
+			})
+		default:
+			return nil, errors.New("unknown method match type " + string(matchType))
+		}
+	}
+
+	for _, header := range match.Headers {
+		name := strings.ToLower(string(header.Name))
+		name = strings.ReplaceAll(name, "-", "_")
+
+		matchType := gatewayv1beta1.HeaderMatchExact
+		if header.Type != nil {
+			matchType = *header.Type
+		}
+		var op string
+		switch matchType {
+		case gatewayv1beta1.HeaderMatchExact:
+			op = "=="
+		case gatewayv1beta1.HeaderMatchRegularExpression:
+			op = "~~"
+		default:
+			return nil, errors.New("unknown header match type " + string(matchType))
+		}
+		route.Vars = append(route.Vars, []apisixv1.StringOrSlice{
+			{StrVal: "http_" + name},
+			{StrVal: op},
+			{StrVal: header.Value},
+		})
+	}
+
+	return route, nil
+}
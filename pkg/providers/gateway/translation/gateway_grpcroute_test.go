@@ -0,0 +1,156 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestTranslateGatewayGRPCRoute(t *testing.T) {
+	refStr := func(str string) *string {
+		return &str
+	}
+	refInt32 := func(i int32) *int32 {
+		return &i
+	}
+	refPortNumber := func(i gatewayv1beta1.PortNumber) *gatewayv1beta1.PortNumber {
+		return &i
+	}
+	refMethodMatchType := func(str gatewayv1alpha2.GRPCMethodMatchType) *gatewayv1alpha2.GRPCMethodMatchType {
+		return &str
+	}
+
+	tr, processCh := mockHTTPRouteTranslator(t)
+	<-processCh
+	<-processCh
+
+	grpcRoute := &gatewayv1alpha2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "grpc_route",
+			Namespace: "test",
+		},
+		Spec: gatewayv1alpha2.GRPCRouteSpec{
+			Hostnames: []gatewayv1alpha2.Hostname{
+				"grpc.example.com",
+			},
+			Rules: []gatewayv1alpha2.GRPCRouteRule{
+				{
+					Matches: []gatewayv1alpha2.GRPCRouteMatch{
+						{
+							Method: &gatewayv1alpha2.GRPCMethodMatch{
+								Service: refStr("helloworld.Greeter"),
+								Method:  refStr("SayHello"),
+							},
+							Headers: []gatewayv1alpha2.GRPCHeaderMatch{
+								{
+									Name:  "X-Version",
+									Value: "v1",
+								},
+							},
+						},
+						{
+							Method: &gatewayv1alpha2.GRPCMethodMatch{
+								Service: refStr("helloworld.Greeter"),
+							},
+						},
+						{
+							Method: &gatewayv1alpha2.GRPCMethodMatch{
+								Method: refStr("SayHello"),
+							},
+						},
+						{
+							Method: &gatewayv1alpha2.GRPCMethodMatch{
+								Type:    refMethodMatchType(gatewayv1alpha2.GRPCMethodMatchRegularExpression),
+								Service: refStr("helloworld\\..+"),
+							},
+						},
+					},
+					BackendRefs: []gatewayv1alpha2.GRPCBackendRef{
+						{
+							BackendRef: gatewayv1beta1.BackendRef{
+								BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+									Name: "svc",
+									Port: refPortNumber(80),
+								},
+							},
+						},
+					},
+				},
+				{
+					BackendRefs: []gatewayv1alpha2.GRPCBackendRef{
+						{
+							BackendRef: gatewayv1beta1.BackendRef{
+								BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+									Name: "svc",
+									Port: refPortNumber(80),
+								},
+								Weight: refInt32(80),
+							},
+						},
+						{
+							BackendRef: gatewayv1beta1.BackendRef{
+								BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+									Name: "svc",
+									Port: refPortNumber(443),
+								},
+								Weight: refInt32(20),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tctx, err := tr.TranslateGatewayGRPCRouteV1Alpha2(grpcRoute)
+	assert.Nil(t, err)
+	assert.Len(t, tctx.Routes, 5)
+	assert.Len(t, tctx.Upstreams, 2)
+
+	for _, u := range tctx.Upstreams {
+		assert.Equal(t, apisixv1.SchemeGRPC, u.Scheme)
+		assert.Len(t, u.Nodes, 2)
+	}
+
+	r := tctx.Routes[0]
+	assert.Equal(t, []string{"grpc.example.com"}, r.Hosts)
+	assert.Equal(t, "/helloworld.Greeter/SayHello", r.Uri)
+	assert.Equal(t, []apisixv1.StringOrSlice{{StrVal: "http_x_version"}, {StrVal: "=="}, {StrVal: "v1"}}, r.Vars[0])
+	assert.Equal(t, tctx.Upstreams[0].ID, r.UpstreamId)
+
+	assert.Equal(t, "/helloworld.Greeter/*", tctx.Routes[1].Uri)
+
+	assert.Equal(t, "/*", tctx.Routes[2].Uri)
+	assert.Equal(t, []apisixv1.StringOrSlice{{StrVal: "uri"}, {StrVal: "~~"}, {StrVal: "^/[^/]+/SayHello$"}}, tctx.Routes[2].Vars[0])
+
+	assert.Equal(t, []apisixv1.StringOrSlice{{StrVal: "uri"}, {StrVal: "~~"}, {StrVal: "^/(helloworld\\..+)/([^/]+)$"}}, tctx.Routes[3].Vars[0])
+
+	// weighted backend refs
+	r = tctx.Routes[4]
+	assert.Equal(t, "/*", r.Uri)
+	assert.Empty(t, r.UpstreamId)
+	ts := r.Plugins["traffic-split"].(*apisixv1.TrafficSplitConfig)
+	assert.Equal(t, []apisixv1.TrafficSplitConfigRuleWeightedUpstream{
+		{UpstreamID: tctx.Upstreams[0].ID, Weight: 80},
+		{UpstreamID: tctx.Upstreams[1].ID, Weight: 20},
+	}, ts.Rules[0].WeightedUpstreams)
+}
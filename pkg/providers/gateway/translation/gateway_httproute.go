@@ -18,6 +18,7 @@ package translation
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -32,7 +33,10 @@ import (
 	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
-func (t *translator) generatePluginsFromHTTPRouteFilter(namespace string, filters []gatewayv1beta1.HTTPRouteFilter) apisixv1.Plugins {
+// generatePluginsFromHTTPRouteFilter translates the filters of the route from
+// applied to the requests matched by match, which is nil if the filters don't
+// depend on it.
+func (t *translator) generatePluginsFromHTTPRouteFilter(from gatewayv1beta1.ReferenceGrantFrom, filters []gatewayv1beta1.HTTPRouteFilter, match *gatewayv1beta1.HTTPRouteMatch) apisixv1.Plugins {
 	plugins := apisixv1.Plugins{}
 	for _, filter := range filters {
 		switch filter.Type {
@@ -41,45 +45,131 @@ func (t *translator) generatePluginsFromHTTPRouteFilter(namespace string, filter
 		case gatewayv1beta1.HTTPRouteFilterRequestRedirect:
 			t.generatePluginFromHTTPRequestRedirectFilter(plugins, filter.RequestRedirect)
 		case gatewayv1beta1.HTTPRouteFilterRequestMirror:
-			t.generatePluginFromHTTPRequestMirrorFilter(namespace, plugins, filter.RequestMirror)
+			t.generatePluginFromHTTPRequestMirrorFilter(from, plugins, filter.RequestMirror)
 		case gatewayv1beta1.HTTPRouteFilterURLRewrite:
-			// TODO: Supported, to be implemented
+			t.generatePluginFromHTTPURLRewriteFilter(plugins, filter.URLRewrite, match)
 		case gatewayv1beta1.HTTPRouteFilterResponseHeaderModifier:
-			// TODO: Supported, to be implemented
+			t.generatePluginFromHTTPResponseHeaderFilter(plugins, filter.ResponseHeaderModifier)
 		}
 	}
 	return plugins
 }
 
+// proxyRewriteConfig returns the config of the proxy-rewrite plugin, which is
+// shared by the request header modifier and the URL rewrite filters.
+func proxyRewriteConfig(plugins apisixv1.Plugins) *apisixv1.RewriteConfig {
+	if cfg, ok := plugins["proxy-rewrite"].(*apisixv1.RewriteConfig); ok {
+		return cfg
+	}
+	cfg := &apisixv1.RewriteConfig{}
+	plugins["proxy-rewrite"] = cfg
+	return cfg
+}
+
 func (t *translator) generatePluginFromHTTPRequestHeaderFilter(plugins apisixv1.Plugins, reqHeaderModifier *gatewayv1beta1.HTTPHeaderFilter) {
 	if reqHeaderModifier == nil {
 		return
 	}
-	headers := map[string]any{}
-	// TODO: The current apisix plugin does not conform to the specification.
-	for _, header := range reqHeaderModifier.Add {
-		headers[string(header.Name)] = header.Value
+	headers := apisixv1.Headers{}
+	if len(reqHeaderModifier.Add) > 0 {
+		add := make(map[string]string, len(reqHeaderModifier.Add))
+		for _, header := range reqHeaderModifier.Add {
+			add[string(header.Name)] = header.Value
+		}
+		headers["add"] = add
+	}
+	if len(reqHeaderModifier.Set) > 0 {
+		set := make(map[string]string, len(reqHeaderModifier.Set))
+		for _, header := range reqHeaderModifier.Set {
+			set[string(header.Name)] = header.Value
+		}
+		headers["set"] = set
+	}
+	if len(reqHeaderModifier.Remove) > 0 {
+		headers["remove"] = reqHeaderModifier.Remove
+	}
+
+	proxyRewriteConfig(plugins).Headers = headers
+}
+
+func (t *translator) generatePluginFromHTTPResponseHeaderFilter(plugins apisixv1.Plugins, respHeaderModifier *gatewayv1beta1.HTTPHeaderFilter) {
+	if respHeaderModifier == nil {
+		return
+	}
+	headers := apisixv1.Headers{}
+	if len(respHeaderModifier.Add) > 0 {
+		// The response-rewrite plugin accepts the headers to add in the form
+		// of "name: value".
+		add := make([]string, 0, len(respHeaderModifier.Add))
+		for _, header := range respHeaderModifier.Add {
+			add = append(add, fmt.Sprintf("%s: %s", header.Name, header.Value))
+		}
+		headers["add"] = add
 	}
-	for _, header := range reqHeaderModifier.Set {
-		headers[string(header.Name)] = header.Value
+	if len(respHeaderModifier.Set) > 0 {
+		set := make(map[string]string, len(respHeaderModifier.Set))
+		for _, header := range respHeaderModifier.Set {
+			set[string(header.Name)] = header.Value
+		}
+		headers["set"] = set
 	}
-	for _, header := range reqHeaderModifier.Remove {
-		headers[header] = ""
+	if len(respHeaderModifier.Remove) > 0 {
+		headers["remove"] = respHeaderModifier.Remove
 	}
 
-	plugins["proxy-rewrite"] = apisixv1.RewriteConfig{
+	plugins["response-rewrite"] = &apisixv1.ResponseRewriteConfig{
 		Headers: headers,
 	}
 }
 
-func (t *translator) generatePluginFromHTTPRequestMirrorFilter(namespace string, plugins apisixv1.Plugins, reqMirror *gatewayv1beta1.HTTPRequestMirrorFilter) {
+func (t *translator) generatePluginFromHTTPURLRewriteFilter(plugins apisixv1.Plugins, urlRewrite *gatewayv1beta1.HTTPURLRewriteFilter, match *gatewayv1beta1.HTTPRouteMatch) {
+	if urlRewrite == nil {
+		return
+	}
+	cfg := proxyRewriteConfig(plugins)
+	if urlRewrite.Hostname != nil {
+		cfg.Host = string(*urlRewrite.Hostname)
+	}
+	if urlRewrite.Path == nil {
+		return
+	}
+
+	switch urlRewrite.Path.Type {
+	case gatewayv1beta1.FullPathHTTPPathModifier:
+		if urlRewrite.Path.ReplaceFullPath != nil {
+			cfg.RewriteTarget = *urlRewrite.Path.ReplaceFullPath
+		}
+	case gatewayv1beta1.PrefixMatchHTTPPathModifier:
+		if urlRewrite.Path.ReplacePrefixMatch == nil {
+			return
+		}
+		if match == nil || match.Path == nil || match.Path.Type == nil || *match.Path.Type != gatewayv1beta1.PathMatchPathPrefix {
+			log.Warnw("ignore ReplacePrefixMatch since the path match type is not PathPrefix",
+				zap.Any("match", match),
+			)
+			return
+		}
+		prefix := strings.TrimSuffix(*match.Path.Value, "/")
+		replacement := strings.TrimSuffix(*urlRewrite.Path.ReplacePrefixMatch, "/")
+		// The prefix is matched by path elements, e.g. the prefix /foo matches
+		// /foo and /foo/bar but not /foobar, and the path is kept absolute
+		// when the replacement is /.
+		if replacement == "" {
+			cfg.RewriteTargetRegex = []string{"^" + regexp.QuoteMeta(prefix) + "/?(.*)$", "/$1"}
+		} else {
+			cfg.RewriteTargetRegex = []string{"^" + regexp.QuoteMeta(prefix) + "(/.*)?$", replacement + "$1"}
+		}
+	}
+}
+
+func (t *translator) generatePluginFromHTTPRequestMirrorFilter(from gatewayv1beta1.ReferenceGrantFrom, plugins apisixv1.Plugins, reqMirror *gatewayv1beta1.HTTPRequestMirrorFilter) {
 	if reqMirror == nil {
 		return
 	}
 
 	var (
 		port int    = 80
-		ns   string = namespace
+		ns   string = string(from.Namespace)
 	)
 	if reqMirror.BackendRef.Port != nil {
 		port = int(*reqMirror.BackendRef.Port)
@@ -87,6 +177,13 @@ func (t *translator) generatePluginFromHTTPRequestMirrorFilter(namespace string,
 	if reqMirror.BackendRef.Namespace != nil {
 		ns = string(*reqMirror.BackendRef.Namespace)
 	}
+	if !t.IsReferenceGrantedV1beta1(from, referenceToService(reqMirror.BackendRef.Name), ns) {
+		log.Warnw("ignore request mirror filter which is not permitted by any ReferenceGrant",
+			zap.String("namespace", ns),
+			zap.String("backend", string(reqMirror.BackendRef.Name)),
+		)
+		return
+	}
 	// TODO 1: Need to support https.
 	// TODO 2: https://github.com/apache/apisix/issues/8351 APISIX 3.0 support {service.namespace} and {service.namespace.svc}, but APISIX <= 2.15 version is not supported.
 	host := fmt.Sprintf("http://%s.%s.svc.cluster.local:%d", reqMirror.BackendRef.Name, ns, port)
@@ -157,9 +254,20 @@ func (t *translator) TranslateGatewayHTTPRouteV1beta1(httpRoute *gatewayv1beta1.
 		var ruleUpstreams []*apisixv1.Upstream
 		var weightedUpstreams []apisixv1.TrafficSplitConfigRuleWeightedUpstream
 
+		filters := rule.Filters
 		for j, backend := range backends {
-			//TODO: Support filters
-			//filters := backend.Filters
+			// APISIX applies plugins to routes rather than upstreams, so the
+			// filters of a backend can only be honored if it's the only one.
+			if len(backend.Filters) > 0 {
+				if len(backends) == 1 {
+					filters = append(append([]gatewayv1beta1.HTTPRouteFilter{}, rule.Filters...), backend.Filters...)
+				} else {
+					log.Warnw(fmt.Sprintf("ignore filters at Rules[%v].BackendRefs[%v] since the rule has multiple backends", i, j),
+						zap.Any("filters", backend.Filters),
+					)
+				}
+			}
+
 			var kind string
 			if backend.Kind == nil {
 				kind = "service"
@@ -179,9 +287,12 @@ func (t *translator) TranslateGatewayHTTPRouteV1beta1(httpRoute *gatewayv1beta1.
 			} else {
 				ns = string(*backend.Namespace)
 			}
-			//if ns != httpRoute.Namespace {
-			// TODO: check gatewayv1beta1.ReferencePolicy
-			//}
+			if !t.IsReferenceGrantedV1beta1(referenceFrom(kindHTTPRoute, httpRoute.Namespace), referenceToService(backend.Name), ns) {
+				log.Warnw(fmt.Sprintf("ignore Rules[%v].BackendRefs[%v] which is not permitted by any ReferenceGrant", i, j),
+					zap.String("namespace", ns),
+				)
+				continue
+			}
 
 			if backend.Port == nil {
 				log.Warnw(fmt.Sprintf("ignore nil port at Rules[%v].BackendRefs[%v]", i, j),
@@ -238,8 +349,6 @@ func (t *translator) TranslateGatewayHTTPRouteV1beta1(httpRoute *gatewayv1beta1.
 				},
 			}
 		}
-		plugins := t.generatePluginsFromHTTPRouteFilter(httpRoute.Namespace, rule.Filters)
-
 		for j, match := range matches {
 			route, err := t.translateGatewayHTTPRouteMatch(&match)
 			if err != nil {
@@ -249,7 +358,7 @@ func (t *translator) TranslateGatewayHTTPRouteV1beta1(httpRoute *gatewayv1beta1.
 			name := apisixv1.ComposeRouteName(httpRoute.Namespace, httpRoute.Name, fmt.Sprintf("%d-%d", i, j))
 			route.ID = id.GenID(name)
 			route.Hosts = hosts
-			route.Plugins = plugins
+			route.Plugins = t.generatePluginsFromHTTPRouteFilter(referenceFrom(kindHTTPRoute, httpRoute.Namespace), filters, &match)
 
 			// Bind Upstream
 			if len(ruleUpstreams) == 1 {
@@ -266,9 +375,6 @@ func (t *translator) TranslateGatewayHTTPRouteV1beta1(httpRoute *gatewayv1beta1.
 
 			ctx.AddRoute(route)
 		}
-
-		//TODO: Support filters
-		//filters := rule.Filters
 	}
 
 	return ctx, nil
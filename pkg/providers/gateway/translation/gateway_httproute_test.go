@@ -411,4 +411,184 @@ func TestTranslateGatewayHTTPRouteRegexMatch(t *testing.T) {
 	assert.Equal(t, 9080, u.Nodes[0].Port)
 }
 
+func TestTranslateGatewayHTTPRouteFilters(t *testing.T) {
+	refStr := func(str string) *string {
+		return &str
+	}
+	refPathMatchType := func(str gatewayv1beta1.PathMatchType) *gatewayv1beta1.PathMatchType {
+		return &str
+	}
+	refPortNumber := func(i gatewayv1beta1.PortNumber) *gatewayv1beta1.PortNumber {
+		return &i
+	}
+	refHostname := func(str gatewayv1beta1.PreciseHostname) *gatewayv1beta1.PreciseHostname {
+		return &str
+	}
+
+	tr, processCh := mockHTTPRouteTranslator(t)
+	<-processCh
+	<-processCh
+
+	httpRoute := &gatewayv1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "http_route",
+			Namespace: "test",
+		},
+		Spec: gatewayv1beta1.HTTPRouteSpec{
+			Rules: []gatewayv1beta1.HTTPRouteRule{
+				{
+					Matches: []gatewayv1beta1.HTTPRouteMatch{
+						{
+							Path: &gatewayv1beta1.HTTPPathMatch{
+								Type:  refPathMatchType(gatewayv1beta1.PathMatchPathPrefix),
+								Value: refStr("/foo/"),
+							},
+						},
+						{
+							Path: &gatewayv1beta1.HTTPPathMatch{
+								Type:  refPathMatchType(gatewayv1beta1.PathMatchExact),
+								Value: refStr("/bar"),
+							},
+						},
+					},
+					Filters: []gatewayv1beta1.HTTPRouteFilter{
+						{
+							Type: gatewayv1beta1.HTTPRouteFilterRequestHeaderModifier,
+							RequestHeaderModifier: &gatewayv1beta1.HTTPHeaderFilter{
+								Add:    []gatewayv1beta1.HTTPHeader{{Name: "X-Add", Value: "add"}},
+								Set:    []gatewayv1beta1.HTTPHeader{{Name: "X-Set", Value: "set"}},
+								Remove: []string{"X-Remove"},
+							},
+						},
+						{
+							Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+							URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+								Hostname: refHostname("example.com"),
+								Path: &gatewayv1beta1.HTTPPathModifier{
+									Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+									ReplacePrefixMatch: refStr("/baz"),
+								},
+							},
+						},
+					},
+					BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+						{
+							BackendRef: gatewayv1beta1.BackendRef{
+								BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+									Name: "svc",
+									Port: refPortNumber(80),
+								},
+							},
+							Filters: []gatewayv1beta1.HTTPRouteFilter{
+								{
+									Type: gatewayv1beta1.HTTPRouteFilterResponseHeaderModifier,
+									ResponseHeaderModifier: &gatewayv1beta1.HTTPHeaderFilter{
+										Add:    []gatewayv1beta1.HTTPHeader{{Name: "X-Add", Value: "add"}},
+										Set:    []gatewayv1beta1.HTTPHeader{{Name: "X-Set", Value: "set"}},
+										Remove: []string{"X-Remove"},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Matches: []gatewayv1beta1.HTTPRouteMatch{
+						{
+							Path: &gatewayv1beta1.HTTPPathMatch{
+								Type:  refPathMatchType(gatewayv1beta1.PathMatchPathPrefix),
+								Value: refStr("/qux"),
+							},
+						},
+					},
+					Filters: []gatewayv1beta1.HTTPRouteFilter{
+						{
+							Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+							URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+								Path: &gatewayv1beta1.HTTPPathModifier{
+									Type:               gatewayv1beta1.PrefixMatchHTTPPathModifier,
+									ReplacePrefixMatch: refStr("/"),
+								},
+							},
+						},
+					},
+					BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+						{
+							BackendRef: gatewayv1beta1.BackendRef{
+								BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+									Name: "svc",
+									Port: refPortNumber(80),
+								},
+							},
+						},
+					},
+				},
+				{
+					Filters: []gatewayv1beta1.HTTPRouteFilter{
+						{
+							Type: gatewayv1beta1.HTTPRouteFilterURLRewrite,
+							URLRewrite: &gatewayv1beta1.HTTPURLRewriteFilter{
+								Path: &gatewayv1beta1.HTTPPathModifier{
+									Type:            gatewayv1beta1.FullPathHTTPPathModifier,
+									ReplaceFullPath: refStr("/full"),
+								},
+							},
+						},
+					},
+					BackendRefs: []gatewayv1beta1.HTTPBackendRef{
+						{
+							BackendRef: gatewayv1beta1.BackendRef{
+								BackendObjectReference: gatewayv1beta1.BackendObjectReference{
+									Name: "svc",
+									Port: refPortNumber(80),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tctx, err := tr.TranslateGatewayHTTPRouteV1beta1(httpRoute)
+	assert.Nil(t, err)
+	assert.Len(t, tctx.Routes, 4)
+
+	// prefix match with request and response header modifiers
+	r := tctx.Routes[0]
+	assert.Equal(t, &v1.RewriteConfig{
+		RewriteTargetRegex: []string{"^/foo(/.*)?$", "/baz$1"},
+		Host:               "example.com",
+		Headers: v1.Headers{
+			"add":    map[string]string{"X-Add": "add"},
+			"set":    map[string]string{"X-Set": "set"},
+			"remove": []string{"X-Remove"},
+		},
+	}, r.Plugins["proxy-rewrite"])
+	assert.Equal(t, &v1.ResponseRewriteConfig{
+		Headers: v1.Headers{
+			"add":    []string{"X-Add: add"},
+			"set":    map[string]string{"X-Set": "set"},
+			"remove": []string{"X-Remove"},
+		},
+	}, r.Plugins["response-rewrite"])
+
+	// ReplacePrefixMatch is ignored with exact match
+	r = tctx.Routes[1]
+	rewrite := r.Plugins["proxy-rewrite"].(*v1.RewriteConfig)
+	assert.Empty(t, rewrite.RewriteTargetRegex)
+	assert.Equal(t, "example.com", rewrite.Host)
+
+	r = tctx.Routes[2]
+	assert.Equal(t, &v1.RewriteConfig{
+		RewriteTargetRegex: []string{"^/qux/?(.*)$", "/$1"},
+	}, r.Plugins["proxy-rewrite"])
+	assert.NotContains(t, r.Plugins, "response-rewrite")
+
+	r = tctx.Routes[3]
+	assert.Equal(t, &v1.RewriteConfig{
+		RewriteTarget: "/full",
+	}, r.Plugins["proxy-rewrite"])
+}
+
 // TODO: Multiple BackendRefs, Multiple Rules, Multiple Matches
@@ -19,9 +19,11 @@ package translation
 import (
 	"fmt"
 
+	"go.uber.org/zap"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
 	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
@@ -31,12 +33,18 @@ func (t *translator) TranslateGatewayTCPRouteV1Alpha2(tcpRoute *gatewayv1alpha2.
 	var ns string
 
 	for i, rule := range tcpRoute.Spec.Rules {
-		for _, backend := range rule.BackendRefs {
+		for j, backend := range rule.BackendRefs {
 			if backend.Namespace != nil {
 				ns = string(*backend.Namespace)
 			} else {
 				ns = tcpRoute.Namespace
 			}
+			if !t.IsReferenceGrantedV1beta1(referenceFrom(kindTCPRoute, tcpRoute.Namespace), referenceToService(backend.Name), ns) {
+				log.Warnw(fmt.Sprintf("ignore Rules[%v].BackendRefs[%v] which is not permitted by any ReferenceGrant", i, j),
+					zap.String("namespace", ns),
+				)
+				continue
+			}
 			sr := apisixv1.NewDefaultStreamRoute()
 			name := apisixv1.ComposeStreamRouteName(tcpRoute.Namespace, tcpRoute.Name, fmt.Sprintf("%d-%s", i, string(backend.Name)))
 			sr.ID = id.GenID(name)
@@ -0,0 +1,121 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+)
+
+func generateCertificate(t *testing.T, dnsNames ...string) (cert, key []byte) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "apisix"},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &priv.PublicKey, priv)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(priv)
+	assert.Nil(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+func TestTranslateGatewayListenerSSLV1beta1(t *testing.T) {
+	cert, key := generateCertificate(t, "foo.example.com", "bar.example.com")
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	err := indexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cert",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		},
+	})
+	assert.Nil(t, err)
+	tr := &translator{
+		TranslatorOptions: &TranslatorOptions{
+			SecretLister: listerscorev1.NewSecretLister(indexer),
+		},
+	}
+
+	gateway := &gatewayv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "default",
+		},
+	}
+	terminate := gatewayv1beta1.TLSModeTerminate
+	hostname := gatewayv1beta1.Hostname("*.example.com")
+	listener := gatewayv1beta1.Listener{
+		Name:     "https",
+		Hostname: &hostname,
+		Protocol: gatewayv1beta1.HTTPSProtocolType,
+		Port:     443,
+		TLS: &gatewayv1beta1.GatewayTLSConfig{
+			Mode: &terminate,
+			CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+				{Name: "cert"},
+			},
+		},
+	}
+
+	ssl, err := tr.TranslateGatewayListenerSSLV1beta1(gateway, listener)
+	assert.Nil(t, err)
+	assert.Equal(t, id.GenID("default_gateway_https"), ssl.ID)
+	assert.Equal(t, []string{"*.example.com"}, ssl.Snis)
+	assert.Equal(t, string(cert), ssl.Cert)
+	assert.Equal(t, string(key), ssl.Key)
+
+	// The hostnames of the certificate are used if the listener accepts all
+	listener.Hostname = nil
+	ssl, err = tr.TranslateGatewayListenerSSLV1beta1(gateway, listener)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"foo.example.com", "bar.example.com"}, ssl.Snis)
+
+	listener.TLS.CertificateRefs[0].Name = "not-found"
+	_, err = tr.TranslateGatewayListenerSSLV1beta1(gateway, listener)
+	assert.NotNil(t, err)
+
+	passthrough := gatewayv1beta1.TLSModePassthrough
+	listener.TLS.Mode = &passthrough
+	ssl, err = tr.TranslateGatewayListenerSSLV1beta1(gateway, listener)
+	assert.Nil(t, err)
+	assert.Nil(t, ssl)
+}
@@ -42,6 +42,10 @@ func (t *translator) TranslateGatewayTLSRouteV1Alpha2(tlsRoute *gatewayv1alpha2.
 		// TODO: calculate intersection of listeners
 		hosts = append(hosts, string(hostname))
 	}
+	if len(hosts) == 0 {
+		// The TLS connections are passed through regardless of the SNI.
+		hosts = []string{""}
+	}
 
 	rules := tlsRoute.Spec.Rules
 
@@ -75,9 +79,12 @@ func (t *translator) TranslateGatewayTLSRouteV1Alpha2(tlsRoute *gatewayv1alpha2.
 			} else {
 				ns = string(*backend.Namespace)
 			}
-			//if ns != tlsRoute.Namespace {
-			// TODO: check gatewayv1alpha2.ReferencePolicy
-			//}
+			if !t.IsReferenceGrantedV1beta1(referenceFrom(kindTLSRoute, tlsRoute.Namespace), referenceToService(backend.Name), ns) {
+				log.Warnw(fmt.Sprintf("ignore Rules[%v].BackendRefs[%v] which is not permitted by any ReferenceGrant", i, j),
+					zap.String("namespace", ns),
+				)
+				continue
+			}
 
 			if backend.Port == nil {
 				log.Warnw(fmt.Sprintf("ignore nil port at Rules[%v].BackendRefs[%v]", i, j),
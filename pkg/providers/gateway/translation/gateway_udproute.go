@@ -58,9 +58,12 @@ func (t *translator) TranslateGatewayUDPRouteV1Alpha2(udpRoute *gatewayv1alpha2.
 			} else {
 				ns = string(*backend.Namespace)
 			}
-			//if ns != httpRoute.Namespace {
-			// TODO: check gatewayv1alpha2.ReferencePolicy
-			//}
+			if !t.IsReferenceGrantedV1beta1(referenceFrom(kindUDPRoute, udpRoute.Namespace), referenceToService(backend.Name), ns) {
+				log.Warnw(fmt.Sprintf("ignore Rules[%v].BackendRefs[%v] which is not permitted by any ReferenceGrant", i, j),
+					zap.String("namespace", ns),
+				)
+				continue
+			}
 
 			if backend.Port == nil {
 				log.Warnw(fmt.Sprintf("ignore nil port at Rules[%v].BackendRefs[%v]", i, j),
@@ -0,0 +1,111 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+)
+
+func (t *translator) IsReferenceGrantedV1beta1(from gatewayv1beta1.ReferenceGrantFrom, to gatewayv1beta1.ReferenceGrantTo, toNamespace string) bool {
+	if string(from.Namespace) == toNamespace {
+		return true
+	}
+	if t.ReferenceGrantLister == nil {
+		return false
+	}
+	grants, err := t.ReferenceGrantLister.ReferenceGrants(toNamespace).List(labels.Everything())
+	if err != nil {
+		log.Errorw("failed to list ReferenceGrants",
+			zap.Error(err),
+			zap.String("namespace", toNamespace),
+		)
+		return false
+	}
+	for _, grant := range grants {
+		if !referenceGrantFromMatches(grant, from) {
+			continue
+		}
+		for _, target := range grant.Spec.To {
+			if target.Group != to.Group || target.Kind != to.Kind {
+				continue
+			}
+			if target.Name == nil || *target.Name == "" || (to.Name != nil && *target.Name == *to.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func referenceGrantFromMatches(grant *gatewayv1beta1.ReferenceGrant, from gatewayv1beta1.ReferenceGrantFrom) bool {
+	for _, f := range grant.Spec.From {
+		if f.Group == from.Group && f.Kind == from.Kind && f.Namespace == from.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// referenceFrom returns the ReferenceGrantFrom of a Gateway API resource.
+func referenceFrom(kind gatewayv1beta1.Kind, namespace string) gatewayv1beta1.ReferenceGrantFrom {
+	return gatewayv1beta1.ReferenceGrantFrom{
+		Group:     gatewayv1beta1.GroupName,
+		Kind:      kind,
+		Namespace: gatewayv1beta1.Namespace(namespace),
+	}
+}
+
+// referenceToService returns the ReferenceGrantTo of a Service.
+func referenceToService(name gatewayv1beta1.ObjectName) gatewayv1beta1.ReferenceGrantTo {
+	return gatewayv1beta1.ReferenceGrantTo{
+		Kind: "Service",
+		Name: &name,
+	}
+}
+
+// CertificateRefsPermitted checks whether the certificateRefs of the Gateway
+// listener are permitted by ReferenceGrants.
+func CertificateRefsPermitted(t Translator, gateway *gatewayv1beta1.Gateway, listener gatewayv1beta1.Listener) bool {
+	if listener.TLS == nil {
+		return true
+	}
+	from := referenceFrom("Gateway", gateway.Namespace)
+	for _, ref := range listener.TLS.CertificateRefs {
+		to := gatewayv1beta1.ReferenceGrantTo{
+			Kind: "Secret",
+			Name: &ref.Name,
+		}
+		if ref.Group != nil {
+			to.Group = *ref.Group
+		}
+		if ref.Kind != nil {
+			to.Kind = *ref.Kind
+		}
+		ns := gateway.Namespace
+		if ref.Namespace != nil {
+			ns = string(*ref.Namespace)
+		}
+		if !t.IsReferenceGrantedV1beta1(from, to, ns) {
+			return false
+		}
+	}
+	return true
+}
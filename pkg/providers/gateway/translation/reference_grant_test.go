@@ -0,0 +1,79 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatewaylistersv1beta1 "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
+)
+
+func TestIsReferenceGrantedV1beta1(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	httpbin := gatewayv1beta1.ObjectName("httpbin")
+	err := indexer.Add(&gatewayv1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "allow-httpbin",
+			Namespace: "backend",
+		},
+		Spec: gatewayv1beta1.ReferenceGrantSpec{
+			From: []gatewayv1beta1.ReferenceGrantFrom{
+				{Group: gatewayv1beta1.GroupName, Kind: "HTTPRoute", Namespace: "default"},
+			},
+			To: []gatewayv1beta1.ReferenceGrantTo{
+				{Kind: "Service", Name: &httpbin},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	tr := &translator{
+		TranslatorOptions: &TranslatorOptions{
+			ReferenceGrantLister: gatewaylistersv1beta1.NewReferenceGrantLister(indexer),
+		},
+	}
+
+	from := referenceFrom(kindHTTPRoute, "default")
+	assert.True(t, tr.IsReferenceGrantedV1beta1(from, referenceToService("httpbin"), "backend"))
+	assert.True(t, tr.IsReferenceGrantedV1beta1(from, referenceToService("foo"), "default"))
+	assert.False(t, tr.IsReferenceGrantedV1beta1(from, referenceToService("foo"), "backend"))
+	assert.False(t, tr.IsReferenceGrantedV1beta1(from, referenceToService("httpbin"), "test"))
+	assert.False(t, tr.IsReferenceGrantedV1beta1(referenceFrom(kindGRPCRoute, "default"), referenceToService("httpbin"), "backend"))
+	assert.False(t, tr.IsReferenceGrantedV1beta1(referenceFrom(kindHTTPRoute, "test"), referenceToService("httpbin"), "backend"))
+
+	// Secrets aren't granted
+	gateway := &gatewayv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "default",
+		},
+	}
+	ns := gatewayv1beta1.Namespace("backend")
+	listener := gatewayv1beta1.Listener{
+		TLS: &gatewayv1beta1.GatewayTLSConfig{
+			CertificateRefs: []gatewayv1beta1.SecretObjectReference{
+				{Name: "cert", Namespace: &ns},
+			},
+		},
+	}
+	assert.False(t, CertificateRefsPermitted(tr, gateway, listener))
+	listener.TLS.CertificateRefs[0].Namespace = nil
+	assert.True(t, CertificateRefsPermitted(tr, gateway, listener))
+}
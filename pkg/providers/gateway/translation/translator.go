@@ -17,15 +17,20 @@
 package translation
 
 import (
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatewaylistersv1beta1 "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
 
 	"github.com/apache/apisix-ingress-controller/pkg/providers/gateway/types"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
 type TranslatorOptions struct {
-	KubeTranslator translation.Translator
+	KubeTranslator       translation.Translator
+	SecretLister         listerscorev1.SecretLister
+	ReferenceGrantLister gatewaylistersv1beta1.ReferenceGrantLister
 }
 
 type translator struct {
@@ -35,14 +40,24 @@ type translator struct {
 type Translator interface {
 	// TranslateGatewayV1beta1 translates Gateway to internal configurations
 	TranslateGatewayV1beta1(gateway *gatewayv1beta1.Gateway) (map[string]*types.ListenerConf, error)
+	// TranslateGatewayListenerSSLV1beta1 translates the certificateRefs of the
+	// Gateway listener to APISIX SSL, nil is returned if the listener doesn't
+	// terminate TLS.
+	TranslateGatewayListenerSSLV1beta1(gateway *gatewayv1beta1.Gateway, listener gatewayv1beta1.Listener) (*apisixv1.Ssl, error)
 	// TranslateGatewayHTTPRouteV1beta1 translates Gateway API HTTPRoute to APISIX resources
 	TranslateGatewayHTTPRouteV1beta1(httpRoute *gatewayv1beta1.HTTPRoute) (*translation.TranslateContext, error)
+	// TranslateGatewayGRPCRouteV1Alpha2 translates Gateway API GRPCRoute to APISIX resources
+	TranslateGatewayGRPCRouteV1Alpha2(grpcRoute *gatewayv1alpha2.GRPCRoute) (*translation.TranslateContext, error)
 	// TranslateGatewayTLSRouteV1Alpha2 translates Gateway API TLSRoute to APISIX resources
 	TranslateGatewayTLSRouteV1Alpha2(tlsRoute *gatewayv1alpha2.TLSRoute) (*translation.TranslateContext, error)
 	// TranslateGatewayTCPRouteV1Alpha2 translates Gateway API TCPRoute to APISIX resources
 	TranslateGatewayTCPRouteV1Alpha2(*gatewayv1alpha2.TCPRoute) (*translation.TranslateContext, error)
 	// TranslateGatewayUDPRouteV1Alpha2 translates Gateway API UDPRoute to APISIX resources
 	TranslateGatewayUDPRouteV1Alpha2(udpRoute *gatewayv1alpha2.UDPRoute) (*translation.TranslateContext, error)
+	// IsReferenceGrantedV1beta1 checks whether the reference to an object in
+	// toNamespace is permitted by a ReferenceGrant, references within the same
+	// namespace are always permitted.
+	IsReferenceGrantedV1beta1(from gatewayv1beta1.ReferenceGrantFrom, to gatewayv1beta1.ReferenceGrantTo, toNamespace string) bool
 }
 
 // NewTranslator initializes a APISIX CRD resources Translator.
@@ -27,6 +27,8 @@ type ListenerConf struct {
 	SectionName string
 	Protocol    gatewayv1beta1.ProtocolType
 	Port        gatewayv1beta1.PortNumber
+	// Hostname of the listener, nil means all the hostnames are accepted
+	Hostname *gatewayv1beta1.Hostname
 
 	// namespace selector of AllowedRoutes
 	RouteNamespace *gatewayv1beta1.RouteNamespaces
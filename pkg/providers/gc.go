@@ -0,0 +1,117 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package providers
+
+import (
+	"context"
+	"sort"
+
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+)
+
+// ownerIndexers returns the indexers to find the owner resources of APISIX
+// objects, keyed by kind.
+func (c *Controller) ownerIndexers() map[string]cache.Indexer {
+	indexers := map[string]cache.Indexer{
+		"ApisixRoute":        c.informers.ApisixRouteInformer.GetIndexer(),
+		"ApisixPluginConfig": c.informers.ApisixPluginConfigInformer.GetIndexer(),
+		"ApisixTls":          c.informers.ApisixTlsInformer.GetIndexer(),
+		"ApisixConsumer":     c.informers.ApisixConsumerInformer.GetIndexer(),
+		"Ingress":            c.informers.IngressInformer.GetIndexer(),
+	}
+	if c.informers.ApisixServiceInformer != nil {
+		indexers["ApisixService"] = c.informers.ApisixServiceInformer.GetIndexer()
+	}
+	if c.informers.ApisixConsumerGroupInformer != nil {
+		indexers["ApisixConsumerGroup"] = c.informers.ApisixConsumerGroupInformer.GetIndexer()
+	}
+	if c.informers.ApisixProtoInformer != nil {
+		indexers["ApisixProto"] = c.informers.ApisixProtoInformer.GetIndexer()
+	}
+	if c.cfg.Kubernetes.EnableGatewayAPI {
+		for kind, indexer := range c.gatewayProvider.RouteIndexers() {
+			indexers[kind] = indexer
+		}
+		indexers["Gateway"] = c.gatewayProvider.GatewayIndexer()
+	}
+	return indexers
+}
+
+// collectGarbage deletes the objects in the APISIX clusters which are owned
+// by this controller instance but whose owners no longer exist. Objects owned
+// by other instances, or whose owners are not watched, are always kept, so
+// that several controllers can share one APISIX.
+func (c *Controller) collectGarbage(ctx context.Context) {
+	if c.cfg.APISIX.DisableGarbageCollection {
+		return
+	}
+	indexers := c.ownerIndexers()
+	ownerExists := func(ref utils.OwnerRef) (exists, known bool) {
+		indexer, ok := indexers[ref.Kind]
+		if !ok {
+			return false, false
+		}
+		if ref.Namespace != "" && !c.namespaceProvider.IsWatchingNamespace(ref.Key()) {
+			return false, false
+		}
+		_, exists, err := indexer.GetByKey(ref.Key())
+		if err != nil {
+			return false, false
+		}
+		return exists, true
+	}
+
+	for _, cluster := range c.clusterNames() {
+		garbage, err := utils.CollectGarbage(ctx, c.apisix, cluster, c.cfg.Kubernetes.ElectionID, ownerExists)
+		if garbage != nil && garbage.Len() > 0 {
+			log.Infow("deleted objects whose owners no longer exist",
+				zap.String("cluster", cluster),
+				zap.Any("objects", garbage),
+			)
+		}
+		if err != nil {
+			log.Errorw("failed to collect garbage objects",
+				zap.String("cluster", cluster),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// clusterNames returns the names of the APISIX clusters, which are the default
+// cluster and the clusters registered by ApisixClusterConfig.
+func (c *Controller) clusterNames() []string {
+	names := []string{c.cfg.APISIX.DefaultClusterName}
+	if c.informers.ApisixClusterConfigInformer == nil {
+		return names
+	}
+	var configs []string
+	for _, obj := range c.informers.ApisixClusterConfigInformer.GetIndexer().List() {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			continue
+		}
+		if name := accessor.GetName(); name != c.cfg.APISIX.DefaultClusterName && c.hasCluster(name) {
+			configs = append(configs, name)
+		}
+	}
+	sort.Strings(configs)
+	return append(names, configs...)
+}
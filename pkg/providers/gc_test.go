@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package providers
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/k8s/namespace"
+	providertypes "github.com/apache/apisix-ingress-controller/pkg/providers/types"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func newTestInformer(obj runtime.Object) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(&cache.ListWatch{}, obj, 0, cache.Indexers{})
+}
+
+func TestCollectGarbageConsumerGroupsAndProtos(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.NewDefaultConfig()
+	client, err := apisix.NewClient("v3")
+	assert.Nil(t, err)
+	assert.Nil(t, client.AddCluster(ctx, &apisix.ClusterOptions{
+		Name:               cfg.APISIX.DefaultClusterName,
+		StandaloneRenderer: apisix.NewFileRenderer(filepath.Join(t.TempDir(), "apisix.yaml")),
+	}))
+	cluster := client.Cluster(cfg.APISIX.DefaultClusterName)
+
+	groupInformer := newTestInformer(&configv2.ApisixConsumerGroup{})
+	protoInformer := newTestInformer(&configv2.ApisixProto{})
+	assert.Nil(t, groupInformer.GetIndexer().Add(&configv2.ApisixConsumerGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kept"},
+	}))
+	assert.Nil(t, protoInformer.GetIndexer().Add(&configv2.ApisixProto{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kept"},
+	}))
+	for _, name := range []string{"kept", "orphan"} {
+		_, err = cluster.ConsumerGroup().Create(ctx, &apisixv1.ConsumerGroup{
+			ID:     name,
+			Labels: utils.OwnerLabels(nil, cfg.Kubernetes.ElectionID, "ApisixConsumerGroup", "default/"+name),
+		})
+		assert.Nil(t, err)
+		_, err = cluster.Proto().Create(ctx, &apisixv1.Proto{
+			ID:      name,
+			Labels:  utils.OwnerLabels(nil, cfg.Kubernetes.ElectionID, "ApisixProto", "default/"+name),
+			Content: `syntax = "proto3";`,
+		})
+		assert.Nil(t, err)
+	}
+
+	c := &Controller{
+		cfg:    cfg,
+		apisix: client,
+		informers: &providertypes.ListerInformer{
+			ApisixRouteInformer:         newTestInformer(&configv2.ApisixRoute{}),
+			ApisixPluginConfigInformer:  newTestInformer(&configv2.ApisixPluginConfig{}),
+			ApisixTlsInformer:           newTestInformer(&configv2.ApisixTls{}),
+			ApisixConsumerInformer:      newTestInformer(&configv2.ApisixConsumer{}),
+			IngressInformer:             newTestInformer(&networkingv1.Ingress{}),
+			ApisixConsumerGroupInformer: groupInformer,
+			ApisixProtoInformer:         protoInformer,
+		},
+		namespaceProvider: namespace.NewMockWatchingNamespaceProvider([]string{"default"}),
+	}
+	c.collectGarbage(ctx)
+
+	groups, err := cluster.ConsumerGroup().List(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, "kept", groups[0].ID)
+	protos, err := cluster.Proto().List(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, protos, 1)
+	assert.Equal(t, "kept", protos[0].ID)
+}
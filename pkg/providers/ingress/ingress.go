@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
@@ -34,15 +35,16 @@ import (
 
 	"github.com/apache/apisix-ingress-controller/pkg/kube"
 	"github.com/apache/apisix-ingress-controller/pkg/log"
+	ingresstranslation "github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/utils"
 	"github.com/apache/apisix-ingress-controller/pkg/types"
 	v1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
-const (
-	_ingressKey = "kubernetes.io/ingress.class"
-)
+// _defaultBackendConflict is the reason of the Events recorded on Ingresses
+// whose global default backend routes are owned by other Ingresses.
+const _defaultBackendConflict = "DefaultBackendConflict"
 
 type ingressController struct {
 	*ingressCommon
@@ -56,6 +58,9 @@ type ingressController struct {
 	// Secret key is kube-style meta key: `namespace/name`
 	// Ingress Version Key is: `namespace/name_groupVersion`
 	secretSSLMap *sync.Map
+	// ingressClusterMap stores the name of APISIX cluster which each
+	// Ingress was synced to, the key is the Ingress key `namespace/name`.
+	ingressClusterMap *sync.Map
 }
 
 func newIngressController(common *ingressCommon) *ingressController {
@@ -65,7 +70,8 @@ func newIngressController(common *ingressCommon) *ingressController {
 		workqueue: workqueue.NewNamedRateLimitingQueue(workqueue.NewItemFastSlowRateLimiter(1*time.Second, 60*time.Second, 5), "ingress"),
 		workers:   1,
 
-		secretSSLMap: new(sync.Map),
+		secretSSLMap:      new(sync.Map),
+		ingressClusterMap: new(sync.Map),
 	}
 
 	c.IngressInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -73,6 +79,28 @@ func newIngressController(common *ingressCommon) *ingressController {
 		UpdateFunc: c.onUpdate,
 		DeleteFunc: c.OnDelete,
 	})
+	// The effectiveness and the parameters of Ingresses are decided by
+	// IngressClasses and their ApisixIngressClassConfigs.
+	classHandler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.resyncIngresses(metav1.NamespaceAll)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if oldObj.(metav1.Object).GetResourceVersion() == newObj.(metav1.Object).GetResourceVersion() {
+				return
+			}
+			c.resyncIngresses(metav1.NamespaceAll)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.resyncIngresses(metav1.NamespaceAll)
+		},
+	}
+	if c.IngressClassInformer != nil {
+		c.IngressClassInformer.AddEventHandler(classHandler)
+	}
+	if c.ApisixIngressClassConfigInformer != nil {
+		c.ApisixIngressClassConfigInformer.AddEventHandler(classHandler)
+	}
 	return c
 }
 
@@ -142,9 +170,33 @@ func (c *ingressController) sync(ctx context.Context, ev *types.Event) error {
 		}
 		ing = ev.Tombstone.(kube.Ingress)
 	}
+	evType := ev.Type
+	if evType != types.EventDelete && !c.isIngressEffective(ing) {
+		// The Ingress is no longer served by this controller, e.g. its
+		// IngressClass was changed, so its objects should be deleted.
+		evType = types.EventDelete
+	}
+
+	prevCluster, synced := c.ingressClusterMap.Load(ingEv.Key)
+	clusterName, err := c.translator.IngressClusterName(ing)
+	if err != nil {
+		if evType != types.EventDelete {
+			log.Errorw("failed to decide the cluster of ingress",
+				zap.Error(err),
+				zap.Any("ingress", ing),
+			)
+			return err
+		}
+		clusterName = c.Config.APISIX.DefaultClusterName
+	}
+	if evType == types.EventDelete && synced {
+		clusterName = prevCluster.(string)
+	}
+	moved := synced && prevCluster.(string) != clusterName
+
 	var tctx *translation.TranslateContext
-	if ev.Type == types.EventDelete {
-		tctx, err = c.translator.TranslateIngressDeleteEvent(ing)
+	if evType == types.EventDelete {
+		tctx, err = c.translator.TranslateIngressDeleteEvent(ing, clusterName)
 	} else {
 		tctx, err = c.translator.TranslateIngress(ing)
 	}
@@ -154,16 +206,24 @@ func (c *ingressController) sync(ctx context.Context, ev *types.Event) error {
 			zap.Error(err),
 			zap.Any("ingress", ing),
 		)
+		if evType != types.EventDelete {
+			// Surface the translation errors, e.g. bad annotations, to users.
+			c.RecordEvent(ing.Object(), corev1.EventTypeWarning, utils.ResourceSyncAborted, err)
+		}
 		return err
 	}
 
+	if evType != types.EventDelete {
+		c.recordDefaultBackendConflict(ing, ingEv.Key)
+	}
+
 	for _, ssl := range tctx.SSL {
 		ns, ok1 := ssl.Labels[translation.MetaSecretNamespace]
 		sec, ok2 := ssl.Labels[translation.MetaSecretName]
 		if ok1 && ok2 {
 			// We don't support annotation in Ingress
 			// 	_caAnnotation = "nginx.ingress.kubernetes.io/auth-tls-secret"
-			c.storeSecretReference(ns+"/"+sec, ingEv.Key, ev.Type, ssl)
+			c.storeSecretReference(ns+"/"+sec, ingEv.Key, evType, ssl)
 		}
 	}
 
@@ -181,6 +241,7 @@ func (c *ingressController) sync(ctx context.Context, ev *types.Event) error {
 		Upstreams:     tctx.Upstreams,
 		PluginConfigs: tctx.PluginConfigs,
 	}
+	m.SetOwnerLabels(c.Kubernetes.ElectionID, "Ingress", ingEv.Key)
 
 	var (
 		added   *utils.Manifest
@@ -188,12 +249,12 @@ func (c *ingressController) sync(ctx context.Context, ev *types.Event) error {
 		deleted *utils.Manifest
 	)
 
-	if ev.Type == types.EventDelete {
+	if evType == types.EventDelete {
 		deleted = m
-	} else if ev.Type == types.EventAdd {
+	} else if evType == types.EventAdd || moved {
 		added = m
 	} else {
-		oldCtx, err := c.translator.TranslateOldIngress(ingEv.OldObject)
+		oldCtx, err := c.translator.TranslateOldIngress(ingEv.OldObject, clusterName)
 		if err != nil {
 			log.Errorw("failed to translate ingress",
 				zap.String("event", "update"),
@@ -208,14 +269,35 @@ func (c *ingressController) sync(ctx context.Context, ev *types.Event) error {
 			SSLs:          oldCtx.SSL,
 			PluginConfigs: oldCtx.PluginConfigs,
 		}
+		om.SetOwnerLabels(c.Kubernetes.ElectionID, "Ingress", ingEv.Key)
 		added, updated, deleted = m.Diff(om)
 	}
-	if err := c.SyncManifests(ctx, added, updated, deleted); err != nil {
+	if moved {
+		// The Ingress is moved to another cluster by its IngressClass,
+		// delete its objects from the previous one.
+		if err := c.SyncClusterManifests(ctx, prevCluster.(string), nil, nil, m); err != nil {
+			log.Errorw("failed to delete ingress artifacts from the previous cluster",
+				zap.String("cluster", prevCluster.(string)),
+				zap.Error(err),
+			)
+			return err
+		}
+		c.ingressClusterMap.Store(ingEv.Key, clusterName)
+	}
+	if err := c.SyncClusterManifests(ctx, clusterName, added, updated, deleted); err != nil {
 		log.Errorw("failed to sync ingress artifacts",
 			zap.Error(err),
 		)
+		if evType != types.EventDelete {
+			c.RecordEvent(ing.Object(), corev1.EventTypeWarning, utils.SyncFailedReason(err), err)
+		}
 		return err
 	}
+	if evType == types.EventDelete {
+		c.ingressClusterMap.Delete(ingEv.Key)
+	} else {
+		c.ingressClusterMap.Store(ingEv.Key, clusterName)
+	}
 	return nil
 }
 
@@ -251,7 +333,7 @@ func (c *ingressController) handleSyncErr(obj interface{}, err error) {
 	if err == nil {
 		// add status
 		if ev.Type != types.EventDelete {
-			if errLocal == nil {
+			if errLocal == nil && c.isIngressEffective(ing) {
 				switch ing.GroupVersion() {
 				case kube.IngressV1:
 					c.recordStatus(ing.V1(), utils.ResourceSynced, nil, metav1.ConditionTrue, ing.V1().GetGeneration())
@@ -260,7 +342,7 @@ func (c *ingressController) handleSyncErr(obj interface{}, err error) {
 				case kube.IngressExtensionsV1beta1:
 					c.recordStatus(ing.ExtensionsV1beta1(), utils.ResourceSynced, nil, metav1.ConditionTrue, ing.ExtensionsV1beta1().GetGeneration())
 				}
-			} else {
+			} else if errLocal != nil {
 				log.Errorw("failed to list ingress resource",
 					zap.Error(errLocal),
 				)
@@ -275,14 +357,15 @@ func (c *ingressController) handleSyncErr(obj interface{}, err error) {
 		zap.Error(err),
 	)
 
+	reason := utils.SyncFailedReason(err)
 	if errLocal == nil {
 		switch ing.GroupVersion() {
 		case kube.IngressV1:
-			c.recordStatus(ing.V1(), utils.ResourceSyncAborted, err, metav1.ConditionTrue, ing.V1().GetGeneration())
+			c.recordStatus(ing.V1(), reason, err, metav1.ConditionTrue, ing.V1().GetGeneration())
 		case kube.IngressV1beta1:
-			c.recordStatus(ing.V1beta1(), utils.ResourceSyncAborted, err, metav1.ConditionTrue, ing.V1beta1().GetGeneration())
+			c.recordStatus(ing.V1beta1(), reason, err, metav1.ConditionTrue, ing.V1beta1().GetGeneration())
 		case kube.IngressExtensionsV1beta1:
-			c.recordStatus(ing.ExtensionsV1beta1(), utils.ResourceSyncAborted, err, metav1.ConditionTrue, ing.ExtensionsV1beta1().GetGeneration())
+			c.recordStatus(ing.ExtensionsV1beta1(), reason, err, metav1.ConditionTrue, ing.ExtensionsV1beta1().GetGeneration())
 		}
 	} else {
 		log.Errorw("failed to list ingress resource",
@@ -323,6 +406,8 @@ func (c *ingressController) onAdd(obj interface{}) {
 			GroupVersion: ing.GroupVersion(),
 		},
 	})
+	c.resyncPrimaryIngresses(ing)
+	c.resyncGlobalDefaultBackendIngresses(ing)
 
 	c.MetricsCollector.IncrEvents("ingress", "add")
 }
@@ -342,7 +427,9 @@ func (c *ingressController) onUpdate(oldObj, newObj interface{}) {
 	if !c.namespaceProvider.IsWatchingNamespace(key) {
 		return
 	}
-	valid := c.isIngressEffective(curr)
+	// The Ingress which was effective is also handled, so that its objects
+	// are deleted if it's no longer served by this controller.
+	valid := c.isIngressEffective(curr) || c.isIngressEffective(prev)
 	if valid {
 		log.Debugw("ingress update event arrived",
 			zap.Any("new object", newObj),
@@ -364,6 +451,16 @@ func (c *ingressController) onUpdate(oldObj, newObj interface{}) {
 			OldObject:    prev,
 		},
 	})
+	if c.translator.IsCanaryIngress(prev) {
+		c.resyncPrimaryIngresses(prev)
+	} else {
+		c.resyncPrimaryIngresses(curr)
+	}
+	if c.translator.HasGlobalDefaultBackend(prev) {
+		c.resyncGlobalDefaultBackendIngresses(prev)
+	} else {
+		c.resyncGlobalDefaultBackendIngresses(curr)
+	}
 
 	c.MetricsCollector.IncrEvents("ingress", "update")
 }
@@ -405,34 +502,89 @@ func (c *ingressController) OnDelete(obj interface{}) {
 		},
 		Tombstone: ing,
 	})
+	c.resyncPrimaryIngresses(ing)
+	c.resyncGlobalDefaultBackendIngresses(ing)
 
 	c.MetricsCollector.IncrEvents("ingress", "delete")
 }
 
+// recordDefaultBackendConflict records a Warning Event if the global default
+// backend route of the Ingress isn't created since it's owned by another one.
+func (c *ingressController) recordDefaultBackendConflict(ing kube.Ingress, key string) {
+	if !c.translator.HasGlobalDefaultBackend(ing) {
+		return
+	}
+	owner, err := c.translator.GlobalDefaultBackendOwner()
+	if err != nil || owner == "" || owner == key {
+		return
+	}
+	c.Recorder.Eventf(ing.Object(), corev1.EventTypeWarning, _defaultBackendConflict,
+		"The global default backend route is not created since it's owned by Ingress %s, which comes first in namespace/name order", owner)
+}
+
+// resyncPrimaryIngresses resyncs the Ingresses in the same namespace if the
+// Ingress is a canary, since it's merged onto the routes of the primary ones.
+func (c *ingressController) resyncPrimaryIngresses(ing kube.Ingress) {
+	if !c.translator.IsCanaryIngress(ing) {
+		return
+	}
+	c.resyncIngresses(ing.Object().GetNamespace())
+}
+
+// resyncGlobalDefaultBackendIngresses resyncs the Ingresses which have
+// default backends if the Ingress has one, since the owner of the global
+// default backend route may be changed.
+func (c *ingressController) resyncGlobalDefaultBackendIngresses(ing kube.Ingress) {
+	if !c.translator.HasGlobalDefaultBackend(ing) {
+		return
+	}
+	ings, err := ingresstranslation.ListDefaultBackendIngresses(c.IngressInformer.GetIndexer())
+	if err != nil {
+		log.Errorw("failed to list Ingresses with default backends", zap.Error(err))
+		return
+	}
+	for _, ing := range ings {
+		c.resyncIngress(ing)
+	}
+}
+
 func (c *ingressController) isIngressEffective(ing kube.Ingress) bool {
-	var (
-		ic  *string
-		ica string
-	)
-	if ing.GroupVersion() == kube.IngressV1 {
-		ic = ing.V1().Spec.IngressClassName
-		ica = ing.V1().GetAnnotations()[_ingressKey]
-	} else if ing.GroupVersion() == kube.IngressV1beta1 {
-		ic = ing.V1beta1().Spec.IngressClassName
-		ica = ing.V1beta1().GetAnnotations()[_ingressKey]
-	} else {
-		ic = ing.ExtensionsV1beta1().Spec.IngressClassName
-		ica = ing.ExtensionsV1beta1().GetAnnotations()[_ingressKey]
+	return c.translator.IsIngressEffective(ing)
+}
+
+// resyncIngresses enqueues the Ingresses in the namespace (all namespaces if
+// it's empty) which are served by this controller, or were synced before,
+// after IngressClasses, their parameters or canary Ingresses are changed.
+// The current Ingress is used as the old object, so it's diffed against the
+// objects in the cache.
+func (c *ingressController) resyncIngresses(namespace string) {
+	objs := c.IngressInformer.GetIndexer().List()
+	for _, obj := range objs {
+		if namespace != metav1.NamespaceAll && obj.(metav1.Object).GetNamespace() != namespace {
+			continue
+		}
+		c.resyncIngress(kube.MustNewIngress(obj))
 	}
+}
 
-	// kubernetes.io/ingress.class takes the precedence.
-	if ica != "" {
-		return ica == c.Kubernetes.IngressClass
+// resyncIngress enqueues the Ingress if it's in the watched namespaces, and
+// it's served by this controller or was synced before.
+func (c *ingressController) resyncIngress(ing kube.Ingress) {
+	key := ing.Object().GetNamespace() + "/" + ing.Object().GetName()
+	if !c.namespaceProvider.IsWatchingNamespace(key) {
+		return
 	}
-	if ic != nil {
-		return *ic == c.Kubernetes.IngressClass
+	if _, synced := c.ingressClusterMap.Load(key); !synced && !c.isIngressEffective(ing) {
+		return
 	}
-	return false
+	c.workqueue.Add(&types.Event{
+		Type: types.EventUpdate,
+		Object: kube.IngressEvent{
+			Key:          key,
+			GroupVersion: ing.GroupVersion(),
+			OldObject:    ing,
+		},
+	})
 }
 
 func (c *ingressController) ResourceSync() {
@@ -448,7 +600,7 @@ func (c *ingressController) ResourceSync() {
 		}
 		ing := kube.MustNewIngress(obj)
 		if !c.isIngressEffective(ing) {
-			return
+			continue
 		}
 		log.Debugw("ingress add event arrived",
 			zap.Any("object", obj),
@@ -463,6 +615,41 @@ func (c *ingressController) ResourceSync() {
 	}
 }
 
+// translateManifests translates the effective Ingress resources without
+// syncing them, only the Ingress with the given key is translated if key
+// is not empty.
+func (c *ingressController) translateManifests(key string, m *utils.Manifest) error {
+	objs, err := utils.ListObjects(c.IngressInformer.GetIndexer(), key)
+	if err != nil {
+		return err
+	}
+	var merr *multierror.Error
+	for _, obj := range objs {
+		objKey, err := cache.MetaNamespaceKeyFunc(obj)
+		if err != nil || !c.namespaceProvider.IsWatchingNamespace(objKey) {
+			continue
+		}
+		ing := kube.MustNewIngress(obj)
+		if !c.isIngressEffective(ing) {
+			continue
+		}
+		tctx, err := c.translator.TranslateIngress(ing)
+		if err != nil {
+			merr = multierror.Append(merr, fmt.Errorf("Ingress %s: %s", objKey, err))
+			continue
+		}
+		om := &utils.Manifest{
+			SSLs:          tctx.SSL,
+			Routes:        tctx.Routes,
+			Upstreams:     tctx.Upstreams,
+			PluginConfigs: tctx.PluginConfigs,
+		}
+		om.SetOwnerLabels(c.Kubernetes.ElectionID, "Ingress", objKey)
+		m.Append(om)
+	}
+	return merr.ErrorOrNil()
+}
+
 // recordStatus record resources status
 func (c *ingressController) recordStatus(at runtime.Object, reason string, err error, status metav1.ConditionStatus, generation int64) {
 	if c.Kubernetes.DisableStatusUpdates {
@@ -25,6 +25,7 @@ import (
 
 	"github.com/apache/apisix-ingress-controller/pkg/config"
 	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	ingresstranslation "github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation"
 	providertypes "github.com/apache/apisix-ingress-controller/pkg/providers/types"
 )
 
@@ -34,6 +35,10 @@ func TestIsIngressEffective(t *testing.T) {
 			Common: &providertypes.Common{
 				Config: config.NewDefaultConfig(),
 			},
+			translator: ingresstranslation.NewIngressTranslator(&ingresstranslation.TranslatorOptions{
+				IngressClass:           config.IngressClass,
+				IngressClassController: config.IngressClassController,
+			}, nil, nil),
 		},
 	}
 	cn := "ingress"
@@ -46,7 +51,7 @@ func TestIsIngressEffective(t *testing.T) {
 			Namespace: "default",
 			Name:      "v1-ing",
 			Annotations: map[string]string{
-				_ingressKey: "apisix",
+				"kubernetes.io/ingress.class": "apisix",
 			},
 		},
 		Spec: networkingv1.IngressSpec{
@@ -85,7 +90,7 @@ func TestIsIngressEffective(t *testing.T) {
 			Namespace: "default",
 			Name:      "v1beta1-ing",
 			Annotations: map[string]string{
-				_ingressKey: "apisix",
+				"kubernetes.io/ingress.class": "apisix",
 			},
 		},
 		Spec: networkingv1beta1.IngressSpec{
@@ -142,7 +147,7 @@ func TestIsIngressEffective(t *testing.T) {
 			Namespace: "default",
 			Name:      "v1extbeta1-ing",
 			Annotations: map[string]string{
-				_ingressKey: "apisix",
+				"kubernetes.io/ingress.class": "apisix",
 			},
 		},
 		Spec: extensionsv1beta1.IngressSpec{
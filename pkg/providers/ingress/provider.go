@@ -18,6 +18,7 @@ package ingress
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -45,6 +46,7 @@ var _ Provider = (*ingressProvider)(nil)
 
 type Provider interface {
 	providertypes.Provider
+	providertypes.ManifestTranslator
 
 	ResourceSync()
 
@@ -67,12 +69,22 @@ func NewProvider(common *providertypes.Common, namespaceProvider namespace.Watch
 		Common:            common,
 		namespaceProvider: namespaceProvider,
 		translator: ingresstranslation.NewIngressTranslator(&ingresstranslation.TranslatorOptions{
-			Apisix:        common.APISIX,
-			ClusterName:   common.Config.APISIX.DefaultClusterName,
-			ServiceLister: common.SvcLister,
+			Apisix:                         common.APISIX,
+			ClusterName:                    common.Config.APISIX.DefaultClusterName,
+			IngressClass:                   common.Config.Kubernetes.IngressClass,
+			IngressClassController:         common.Config.Kubernetes.IngressClassController,
+			ServiceLister:                  common.SvcLister,
+			IngressLister:                  common.IngressLister,
+			IngressIndexer:                 common.IngressInformer.GetIndexer(),
+			IngressClassLister:             common.IngressClassLister,
+			ApisixIngressClassConfigLister: common.ApisixIngressClassConfigLister,
+			IsWatchingNamespace:            namespaceProvider.IsWatchingNamespace,
 		}, translator, apisixTranslator),
 	}
 
+	if err := common.IngressInformer.AddIndexers(ingresstranslation.DefaultBackendIndexers()); err != nil {
+		return nil, err
+	}
 	p.ingressController = newIngressController(c)
 
 	return p, nil
@@ -96,6 +108,22 @@ func (p *ingressProvider) ResourceSync() {
 	e.Wait()
 }
 
+// TranslateManifests translates Ingress resources.
+func (p *ingressProvider) TranslateManifests(kind, key string, m *utils.Manifest) error {
+	if kind != "Ingress" {
+		return fmt.Errorf("unsupported resource kind %s", kind)
+	}
+	return p.ingressController.translateManifests(key, m)
+}
+
+// ListManifestResources lists Ingress resources.
+func (p *ingressProvider) ListManifestResources(kind string) ([]interface{}, error) {
+	if kind != "Ingress" {
+		return nil, fmt.Errorf("unsupported resource kind %s", kind)
+	}
+	return p.ingressController.IngressInformer.GetIndexer().List(), nil
+}
+
 func (p *ingressProvider) SyncSecretChange(ctx context.Context, ev *types.Event, secret *corev1.Secret, secretMapKey string) {
 	p.ingressController.SyncSecretChange(ctx, ev, secret, secretMapKey)
 }
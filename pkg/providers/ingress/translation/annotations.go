@@ -15,16 +15,28 @@
 package translation
 
 import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
 	"github.com/imdario/mergo"
+	"github.com/xeipuuv/gojsonschema"
 	"go.uber.org/zap"
 
+	apisixclient "github.com/apache/apisix-ingress-controller/pkg/apisix"
+	apisixcache "github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
 	"github.com/apache/apisix-ingress-controller/pkg/log"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/canary"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/genericplugin"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/pluginconfig"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/plugins"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/regex"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/servicenamespace"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/upstreamretries"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/upstreamscheme"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/upstreamtimeout"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/websocket"
 	apisix "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
@@ -37,6 +49,10 @@ type Ingress struct {
 	PluginConfigName string
 	ServiceNamespace string
 	UpstreamScheme   string
+	UpstreamTimeout  *apisix.UpstreamTimeout
+	UpstreamRetries  *int
+	Canary           *canary.Canary
+	GenericPlugins   apisix.Plugins
 }
 
 var (
@@ -47,6 +63,10 @@ var (
 		"PluginConfigName": pluginconfig.NewParser(),
 		"ServiceNamespace": servicenamespace.NewParser(),
 		"UpstreamScheme":   upstreamscheme.NewParser(),
+		"UpstreamTimeout":  upstreamtimeout.NewParser(),
+		"UpstreamRetries":  upstreamretries.NewParser(),
+		"Canary":           canary.NewParser(),
+		"GenericPlugins":   genericplugin.NewParser(),
 	}
 )
 
@@ -70,5 +90,101 @@ func (t *translator) TranslateAnnotations(anno map[string]string) *Ingress {
 	if err != nil {
 		log.Errorw("unexpected error merging extracted annotations", zap.Error(err))
 	}
+	// The plugin.<name> annotations take precedence over the other ones
+	// which generate the same plugin.
+	if len(ing.GenericPlugins) > 0 {
+		if ing.Plugins == nil {
+			ing.Plugins = make(apisix.Plugins)
+		}
+		for name, config := range ing.GenericPlugins {
+			ing.Plugins[name] = config
+		}
+	}
 	return ing
 }
+
+// ValidateAnnotations parses the annotations like TranslateAnnotations, but
+// the errors of the bad annotations are returned instead of being ignored.
+// The plugins converted from the annotations are returned as well, so that
+// they can be validated against the schemas of APISIX.
+func ValidateAnnotations(anno map[string]string) (apisix.Plugins, error) {
+	extractor := annotations.NewExtractor(anno)
+	names := make([]string, 0, len(_parsers))
+	for name := range _parsers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result error
+	for _, name := range names {
+		// The errors of plugins are collected by plugins.Validate.
+		if name == "Plugins" {
+			continue
+		}
+		if _, err := _parsers[name].Parse(extractor); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+	ps, err := plugins.Validate(extractor)
+	if err != nil {
+		result = multierror.Append(result, err)
+	}
+	if out, err := _parsers["GenericPlugins"].Parse(extractor); err == nil && out != nil {
+		for name, config := range out.(apisix.Plugins) {
+			ps[name] = config
+		}
+	}
+	return ps, result
+}
+
+// validateGenericPlugins validates the plugins of the plugin.<name>
+// annotations against the plugin schemas of the APISIX cluster. Unlike the
+// other annotations, the bad ones fail the translation, so that the errors
+// are surfaced as the events of the Ingress. The validation is skipped if the
+// schemas are unavailable, e.g. in the standalone mode, while the failures of
+// fetching them, e.g. timeouts, are errors so that the Ingress is retried.
+func (t *translator) validateGenericPlugins(anno map[string]string, clusterName string) error {
+	out, err := _parsers["GenericPlugins"].Parse(annotations.NewExtractor(anno))
+	if err != nil || out == nil || t.Apisix == nil {
+		return err
+	}
+	ps := out.(apisix.Plugins)
+	names := make([]string, 0, len(ps))
+	for name := range ps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result error
+	schemaClient := t.Apisix.Cluster(clusterName).Schema()
+	for _, name := range names {
+		schema, err := schemaClient.GetPluginSchema(context.TODO(), name)
+		if err == apisixclient.ErrFunctionDisabled || err == apisixclient.ErrClusterNotExist {
+			log.Infow("skip validating plugin annotations since plugin schemas are unavailable",
+				zap.String("cluster", clusterName),
+				zap.Error(err),
+			)
+			return nil
+		}
+		if err == apisixcache.ErrNotFound {
+			result = multierror.Append(result, fmt.Errorf("plugin %s is not found", name))
+			continue
+		}
+		if err != nil {
+			// The schema may be fetched once APISIX recovers, fail the
+			// translation so that it's retried instead of syncing the
+			// plugins unvalidated.
+			result = multierror.Append(result, fmt.Errorf("failed to get schema of plugin %s: %s", name, err))
+			continue
+		}
+		res, err := gojsonschema.Validate(gojsonschema.NewStringLoader(schema.Content), gojsonschema.NewGoLoader(ps[name]))
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to validate plugin %s: %s", name, err))
+			continue
+		}
+		for _, desc := range res.Errors() {
+			result = multierror.Append(result, fmt.Errorf("plugin %s is invalid: %s", name, desc))
+		}
+	}
+	return result
+}
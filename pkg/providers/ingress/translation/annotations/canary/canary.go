@@ -0,0 +1,70 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package canary
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+)
+
+const (
+	_defaultWeightTotal = 100
+)
+
+// Canary is the canary config of an Ingress. The canary Ingress is not
+// translated alone, its backend is merged onto the route of the primary
+// Ingress which has the same host and path.
+type Canary struct {
+	Weight      int
+	WeightTotal int
+	Header      string
+	HeaderValue string
+	Cookie      string
+}
+
+type canary struct{}
+
+func NewParser() annotations.IngressAnnotationsParser {
+	return &canary{}
+}
+
+func (c *canary) Parse(e annotations.Extractor) (interface{}, error) {
+	if !e.GetBoolAnnotation(annotations.AnnotationsCanary) {
+		return nil, nil
+	}
+	cfg := &Canary{
+		WeightTotal: _defaultWeightTotal,
+		Header:      e.GetStringAnnotation(annotations.AnnotationsCanaryByHeader),
+		HeaderValue: e.GetStringAnnotation(annotations.AnnotationsCanaryByHeaderValue),
+		Cookie:      e.GetStringAnnotation(annotations.AnnotationsCanaryByCookie),
+	}
+	if total := e.GetStringAnnotation(annotations.AnnotationsCanaryWeightTotal); total != "" {
+		weightTotal, err := strconv.Atoi(total)
+		if err != nil || weightTotal <= 0 {
+			return nil, fmt.Errorf("invalid canary weight total %s", total)
+		}
+		cfg.WeightTotal = weightTotal
+	}
+	if weight := e.GetStringAnnotation(annotations.AnnotationsCanaryWeight); weight != "" {
+		w, err := strconv.Atoi(weight)
+		if err != nil || w < 0 || w > cfg.WeightTotal {
+			return nil, fmt.Errorf("invalid canary weight %s, it should be in [0, %d]", weight, cfg.WeightTotal)
+		}
+		cfg.Weight = w
+	}
+	return cfg, nil
+}
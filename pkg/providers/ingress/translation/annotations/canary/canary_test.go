@@ -0,0 +1,68 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package canary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+)
+
+func TestCanaryHandler(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsCanaryWeight: "20",
+	}
+	p := NewParser()
+
+	out, err := p.Parse(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+
+	anno[annotations.AnnotationsCanary] = "true"
+	anno[annotations.AnnotationsCanaryByHeader] = "X-Canary"
+	anno[annotations.AnnotationsCanaryByCookie] = "canary"
+	out, err = p.Parse(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Equal(t, &Canary{
+		Weight:      20,
+		WeightTotal: 100,
+		Header:      "X-Canary",
+		Cookie:      "canary",
+	}, out)
+
+	anno[annotations.AnnotationsCanaryWeightTotal] = "10"
+	out, err = p.Parse(annotations.NewExtractor(anno))
+	assert.NotNil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+
+	anno[annotations.AnnotationsCanaryWeightTotal] = "1000"
+	anno[annotations.AnnotationsCanaryByHeaderValue] = "v2"
+	out, err = p.Parse(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Equal(t, &Canary{
+		Weight:      20,
+		WeightTotal: 1000,
+		Header:      "X-Canary",
+		HeaderValue: "v2",
+		Cookie:      "canary",
+	}, out)
+
+	anno[annotations.AnnotationsCanaryWeight] = "abc"
+	out, err = p.Parse(annotations.NewExtractor(anno))
+	assert.NotNil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+}
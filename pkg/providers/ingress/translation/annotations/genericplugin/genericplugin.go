@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package genericplugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"sigs.k8s.io/yaml"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type genericPlugin struct{}
+
+// NewParser creates a parser to convert the plugin.<name> annotations
+// to APISIX plugins, the config is passed through as is.
+func NewParser() annotations.IngressAnnotationsParser {
+	return &genericPlugin{}
+}
+
+func (g *genericPlugin) Parse(e annotations.Extractor) (interface{}, error) {
+	configs := e.GetAnnotationsWithPrefix(annotations.AnnotationsPluginPrefix)
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(configs))
+	for name := range configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var result error
+	plugins := make(apisixv1.Plugins, len(configs))
+	for _, name := range names {
+		if name == "" {
+			result = multierror.Append(result, fmt.Errorf("empty plugin name in annotation %s", annotations.AnnotationsPluginPrefix))
+			continue
+		}
+		config := make(map[string]interface{})
+		if value := strings.TrimSpace(configs[name]); value != "" {
+			// JSON is a subset of YAML.
+			if err := yaml.Unmarshal([]byte(value), &config); err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid config of plugin %s: %s", name, err))
+				continue
+			}
+		}
+		plugins[name] = config
+	}
+	if result != nil {
+		return nil, result
+	}
+	return plugins, nil
+}
@@ -0,0 +1,57 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package genericplugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestGenericPlugin(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsUseRegex: "true",
+	}
+	p := NewParser()
+
+	out, err := p.Parse(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+
+	anno[annotations.AnnotationsPluginPrefix+"echo"] = `{"before_body": "hello"}`
+	anno[annotations.AnnotationsPluginPrefix+"prometheus"] = ""
+	anno[annotations.AnnotationsPluginPrefix+"limit-count"] = "count: 2\ntime_window: 60\n"
+	out, err = p.Parse(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Equal(t, apisixv1.Plugins{
+		"echo": map[string]interface{}{
+			"before_body": "hello",
+		},
+		"prometheus": map[string]interface{}{},
+		"limit-count": map[string]interface{}{
+			"count":       float64(2),
+			"time_window": float64(60),
+		},
+	}, out)
+
+	anno[annotations.AnnotationsPluginPrefix+"echo"] = `["hello"]`
+	out, err = p.Parse(annotations.NewExtractor(anno))
+	assert.Nil(t, out, "checking given output")
+	assert.NotNil(t, err, "checking given error")
+	assert.Contains(t, err.Error(), "invalid config of plugin echo")
+}
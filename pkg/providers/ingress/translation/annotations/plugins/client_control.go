@@ -0,0 +1,78 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package plugins
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+var (
+	_sizeUnits = map[byte]int64{
+		'k': 1 << 10,
+		'm': 1 << 20,
+		'g': 1 << 30,
+	}
+)
+
+type clientControl struct{}
+
+// NewClientControlHandler creates a handler to convert annotations about
+// the client request body size to APISIX client-control plugin.
+func NewClientControlHandler() PluginAnnotationsHandler {
+	return &clientControl{}
+}
+
+func (c *clientControl) PluginName() string {
+	return "client-control"
+}
+
+func (c *clientControl) Handle(e annotations.Extractor) (interface{}, error) {
+	value := e.GetStringAnnotation(annotations.AnnotationsClientMaxBodySize)
+	if value == "" {
+		return nil, nil
+	}
+	size, err := parseSize(value)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixv1.ClientControlConfig{
+		MaxBodySize: size,
+	}, nil
+}
+
+// parseSize parses the size in the nginx style, like 1024, 8k and 1m,
+// 0 means the size is not limited.
+func parseSize(value string) (int64, error) {
+	num := strings.ToLower(value)
+	unit := int64(1)
+	if n, ok := _sizeUnits[num[len(num)-1]]; ok {
+		unit = n
+		num = num[:len(num)-1]
+	}
+	size, err := strconv.ParseInt(num, 10, 64)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("invalid size %s", value)
+	}
+	if size > math.MaxInt64/unit {
+		return 0, fmt.Errorf("size %s is too large", value)
+	}
+	return size * unit, nil
+}
@@ -0,0 +1,53 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestClientControlHandler(t *testing.T) {
+	p := NewClientControlHandler()
+	for value, size := range map[string]int64{
+		"0":                   0,
+		"1024":                1024,
+		"8k":                  8 << 10,
+		"1M":                  1 << 20,
+		"2g":                  2 << 30,
+		"8589934591g":         8589934591 << 30,
+		"9223372036854775807": 9223372036854775807,
+	} {
+		anno := map[string]string{
+			annotations.AnnotationsClientMaxBodySize: value,
+		}
+		out, err := p.Handle(annotations.NewExtractor(anno))
+		assert.Nil(t, err, "checking given error")
+		assert.Equal(t, &apisixv1.ClientControlConfig{MaxBodySize: size}, out)
+	}
+
+	for _, value := range []string{"m", "1t", "-1k", "8589934592g", "9223372036854775807k"} {
+		anno := map[string]string{
+			annotations.AnnotationsClientMaxBodySize: value,
+		}
+		out, err := p.Handle(annotations.NewExtractor(anno))
+		assert.NotNil(t, err, "checking given error")
+		assert.Nil(t, out, "checking given output")
+	}
+}
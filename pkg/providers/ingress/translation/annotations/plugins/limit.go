@@ -0,0 +1,197 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package plugins
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	_defaultLimitKey              = "remote_addr"
+	_defaultLimitCountTimeWindow  = 60
+	_defaultLimitConnDefaultDelay = 0.1
+)
+
+// limitOptions returns the key and the rejected code shared by the
+// limit-count, limit-req and limit-conn plugins.
+func limitOptions(e annotations.Extractor) (string, int, error) {
+	key := e.GetStringAnnotation(annotations.AnnotationsLimitKey)
+	if key == "" {
+		key = _defaultLimitKey
+	}
+	var code int
+	if value := e.GetStringAnnotation(annotations.AnnotationsLimitRejectedCode); value != "" {
+		var err error
+		code, err = strconv.Atoi(value)
+		if err != nil || code < 200 || code > 599 {
+			return "", 0, fmt.Errorf("invalid rejected code %s", value)
+		}
+	}
+	return key, code, nil
+}
+
+// positiveInt parses the annotation as a positive integer, the default
+// value is returned if the annotation is missing.
+func positiveInt(e annotations.Extractor, name string, defaultValue int) (int, error) {
+	value := e.GetStringAnnotation(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid %s %s, it should be a positive integer", name, value)
+	}
+	return n, nil
+}
+
+// nonNegativeFloat parses the annotation as a non-negative number, the
+// default value is returned if the annotation is missing.
+func nonNegativeFloat(e annotations.Extractor, name string, defaultValue float64) (float64, error) {
+	value := e.GetStringAnnotation(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s %s, it should be a non-negative number", name, value)
+	}
+	return n, nil
+}
+
+type limitCount struct{}
+
+// NewLimitCountHandler creates a handler to convert annotations about
+// the request count limiting to APISIX limit-count plugin.
+func NewLimitCountHandler() PluginAnnotationsHandler {
+	return &limitCount{}
+}
+
+func (l *limitCount) PluginName() string {
+	return "limit-count"
+}
+
+func (l *limitCount) Handle(e annotations.Extractor) (interface{}, error) {
+	if e.GetStringAnnotation(annotations.AnnotationsLimitCount) == "" {
+		return nil, nil
+	}
+	count, err := positiveInt(e, annotations.AnnotationsLimitCount, 0)
+	if err != nil {
+		return nil, err
+	}
+	timeWindow, err := positiveInt(e, annotations.AnnotationsLimitCountTimeWindow, _defaultLimitCountTimeWindow)
+	if err != nil {
+		return nil, err
+	}
+	key, code, err := limitOptions(e)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixv1.LimitCountConfig{
+		Count:        count,
+		TimeWindow:   timeWindow,
+		Key:          key,
+		RejectedCode: code,
+	}, nil
+}
+
+type limitReq struct{}
+
+// NewLimitReqHandler creates a handler to convert annotations about
+// the request rate limiting to APISIX limit-req plugin.
+func NewLimitReqHandler() PluginAnnotationsHandler {
+	return &limitReq{}
+}
+
+func (l *limitReq) PluginName() string {
+	return "limit-req"
+}
+
+func (l *limitReq) Handle(e annotations.Extractor) (interface{}, error) {
+	if e.GetStringAnnotation(annotations.AnnotationsLimitReqRate) == "" {
+		return nil, nil
+	}
+	rate, err := nonNegativeFloat(e, annotations.AnnotationsLimitReqRate, 0)
+	if err != nil {
+		return nil, err
+	}
+	if rate == 0 {
+		return nil, fmt.Errorf("invalid %s 0, it should be a positive number", annotations.AnnotationsLimitReqRate)
+	}
+	burst, err := nonNegativeFloat(e, annotations.AnnotationsLimitReqBurst, 0)
+	if err != nil {
+		return nil, err
+	}
+	key, code, err := limitOptions(e)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixv1.LimitReqConfig{
+		Rate:         rate,
+		Burst:        burst,
+		Key:          key,
+		RejectedCode: code,
+	}, nil
+}
+
+type limitConn struct{}
+
+// NewLimitConnHandler creates a handler to convert annotations about
+// the concurrent connections limiting to APISIX limit-conn plugin.
+func NewLimitConnHandler() PluginAnnotationsHandler {
+	return &limitConn{}
+}
+
+func (l *limitConn) PluginName() string {
+	return "limit-conn"
+}
+
+func (l *limitConn) Handle(e annotations.Extractor) (interface{}, error) {
+	if e.GetStringAnnotation(annotations.AnnotationsLimitConn) == "" {
+		return nil, nil
+	}
+	conn, err := positiveInt(e, annotations.AnnotationsLimitConn, 0)
+	if err != nil {
+		return nil, err
+	}
+	burst := 0
+	if value := e.GetStringAnnotation(annotations.AnnotationsLimitConnBurst); value != "" {
+		burst, err = strconv.Atoi(value)
+		if err != nil || burst < 0 {
+			return nil, fmt.Errorf("invalid %s %s, it should be a non-negative integer", annotations.AnnotationsLimitConnBurst, value)
+		}
+	}
+	delay, err := nonNegativeFloat(e, annotations.AnnotationsLimitConnDefaultConnDelay, _defaultLimitConnDefaultDelay)
+	if err != nil {
+		return nil, err
+	}
+	if delay == 0 {
+		return nil, fmt.Errorf("invalid %s 0, it should be a positive number", annotations.AnnotationsLimitConnDefaultConnDelay)
+	}
+	key, code, err := limitOptions(e)
+	if err != nil {
+		return nil, err
+	}
+	return &apisixv1.LimitConnConfig{
+		Conn:             conn,
+		Burst:            burst,
+		DefaultConnDelay: delay,
+		Key:              key,
+		RejectedCode:     code,
+	}, nil
+}
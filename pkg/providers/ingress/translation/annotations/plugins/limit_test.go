@@ -0,0 +1,116 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package plugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestLimitCountHandler(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsLimitCount: "100",
+	}
+	p := NewLimitCountHandler()
+	out, err := p.Handle(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Equal(t, &apisixv1.LimitCountConfig{
+		Count:      100,
+		TimeWindow: 60,
+		Key:        "remote_addr",
+	}, out)
+	assert.Equal(t, "limit-count", p.PluginName())
+
+	anno[annotations.AnnotationsLimitCountTimeWindow] = "1"
+	anno[annotations.AnnotationsLimitKey] = "http_x_real_ip"
+	anno[annotations.AnnotationsLimitRejectedCode] = "429"
+	out, err = p.Handle(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Equal(t, &apisixv1.LimitCountConfig{
+		Count:        100,
+		TimeWindow:   1,
+		Key:          "http_x_real_ip",
+		RejectedCode: 429,
+	}, out)
+
+	anno[annotations.AnnotationsLimitRejectedCode] = "100"
+	out, err = p.Handle(annotations.NewExtractor(anno))
+	assert.NotNil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+
+	anno[annotations.AnnotationsLimitCount] = "-1"
+	out, err = p.Handle(annotations.NewExtractor(anno))
+	assert.NotNil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+}
+
+func TestLimitReqHandler(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsLimitReqRate:  "10.5",
+		annotations.AnnotationsLimitReqBurst: "5",
+	}
+	p := NewLimitReqHandler()
+	out, err := p.Handle(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Equal(t, &apisixv1.LimitReqConfig{
+		Rate:  10.5,
+		Burst: 5,
+		Key:   "remote_addr",
+	}, out)
+
+	anno[annotations.AnnotationsLimitReqRate] = "0"
+	out, err = p.Handle(annotations.NewExtractor(anno))
+	assert.NotNil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+
+	delete(anno, annotations.AnnotationsLimitReqRate)
+	out, err = p.Handle(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+}
+
+func TestLimitConnHandler(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsLimitConn: "10",
+	}
+	p := NewLimitConnHandler()
+	out, err := p.Handle(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Equal(t, &apisixv1.LimitConnConfig{
+		Conn:             10,
+		DefaultConnDelay: 0.1,
+		Key:              "remote_addr",
+	}, out)
+
+	anno[annotations.AnnotationsLimitConnBurst] = "5"
+	anno[annotations.AnnotationsLimitConnDefaultConnDelay] = "1"
+	out, err = p.Handle(annotations.NewExtractor(anno))
+	assert.Nil(t, err, "checking given error")
+	assert.Equal(t, &apisixv1.LimitConnConfig{
+		Conn:             10,
+		Burst:            5,
+		DefaultConnDelay: 1,
+		Key:              "remote_addr",
+	}, out)
+
+	anno[annotations.AnnotationsLimitConnBurst] = "-5"
+	out, err = p.Handle(annotations.NewExtractor(anno))
+	assert.NotNil(t, err, "checking given error")
+	assert.Nil(t, out, "checking given output")
+}
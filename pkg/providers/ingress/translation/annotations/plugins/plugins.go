@@ -15,6 +15,7 @@
 package plugins
 
 import (
+	"github.com/hashicorp/go-multierror"
 	"go.uber.org/zap"
 
 	"github.com/apache/apisix-ingress-controller/pkg/log"
@@ -45,6 +46,10 @@ var (
 		NewCSRFHandler(),
 		NewHttpMethodHandler(),
 		NewResponseRewriteHandler(),
+		NewLimitCountHandler(),
+		NewLimitReqHandler(),
+		NewLimitConnHandler(),
+		NewClientControlHandler(),
 	}
 )
 
@@ -55,18 +60,29 @@ func NewParser() annotations.IngressAnnotationsParser {
 }
 
 func (p *plugins) Parse(e annotations.Extractor) (interface{}, error) {
+	plugins, err := Validate(e)
+	if err != nil {
+		log.Warnw("failed to handle annotations",
+			zap.Error(err),
+		)
+	}
+	return plugins, nil
+}
+
+// Validate converts the annotations to APISIX plugins like Parse, but the
+// errors of the bad annotations are returned instead of being ignored.
+func Validate(e annotations.Extractor) (apisix.Plugins, error) {
+	var result error
 	plugins := make(apisix.Plugins)
 	for _, handler := range _handlers {
 		out, err := handler.Handle(e)
 		if err != nil {
-			log.Warnw("failed to handle annotations",
-				zap.Error(err),
-			)
+			result = multierror.Append(result, err)
 			continue
 		}
 		if out != nil {
 			plugins[handler.PluginName()] = out
 		}
 	}
-	return plugins, nil
+	return plugins, result
 }
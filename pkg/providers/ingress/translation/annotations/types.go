@@ -27,6 +27,12 @@ const (
 	AnnotationsEnableWebSocket  = AnnotationsPrefix + "enable-websocket"
 	AnnotationsPluginConfigName = AnnotationsPrefix + "plugin-config-name"
 	AnnotationsUpstreamScheme   = AnnotationsPrefix + "upstream-scheme"
+
+	// upstream timeouts in seconds and retries
+	AnnotationsUpstreamConnectTimeout = AnnotationsPrefix + "upstream-connect-timeout"
+	AnnotationsUpstreamSendTimeout    = AnnotationsPrefix + "upstream-send-timeout"
+	AnnotationsUpstreamReadTimeout    = AnnotationsPrefix + "upstream-read-timeout"
+	AnnotationsUpstreamRetries        = AnnotationsPrefix + "upstream-retries"
 )
 
 const (
@@ -77,8 +83,50 @@ const (
 	// auth-type: keyAuth | basicAuth
 	AnnotationsAuthType = AnnotationsPrefix + "auth-type"
 
+	// limit-count plugin, the time window is in seconds
+	AnnotationsLimitCount           = AnnotationsPrefix + "limit-count"
+	AnnotationsLimitCountTimeWindow = AnnotationsPrefix + "limit-count-time-window"
+
+	// limit-req plugin
+	AnnotationsLimitReqRate  = AnnotationsPrefix + "limit-req-rate"
+	AnnotationsLimitReqBurst = AnnotationsPrefix + "limit-req-burst"
+
+	// limit-conn plugin, the default connection delay is in seconds
+	AnnotationsLimitConn                 = AnnotationsPrefix + "limit-conn"
+	AnnotationsLimitConnBurst            = AnnotationsPrefix + "limit-conn-burst"
+	AnnotationsLimitConnDefaultConnDelay = AnnotationsPrefix + "limit-conn-default-conn-delay"
+
+	// shared by limit-count, limit-req and limit-conn plugins
+	AnnotationsLimitKey          = AnnotationsPrefix + "limit-key"
+	AnnotationsLimitRejectedCode = AnnotationsPrefix + "limit-rejected-code"
+
+	// client-control plugin, the size is in bytes, or with a k, m or g suffix
+	AnnotationsClientMaxBodySize = AnnotationsPrefix + "client-max-body-size"
+
 	// support backend service cross namespace
 	AnnotationsSvcNamespace = AnnotationsPrefix + "svc-namespace"
+
+	// plugin.<name>: <config> enables any APISIX plugin, the config is
+	// in JSON or YAML
+	AnnotationsPluginPrefix = AnnotationsPrefix + "plugin."
+)
+
+const (
+	// Supported the annotations of the canary Ingress, which are translated
+	// into the traffic-split plugin of the primary Ingress.
+
+	// canary: "true" marks the Ingress as a canary
+	AnnotationsCanary = AnnotationsPrefix + "canary"
+	// weighted traffic split, the total weight is 100 by default
+	AnnotationsCanaryWeight      = AnnotationsPrefix + "canary-weight"
+	AnnotationsCanaryWeightTotal = AnnotationsPrefix + "canary-weight-total"
+	// header based traffic split, requests whose header value is "always"
+	// (or the canary-by-header-value) go to the canary, "never" go to the primary
+	AnnotationsCanaryByHeader      = AnnotationsPrefix + "canary-by-header"
+	AnnotationsCanaryByHeaderValue = AnnotationsPrefix + "canary-by-header-value"
+	// cookie based traffic split, requests whose cookie value is "always"
+	// go to the canary, "never" go to the primary
+	AnnotationsCanaryByCookie = AnnotationsPrefix + "canary-by-cookie"
 )
 
 // Handler abstracts the behavior so that the apisix-ingress-controller knows
@@ -102,6 +150,10 @@ type Extractor interface {
 	// When value is "true", true will be given, other values will be treated as
 	// false.
 	GetBoolAnnotation(string) bool
+	// GetAnnotationsWithPrefix returns the annotations whose names have the
+	// given prefix, the prefix is trimmed from the names. When there are no
+	// such annotations, an empty map will be given.
+	GetAnnotationsWithPrefix(string) map[string]string
 }
 
 type extractor struct {
@@ -124,6 +176,16 @@ func (e *extractor) GetBoolAnnotation(name string) bool {
 	return e.annotations[name] == "true"
 }
 
+func (e *extractor) GetAnnotationsWithPrefix(prefix string) map[string]string {
+	annotations := make(map[string]string)
+	for name, value := range e.annotations {
+		if strings.HasPrefix(name, prefix) {
+			annotations[strings.TrimPrefix(name, prefix)] = value
+		}
+	}
+	return annotations
+}
+
 // NewExtractor creates an annotation extractor.
 func NewExtractor(annotations map[string]string) Extractor {
 	return &extractor{
@@ -0,0 +1,40 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package upstreamretries
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+)
+
+type upstreamretries struct{}
+
+func NewParser() annotations.IngressAnnotationsParser {
+	return &upstreamretries{}
+}
+
+func (u *upstreamretries) Parse(e annotations.Extractor) (interface{}, error) {
+	value := e.GetStringAnnotation(annotations.AnnotationsUpstreamRetries)
+	if value == "" {
+		return nil, nil
+	}
+	retries, err := strconv.Atoi(value)
+	if err != nil || retries < 0 {
+		return nil, fmt.Errorf("invalid upstream retries %s, it should be a non-negative integer", value)
+	}
+	return &retries, nil
+}
@@ -0,0 +1,65 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package upstreamtimeout
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+type upstreamtimeout struct{}
+
+func NewParser() annotations.IngressAnnotationsParser {
+	return &upstreamtimeout{}
+}
+
+// Parse returns the upstream timeout, the missing ones take the default
+// timeout of APISIX.
+func (u *upstreamtimeout) Parse(e annotations.Extractor) (interface{}, error) {
+	var (
+		timeout = &apisixv1.UpstreamTimeout{
+			Connect: apisixv1.DefaultUpstreamTimeout,
+			Send:    apisixv1.DefaultUpstreamTimeout,
+			Read:    apisixv1.DefaultUpstreamTimeout,
+		}
+		found bool
+	)
+	for _, item := range []struct {
+		name  string
+		field *int
+	}{
+		{annotations.AnnotationsUpstreamConnectTimeout, &timeout.Connect},
+		{annotations.AnnotationsUpstreamSendTimeout, &timeout.Send},
+		{annotations.AnnotationsUpstreamReadTimeout, &timeout.Read},
+	} {
+		value := e.GetStringAnnotation(item.name)
+		if value == "" {
+			continue
+		}
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds <= 0 {
+			return nil, fmt.Errorf("invalid %s %s, it should be a positive integer", item.name, value)
+		}
+		*item.field = seconds
+		found = true
+	}
+	if !found {
+		return nil, nil
+	}
+	return timeout, nil
+}
@@ -15,11 +15,17 @@
 package translation
 
 import (
+	"context"
+	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	apisixclient "github.com/apache/apisix-ingress-controller/pkg/apisix"
+	apisixcache "github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
 	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations/canary"
 	apisix "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
 )
 
@@ -81,3 +87,188 @@ func TestAnnotationsServiceNamespace(t *testing.T) {
 	ingress := (&translator{}).TranslateAnnotations(anno)
 	assert.Equal(t, "mynamespace", ingress.ServiceNamespace)
 }
+
+func TestAnnotationsCanary(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsCanary:       "true",
+		annotations.AnnotationsCanaryWeight: "30",
+	}
+
+	ingress := (&translator{}).TranslateAnnotations(anno)
+	assert.Equal(t, &canary.Canary{
+		Weight:      30,
+		WeightTotal: 100,
+	}, ingress.Canary)
+}
+
+func TestAnnotationsUpstreamTimeoutAndRetries(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsUpstreamConnectTimeout: "5",
+		annotations.AnnotationsUpstreamRetries:        "2",
+	}
+
+	ingress := (&translator{}).TranslateAnnotations(anno)
+	assert.Equal(t, &apisix.UpstreamTimeout{
+		Connect: 5,
+		Send:    60,
+		Read:    60,
+	}, ingress.UpstreamTimeout)
+	assert.Equal(t, 2, *ingress.UpstreamRetries)
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsLimitConn:         "10",
+		annotations.AnnotationsClientMaxBodySize: "1m",
+	}
+
+	plugins, err := ValidateAnnotations(anno)
+	assert.Nil(t, err)
+	assert.Len(t, plugins, 2)
+	assert.Contains(t, plugins, "limit-conn")
+	assert.Contains(t, plugins, "client-control")
+
+	anno[annotations.AnnotationsClientMaxBodySize] = "1x"
+	anno[annotations.AnnotationsUpstreamScheme] = "ftp"
+	plugins, err = ValidateAnnotations(anno)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid size 1x")
+	assert.Contains(t, err.Error(), "scheme ftp is not supported")
+	assert.Len(t, plugins, 1)
+}
+
+type fakeSchemaAPISIX struct {
+	apisixclient.APISIX
+	schemas map[string]string
+	errs    map[string]error
+}
+
+func (f *fakeSchemaAPISIX) Cluster(_ string) apisixclient.Cluster {
+	return &fakeSchemaCluster{schema: &fakeSchema{schemas: f.schemas, errs: f.errs}}
+}
+
+type fakeSchemaCluster struct {
+	apisixclient.Cluster
+	schema apisixclient.Schema
+}
+
+func (f *fakeSchemaCluster) Schema() apisixclient.Schema {
+	return f.schema
+}
+
+type fakeSchema struct {
+	apisixclient.Schema
+	schemas map[string]string
+	errs    map[string]error
+}
+
+func (f *fakeSchema) GetPluginSchema(_ context.Context, name string) (*apisix.Schema, error) {
+	if err, ok := f.errs[name]; ok {
+		return nil, err
+	}
+	if content, ok := f.schemas[name]; ok {
+		return &apisix.Schema{Name: name, Content: content}, nil
+	}
+	return nil, apisixcache.ErrNotFound
+}
+
+func TestAnnotationsGenericPlugins(t *testing.T) {
+	anno := map[string]string{
+		annotations.AnnotationsEnableCsrf:               "true",
+		annotations.AnnotationsCsrfKey:                  "csrf-key",
+		annotations.AnnotationsPluginPrefix + "csrf":    `{"key": "generic-key"}`,
+		annotations.AnnotationsPluginPrefix + "echo":    `{"before_body": "hello"}`,
+		annotations.AnnotationsPluginPrefix + "real-ip": `source: http_x_forwarded_for`,
+	}
+
+	// The plugin.<name> annotations take precedence.
+	ingress := (&translator{}).TranslateAnnotations(anno)
+	assert.Equal(t, apisix.Plugins{
+		"csrf": map[string]interface{}{
+			"key": "generic-key",
+		},
+		"echo": map[string]interface{}{
+			"before_body": "hello",
+		},
+		"real-ip": map[string]interface{}{
+			"source": "http_x_forwarded_for",
+		},
+	}, ingress.Plugins)
+
+	tr := &translator{
+		TranslatorOptions: &TranslatorOptions{
+			Apisix: &fakeSchemaAPISIX{
+				schemas: map[string]string{
+					"csrf":    `{"type":"object","properties":{"key":{"type":"string"}},"required":["key"]}`,
+					"echo":    `{"type":"object","properties":{"before_body":{"type":"string"}}}`,
+					"real-ip": `{"type":"object","properties":{"source":{"type":"string","minLength":1}},"required":["source"]}`,
+				},
+			},
+		},
+	}
+	assert.Nil(t, tr.validateGenericPlugins(anno, "default"))
+
+	anno[annotations.AnnotationsPluginPrefix+"echo"] = `{"before_body": 1}`
+	anno[annotations.AnnotationsPluginPrefix+"unknown"] = `{}`
+	err := tr.validateGenericPlugins(anno, "default")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "plugin echo is invalid")
+	assert.Contains(t, err.Error(), "plugin unknown is not found")
+
+	anno[annotations.AnnotationsPluginPrefix+"bad-yaml"] = `{`
+	err = tr.validateGenericPlugins(anno, "default")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid config of plugin bad-yaml")
+}
+
+func TestValidateGenericPluginsSchemaFailure(t *testing.T) {
+	tr := &translator{
+		TranslatorOptions: &TranslatorOptions{
+			Apisix: &fakeSchemaAPISIX{
+				schemas: map[string]string{
+					"echo": `{"type":"object","properties":{"before_body":{"type":"string"}}}`,
+				},
+				errs: map[string]error{
+					"csrf": errors.New("context deadline exceeded"),
+				},
+			},
+		},
+	}
+	anno := map[string]string{
+		annotations.AnnotationsPluginPrefix + "echo": `{"before_body": "hello"}`,
+		annotations.AnnotationsPluginPrefix + "csrf": `{"key": "csrf-key"}`,
+	}
+	// The plugins can't be validated for now, the Ingress should be retried.
+	err := tr.validateGenericPlugins(anno, "default")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "failed to get schema of plugin csrf: context deadline exceeded")
+	assert.NotContains(t, err.Error(), "plugin echo")
+}
+
+func TestValidateGenericPluginsWithoutSchemas(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client, err := apisixclient.NewClient("v3")
+	assert.Nil(t, err)
+	err = client.AddCluster(ctx, &apisixclient.ClusterOptions{
+		Name:               "standalone",
+		StandaloneRenderer: apisixclient.NewFileRenderer(filepath.Join(t.TempDir(), "apisix.yaml")),
+	})
+	assert.Nil(t, err)
+
+	tr := &translator{
+		TranslatorOptions: &TranslatorOptions{
+			Apisix: client,
+		},
+	}
+	anno := map[string]string{
+		annotations.AnnotationsPluginPrefix + "echo": `{"before_body": 1}`,
+	}
+	// Schemas can't be fetched from a standalone or missing cluster.
+	assert.Nil(t, tr.validateGenericPlugins(anno, "standalone"))
+	assert.Nil(t, tr.validateGenericPlugins(anno, "missing"))
+
+	anno[annotations.AnnotationsPluginPrefix+"bad-yaml"] = `{`
+	assert.NotNil(t, tr.validateGenericPlugins(anno, "standalone"))
+}
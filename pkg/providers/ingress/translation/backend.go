@@ -0,0 +1,228 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	kubev2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	// DefaultBackendIndex is the name of the Ingress informer index, which
+	// contains the Ingresses with default backends.
+	DefaultBackendIndex       = "defaultBackend"
+	_defaultBackendIndexValue = "true"
+
+	// _defaultBackendPath is used to compose the names of the default backend
+	// routes, it never conflicts with the paths of Ingress rules, which must be
+	// absolute.
+	_defaultBackendPath = "<default-backend>"
+	// _defaultBackendPriority makes the default backend routes lower than
+	// routes translated from Ingress rules, so they only catch the requests
+	// that match no rule.
+	_defaultBackendPriority = -1
+)
+
+// translateUpstreamFromResource translates the ApisixUpstream referred by the
+// resource backend of Ingress. Since there is no Service to resolve, the
+// ApisixUpstream must have external nodes or discovery configuration.
+func (t *translator) translateUpstreamFromResource(namespace string, resource *corev1.TypedLocalObjectReference,
+	skipVerify bool) (*apisixv1.Upstream, error) {
+	if resource.APIGroup == nil || *resource.APIGroup != kubev2.GroupName || resource.Kind != "ApisixUpstream" {
+		return nil, &translation.TranslateError{
+			Field:  "resource",
+			Reason: "only ApisixUpstream of apisix.apache.org is supported",
+		}
+	}
+	if skipVerify {
+		ups := apisixv1.NewDefaultUpstream()
+		ups.Name = apisixv1.ComposeExternalUpstreamName(namespace, resource.Name)
+		ups.ID = id.GenID(ups.Name)
+		return ups, nil
+	}
+	return t.ApisixTranslator.TranslateExternalApisixUpstream(namespace, resource.Name)
+}
+
+// hasDefaultBackend tells whether the Ingress has a default backend, the
+// default backends of canary Ingresses are ignored since they are merged onto
+// the primary ones.
+func hasDefaultBackend(ing kube.Ingress) bool {
+	if annotations.NewExtractor(ing.Object().GetAnnotations()).GetBoolAnnotation(annotations.AnnotationsCanary) {
+		return false
+	}
+	switch ing.GroupVersion() {
+	case kube.IngressV1:
+		return ing.V1().Spec.DefaultBackend != nil
+	case kube.IngressV1beta1:
+		return ing.V1beta1().Spec.Backend != nil
+	default:
+		return ing.ExtensionsV1beta1().Spec.Backend != nil
+	}
+}
+
+func (t *translator) HasGlobalDefaultBackend(ing kube.Ingress) bool {
+	return hasDefaultBackend(ing)
+}
+
+// DefaultBackendIndexers returns the index of Ingresses which have default
+// backends, it should be added to the Ingress informer before it starts.
+func DefaultBackendIndexers() cache.Indexers {
+	return cache.Indexers{DefaultBackendIndex: defaultBackendIndexFunc}
+}
+
+func defaultBackendIndexFunc(obj interface{}) ([]string, error) {
+	ing, err := kube.NewIngress(obj)
+	if err != nil || !hasDefaultBackend(ing) {
+		return nil, nil
+	}
+	return []string{_defaultBackendIndexValue}, nil
+}
+
+// ListDefaultBackendIngresses lists the Ingresses which have default
+// backends from the indexer of the Ingress informer.
+func ListDefaultBackendIngresses(indexer cache.Indexer) ([]kube.Ingress, error) {
+	objs, err := indexer.ByIndex(DefaultBackendIndex, _defaultBackendIndexValue)
+	if err != nil {
+		return nil, err
+	}
+	ings := make([]kube.Ingress, 0, len(objs))
+	for _, obj := range objs {
+		ing, err := kube.NewIngress(obj)
+		if err != nil {
+			return nil, err
+		}
+		ings = append(ings, ing)
+	}
+	return ings, nil
+}
+
+// GlobalDefaultBackendOwner returns the key of the Ingress whose global
+// default backend route is created. These routes conflict with each other,
+// so only the first Ingress in namespace/name order, which is effective and
+// in the watched namespaces, owns it.
+func (t *translator) GlobalDefaultBackendOwner() (string, error) {
+	if t.IngressIndexer == nil {
+		return "", nil
+	}
+	ings, err := ListDefaultBackendIngresses(t.IngressIndexer)
+	if err != nil {
+		return "", err
+	}
+	var owner string
+	for _, ing := range ings {
+		key := ing.Object().GetNamespace() + "/" + ing.Object().GetName()
+		if owner != "" && key >= owner {
+			continue
+		}
+		if t.IsWatchingNamespace != nil && !t.IsWatchingNamespace(key) {
+			continue
+		}
+		if t.IsIngressEffective(ing) {
+			owner = key
+		}
+	}
+	return owner, nil
+}
+
+// defaultBackendHosts returns the hosts which the default backend routes are
+// created for, that is, the empty host which stands for the global catch-all
+// route, and the distinct hosts of Ingress rules.
+func defaultBackendHosts(ing kube.Ingress) []string {
+	var ruleHosts []string
+	switch ing.GroupVersion() {
+	case kube.IngressV1:
+		for _, rule := range ing.V1().Spec.Rules {
+			ruleHosts = append(ruleHosts, rule.Host)
+		}
+	case kube.IngressV1beta1:
+		for _, rule := range ing.V1beta1().Spec.Rules {
+			ruleHosts = append(ruleHosts, rule.Host)
+		}
+	default:
+		for _, rule := range ing.ExtensionsV1beta1().Spec.Rules {
+			ruleHosts = append(ruleHosts, rule.Host)
+		}
+	}
+
+	hosts := []string{""}
+	seen := map[string]struct{}{"": {}}
+	for _, host := range ruleHosts {
+		if _, ok := seen[host]; ok {
+			continue
+		}
+		seen[host] = struct{}{}
+		hosts = append(hosts, host)
+	}
+	return hosts
+}
+
+func composeDefaultBackendRouteNames(ing kube.Ingress) []string {
+	namespace, name := ing.Object().GetNamespace(), ing.Object().GetName()
+	var names []string
+	for _, host := range defaultBackendHosts(ing) {
+		names = append(names, composeIngressRouteName(namespace, name, host, _defaultBackendPath))
+	}
+	return names
+}
+
+// translateDefaultBackendRoutes composes the catch-all routes of the Ingress
+// default backend, one for each host of the Ingress rules, and a global one
+// without host if the Ingress owns it, see GlobalDefaultBackendOwner.
+func (t *translator) translateDefaultBackendRoutes(ctx *translation.TranslateContext, ing kube.Ingress, ups *apisixv1.Upstream, ingress *Ingress) error {
+	namespace, name := ing.Object().GetNamespace(), ing.Object().GetName()
+	for _, host := range defaultBackendHosts(ing) {
+		if host == "" && t.IngressIndexer != nil {
+			owner, err := t.GlobalDefaultBackendOwner()
+			if err != nil {
+				return err
+			}
+			if owner != namespace+"/"+name {
+				log.Warnw("skip the global default backend route since it's owned by another ingress",
+					zap.String("namespace", namespace),
+					zap.String("name", name),
+					zap.String("owner", owner),
+				)
+				continue
+			}
+		}
+		route := apisixv1.NewDefaultRoute()
+		route.Name = composeIngressRouteName(namespace, name, host, _defaultBackendPath)
+		route.ID = id.GenID(route.Name)
+		route.Host = host
+		route.Uris = []string{"/*"}
+		route.Priority = _defaultBackendPriority
+		route.EnableWebsocket = ingress.EnableWebSocket
+		route.UpstreamId = ups.ID
+		if len(ingress.Plugins) > 0 {
+			route.Plugins = *(ingress.Plugins.DeepCopy())
+		}
+		if ingress.PluginConfigName != "" {
+			route.PluginConfigId = id.GenID(apisixv1.ComposePluginConfigName(namespace, ingress.PluginConfigName))
+		}
+		ctx.AddRoute(route)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package translation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestTranslateUpstreamFromResource(t *testing.T) {
+	tr := &translator{}
+	group := "apisix.apache.org"
+	ups, err := tr.translateUpstreamFromResource("default", &corev1.TypedLocalObjectReference{
+		APIGroup: &group,
+		Kind:     "ApisixUpstream",
+		Name:     "httpbin",
+	}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, apisixv1.ComposeExternalUpstreamName("default", "httpbin"), ups.Name)
+	assert.Equal(t, id.GenID(ups.Name), ups.ID)
+
+	_, err = tr.translateUpstreamFromResource("default", &corev1.TypedLocalObjectReference{
+		Kind: "ApisixUpstream",
+		Name: "httpbin",
+	}, true)
+	assert.Equal(t, &translation.TranslateError{
+		Field:  "resource",
+		Reason: "only ApisixUpstream of apisix.apache.org is supported",
+	}, err)
+
+	_, err = tr.translateUpstreamFromResource("default", &corev1.TypedLocalObjectReference{
+		APIGroup: &group,
+		Kind:     "ApisixRoute",
+		Name:     "httpbin",
+	}, true)
+	assert.NotNil(t, err)
+}
+
+func TestTranslateDefaultBackendRoutes(t *testing.T) {
+	ing := kube.MustNewIngress(&networkingv1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Ingress",
+			APIVersion: "networking.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "httpbin",
+			Namespace: "default",
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{Host: "a.com"},
+				{Host: "b.com"},
+				{Host: "a.com"},
+				{},
+			},
+		},
+	})
+	assert.Equal(t, []string{"", "a.com", "b.com"}, defaultBackendHosts(ing))
+
+	ups := apisixv1.NewDefaultUpstream()
+	ups.ID = "1"
+	ctx := translation.DefaultEmptyTranslateContext()
+	tr := &translator{TranslatorOptions: &TranslatorOptions{}}
+	assert.Nil(t, tr.translateDefaultBackendRoutes(ctx, ing, ups, &Ingress{
+		EnableWebSocket:  true,
+		PluginConfigName: "echo",
+	}))
+	names := composeDefaultBackendRouteNames(ing)
+	assert.Len(t, ctx.Routes, 3)
+	for i, route := range ctx.Routes {
+		assert.Equal(t, names[i], route.Name)
+		assert.Equal(t, defaultBackendHosts(ing)[i], route.Host)
+		assert.Equal(t, []string{"/*"}, route.Uris)
+		assert.Equal(t, _defaultBackendPriority, route.Priority)
+		assert.Equal(t, "1", route.UpstreamId)
+		assert.True(t, route.EnableWebsocket)
+		assert.Equal(t, id.GenID(apisixv1.ComposePluginConfigName("default", "echo")), route.PluginConfigId)
+	}
+}
+
+func newDefaultBackendIngress(namespace, name string, hosts ...string) *networkingv1.Ingress {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Annotations: map[string]string{
+				_ingressClassAnnotation: config.IngressClass,
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			DefaultBackend: &networkingv1.IngressBackend{
+				Service: &networkingv1.IngressServiceBackend{
+					Name: "httpbin",
+					Port: networkingv1.ServiceBackendPort{Number: 80},
+				},
+			},
+		},
+	}
+	for _, host := range hosts {
+		ing.Spec.Rules = append(ing.Spec.Rules, networkingv1.IngressRule{Host: host})
+	}
+	return ing
+}
+
+func TestTranslateGlobalDefaultBackendRoute(t *testing.T) {
+	unwatched := kube.MustNewIngress(newDefaultBackendIngress("apisix", "a"))
+	other := kube.MustNewIngress(newDefaultBackendIngress("default", "a", "a.com"))
+	ing := kube.MustNewIngress(newDefaultBackendIngress("default", "b", "b.com"))
+	noBackend := newDefaultBackendIngress("default", "0")
+	noBackend.Spec.DefaultBackend = nil
+	canary := newDefaultBackendIngress("default", "1")
+	canary.Annotations[annotations.AnnotationsCanary] = "true"
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, DefaultBackendIndexers())
+	for _, obj := range []interface{}{unwatched.V1(), other.V1(), ing.V1(), noBackend, canary} {
+		assert.Nil(t, indexer.Add(obj))
+	}
+	ings, err := ListDefaultBackendIngresses(indexer)
+	assert.Nil(t, err)
+	assert.Len(t, ings, 3)
+
+	tr := newIngressClassTranslator(t)
+	tr.IngressIndexer = indexer
+	tr.IsWatchingNamespace = func(key string) bool {
+		return strings.HasPrefix(key, "default/")
+	}
+	assert.True(t, tr.HasGlobalDefaultBackend(ing))
+	assert.False(t, tr.HasGlobalDefaultBackend(kube.MustNewIngress(noBackend)))
+	assert.False(t, tr.HasGlobalDefaultBackend(kube.MustNewIngress(canary)))
+	// The Ingress in the unwatched namespace is ignored.
+	owner, err := tr.GlobalDefaultBackendOwner()
+	assert.Nil(t, err)
+	assert.Equal(t, "default/a", owner)
+
+	ups := apisixv1.NewDefaultUpstream()
+	ups.ID = "1"
+	// The global route is created even if all rules have hosts.
+	ctx := translation.DefaultEmptyTranslateContext()
+	assert.Nil(t, tr.translateDefaultBackendRoutes(ctx, other, ups, &Ingress{}))
+	assert.Len(t, ctx.Routes, 2)
+	assert.Equal(t, "", ctx.Routes[0].Host)
+	assert.Equal(t, "a.com", ctx.Routes[1].Host)
+
+	ctx = translation.DefaultEmptyTranslateContext()
+	assert.Nil(t, tr.translateDefaultBackendRoutes(ctx, ing, ups, &Ingress{}))
+	assert.Len(t, ctx.Routes, 1)
+	assert.Equal(t, "b.com", ctx.Routes[0].Host)
+
+	// The global route is taken over once the owner is deleted.
+	assert.Nil(t, indexer.Delete(other.V1()))
+	ctx = translation.DefaultEmptyTranslateContext()
+	assert.Nil(t, tr.translateDefaultBackendRoutes(ctx, ing, ups, &Ingress{}))
+	assert.Len(t, ctx.Routes, 2)
+	assert.Equal(t, "", ctx.Routes[0].Host)
+}
@@ -0,0 +1,249 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package translation
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	kubev2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	apisixconst "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/const"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/ingress/translation/annotations"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	_canaryAlways = "always"
+	_canaryNever  = "never"
+)
+
+func (t *translator) IsCanaryIngress(ing kube.Ingress) bool {
+	return annotations.NewExtractor(ing.Object().GetAnnotations()).GetBoolAnnotation(annotations.AnnotationsCanary)
+}
+
+// canaryBackendOf returns the backend of the path rule which has the given
+// host and path in the Ingress, nil is returned if there is no such rule.
+func canaryBackendOf(ing kube.Ingress, host, path string) *kubev2.ApisixRouteHTTPBackend {
+	switch ing.GroupVersion() {
+	case kube.IngressV1:
+		for _, rule := range ing.V1().Spec.Rules {
+			if rule.Host != host || rule.HTTP == nil {
+				continue
+			}
+			for _, pathRule := range rule.HTTP.Paths {
+				if pathRule.Path != path || pathRule.Backend.Service == nil {
+					continue
+				}
+				svc := pathRule.Backend.Service
+				port := intstr.FromInt(int(svc.Port.Number))
+				if svc.Port.Name != "" {
+					port = intstr.FromString(svc.Port.Name)
+				}
+				return &kubev2.ApisixRouteHTTPBackend{
+					ServiceName: svc.Name,
+					ServicePort: port,
+				}
+			}
+		}
+	case kube.IngressV1beta1:
+		for _, rule := range ing.V1beta1().Spec.Rules {
+			if rule.Host != host || rule.HTTP == nil {
+				continue
+			}
+			for _, pathRule := range rule.HTTP.Paths {
+				if pathRule.Path != path || pathRule.Backend.ServiceName == "" {
+					continue
+				}
+				return &kubev2.ApisixRouteHTTPBackend{
+					ServiceName: pathRule.Backend.ServiceName,
+					ServicePort: pathRule.Backend.ServicePort,
+				}
+			}
+		}
+	default:
+		for _, rule := range ing.ExtensionsV1beta1().Spec.Rules {
+			if rule.Host != host || rule.HTTP == nil {
+				continue
+			}
+			for _, pathRule := range rule.HTTP.Paths {
+				if pathRule.Path != path || pathRule.Backend.ServiceName == "" {
+					continue
+				}
+				return &kubev2.ApisixRouteHTTPBackend{
+					ServiceName: pathRule.Backend.ServiceName,
+					ServicePort: pathRule.Backend.ServicePort,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// findCanary finds the canary Ingress which has the same host and path as
+// the primary route. Like ingress-nginx, only one canary is supported for a
+// host and path, the first one in name order is picked if there are many.
+func (t *translator) findCanary(groupVersion, namespace, host, path string) (kube.Ingress, *kubev2.ApisixRouteHTTPBackend, error) {
+	if t.IngressLister == nil {
+		return nil, nil, nil
+	}
+	var (
+		ings []kube.Ingress
+		err  error
+	)
+	switch groupVersion {
+	case kube.IngressV1:
+		ings, err = t.IngressLister.ListV1(namespace)
+	case kube.IngressV1beta1:
+		ings, err = t.IngressLister.ListV1beta1(namespace)
+	default:
+		ings, err = t.IngressLister.ListExtensionsV1beta1(namespace)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(ings, func(i, j int) bool {
+		ni := ings[i].Object().GetName()
+		nj := ings[j].Object().GetName()
+		return ni < nj
+	})
+	for _, ing := range ings {
+		if !t.IsCanaryIngress(ing) || !t.IsIngressEffective(ing) {
+			continue
+		}
+		if backend := canaryBackendOf(ing, host, path); backend != nil {
+			return ing, backend, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// translateCanary merges the canary Ingress which has the same host and path
+// onto the primary route through the traffic-split plugin. The rules are
+// ordered by precedence: canary-by-header, canary-by-cookie and canary-weight.
+func (t *translator) translateCanary(ctx *translation.TranslateContext, groupVersion, namespace, host, path string, route *apisixv1.Route) error {
+	ing, backend, err := t.findCanary(groupVersion, namespace, host, path)
+	if err != nil || ing == nil {
+		return err
+	}
+	name, anno := ing.Object().GetName(), ing.Object().GetAnnotations()
+	canaryIngress := t.TranslateAnnotations(anno)
+	cfg := canaryIngress.Canary
+	if cfg == nil {
+		return fmt.Errorf("bad canary annotations of ingress %s/%s", namespace, name)
+	}
+	if cfg.Weight == 0 && cfg.Header == "" && cfg.Cookie == "" {
+		return nil
+	}
+
+	ns := namespace
+	if canaryIngress.ServiceNamespace != "" {
+		ns = canaryIngress.ServiceNamespace
+	}
+	backend.Weight = &cfg.Weight
+	backend.ResolveGranularity = types.ResolveGranularity.Endpoint
+	tsCfg, err := t.ApisixTranslator.TranslateTrafficSplitPlugin(ctx, ns, cfg.WeightTotal-cfg.Weight,
+		[]kubev2.ApisixRouteHTTPBackend{*backend})
+	if err != nil {
+		return err
+	}
+	canaryUpstreamID := tsCfg.Rules[0].WeightedUpstreams[0].UpstreamID
+
+	var rules []apisixv1.TrafficSplitConfigRule
+	if cfg.Header != "" {
+		if cfg.HeaderValue != "" {
+			rule, err := t.translateCanaryMatchRule(apisixconst.ScopeHeader, cfg.Header, cfg.HeaderValue, canaryUpstreamID)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, rule)
+		} else {
+			matchRules, err := t.translateCanaryAlwaysNeverRules(apisixconst.ScopeHeader, cfg.Header, canaryUpstreamID)
+			if err != nil {
+				return err
+			}
+			rules = append(rules, matchRules...)
+		}
+	}
+	if cfg.Cookie != "" {
+		matchRules, err := t.translateCanaryAlwaysNeverRules(apisixconst.ScopeCookie, cfg.Cookie, canaryUpstreamID)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, matchRules...)
+	}
+	if cfg.Weight > 0 {
+		rules = append(rules, tsCfg.Rules...)
+	}
+
+	if route.Plugins == nil {
+		route.Plugins = make(apisixv1.Plugins)
+	}
+	route.Plugins["traffic-split"] = &apisixv1.TrafficSplitConfig{
+		Rules: rules,
+	}
+	return nil
+}
+
+// translateCanaryAlwaysNeverRules translates the rules which send requests
+// to the canary when the subject is "always", and to the primary when it's
+// "never".
+func (t *translator) translateCanaryAlwaysNeverRules(scope, subject, canaryUpstreamID string) ([]apisixv1.TrafficSplitConfigRule, error) {
+	always, err := t.translateCanaryMatchRule(scope, subject, _canaryAlways, canaryUpstreamID)
+	if err != nil {
+		return nil, err
+	}
+	// The weighted upstream without upstream_id is the upstream of the route.
+	never, err := t.translateCanaryMatchRule(scope, subject, _canaryNever, "")
+	if err != nil {
+		return nil, err
+	}
+	return []apisixv1.TrafficSplitConfigRule{always, never}, nil
+}
+
+func (t *translator) translateCanaryMatchRule(scope, subject, value, upstreamID string) (apisixv1.TrafficSplitConfigRule, error) {
+	vars, err := t.ApisixTranslator.TranslateRouteMatchExprs([]kubev2.ApisixRouteHTTPMatchExpr{
+		{
+			Subject: kubev2.ApisixRouteHTTPMatchExprSubject{
+				Scope: scope,
+				Name:  subject,
+			},
+			Op:    apisixconst.OpEqual,
+			Value: &value,
+		},
+	})
+	if err != nil {
+		return apisixv1.TrafficSplitConfigRule{}, err
+	}
+	return apisixv1.TrafficSplitConfigRule{
+		Match: []apisixv1.TrafficSplitConfigRuleMatch{
+			{
+				Vars: vars,
+			},
+		},
+		WeightedUpstreams: []apisixv1.TrafficSplitConfigRuleWeightedUpstream{
+			{
+				UpstreamID: upstreamID,
+				Weight:     translation.DefaultWeight,
+			},
+		},
+	}, nil
+}
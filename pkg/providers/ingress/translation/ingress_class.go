@@ -0,0 +1,190 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package translation
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/zap"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/log"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	_ingressClassAnnotation        = "kubernetes.io/ingress.class"
+	_defaultIngressClassAnnotation = "ingressclass.kubernetes.io/is-default-class"
+	_ingressClassConfigKind        = "ApisixIngressClassConfig"
+)
+
+// ingressClassOf returns the class set by the kubernetes.io/ingress.class
+// annotation and the IngressClassName field of the Ingress.
+func ingressClassOf(ing kube.Ingress) (string, *string) {
+	switch ing.GroupVersion() {
+	case kube.IngressV1:
+		return ing.V1().GetAnnotations()[_ingressClassAnnotation], ing.V1().Spec.IngressClassName
+	case kube.IngressV1beta1:
+		return ing.V1beta1().GetAnnotations()[_ingressClassAnnotation], ing.V1beta1().Spec.IngressClassName
+	default:
+		return ing.ExtensionsV1beta1().GetAnnotations()[_ingressClassAnnotation], ing.ExtensionsV1beta1().Spec.IngressClassName
+	}
+}
+
+func (t *translator) IsIngressEffective(ing kube.Ingress) bool {
+	annotation, className := ingressClassOf(ing)
+	// kubernetes.io/ingress.class takes the precedence.
+	if annotation != "" {
+		return t.isIngressClassServed(annotation)
+	}
+	if className != nil {
+		return t.isIngressClassServed(*className)
+	}
+	return t.defaultIngressClass() != nil
+}
+
+// isIngressClassServed tells whether the class is the configured one, or an
+// IngressClass whose controller is this controller.
+func (t *translator) isIngressClassServed(name string) bool {
+	if name == t.IngressClass {
+		return true
+	}
+	class := t.getIngressClass(name)
+	return class != nil && class.Spec.Controller == t.IngressClassController
+}
+
+// ingressClass returns the IngressClass resource of the Ingress, nil is
+// returned if it doesn't exist.
+func (t *translator) ingressClass(ing kube.Ingress) *networkingv1.IngressClass {
+	annotation, className := ingressClassOf(ing)
+	if annotation != "" {
+		return t.getIngressClass(annotation)
+	}
+	if className != nil {
+		return t.getIngressClass(*className)
+	}
+	return t.defaultIngressClass()
+}
+
+func (t *translator) getIngressClass(name string) *networkingv1.IngressClass {
+	if t.IngressClassLister == nil {
+		return nil
+	}
+	class, err := t.IngressClassLister.Get(name)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Errorw("failed to get IngressClass",
+				zap.String("name", name),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+	return class
+}
+
+// defaultIngressClass returns the IngressClass which is marked as default and
+// served by this controller, the Ingresses without class belong to it. If
+// there are multiple ones, the first one sorted by name is chosen.
+func (t *translator) defaultIngressClass() *networkingv1.IngressClass {
+	if t.IngressClassLister == nil {
+		return nil
+	}
+	classes, err := t.IngressClassLister.List(labels.Everything())
+	if err != nil {
+		log.Errorw("failed to list IngressClasses",
+			zap.Error(err),
+		)
+		return nil
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].Name < classes[j].Name
+	})
+	for _, class := range classes {
+		if class.Spec.Controller == t.IngressClassController && class.Annotations[_defaultIngressClassAnnotation] == "true" {
+			return class
+		}
+	}
+	return nil
+}
+
+// ingressClassConfig returns the ApisixIngressClassConfig referred by the
+// parameters of the IngressClass of the Ingress, nil is returned if the
+// IngressClass doesn't exist, it's not served by this controller, or it has
+// no such parameters.
+func (t *translator) ingressClassConfig(ing kube.Ingress) (*configv2.ApisixIngressClassConfig, error) {
+	class := t.ingressClass(ing)
+	if class == nil || class.Spec.Controller != t.IngressClassController || class.Spec.Parameters == nil {
+		return nil, nil
+	}
+	params := class.Spec.Parameters
+	if params.APIGroup == nil || *params.APIGroup != configv2.GroupName || params.Kind != _ingressClassConfigKind {
+		log.Warnw("ignore unknown parameters of IngressClass",
+			zap.String("ingress_class", class.Name),
+			zap.Any("parameters", params),
+		)
+		return nil, nil
+	}
+	if params.Scope != nil && *params.Scope != networkingv1.IngressClassParametersReferenceScopeCluster {
+		return nil, fmt.Errorf("parameters of IngressClass %s should be in Cluster scope", class.Name)
+	}
+	aicc, err := t.ApisixIngressClassConfigLister.ApisixIngressClassConfig(params.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ApisixIngressClassConfig %s of IngressClass %s: %s", params.Name, class.Name, err)
+	}
+	return aicc.V2(), nil
+}
+
+func (t *translator) IngressClusterName(ing kube.Ingress) (string, error) {
+	aicc, err := t.ingressClassConfig(ing)
+	if err != nil {
+		return "", err
+	}
+	if aicc != nil && aicc.Spec.Cluster != "" {
+		return aicc.Spec.Cluster, nil
+	}
+	return t.ClusterName, nil
+}
+
+// applyIngressClassConfig sets the defaults in ApisixIngressClassConfig to the
+// extracted annotations, which aren't configured by the Ingress itself.
+func applyIngressClassConfig(ingress *Ingress, aicc *configv2.ApisixIngressClassConfig) {
+	if aicc == nil {
+		return
+	}
+	if ingress.UpstreamScheme == "" {
+		ingress.UpstreamScheme = aicc.Spec.UpstreamScheme
+	}
+	for _, plugin := range aicc.Spec.Plugins {
+		if !plugin.Enable {
+			continue
+		}
+		if _, ok := ingress.Plugins[plugin.Name]; ok {
+			continue
+		}
+		if ingress.Plugins == nil {
+			ingress.Plugins = make(apisixv1.Plugins)
+		}
+		ingress.Plugins[plugin.Name] = map[string]interface{}(*plugin.Config.DeepCopy())
+	}
+}
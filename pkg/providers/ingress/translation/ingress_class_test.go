@@ -0,0 +1,207 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package translation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listersnetworkingv1 "k8s.io/client-go/listers/networking/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
+	listersv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/listers/config/v2"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func newIngressClassTranslator(t *testing.T, objs ...interface{}) *translator {
+	classIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	configIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, obj := range objs {
+		switch obj.(type) {
+		case *networkingv1.IngressClass:
+			assert.Nil(t, classIndexer.Add(obj))
+		case *configv2.ApisixIngressClassConfig:
+			assert.Nil(t, configIndexer.Add(obj))
+		}
+	}
+	return &translator{
+		TranslatorOptions: &TranslatorOptions{
+			ClusterName:            "default",
+			IngressClass:           config.IngressClass,
+			IngressClassController: config.IngressClassController,
+			IngressClassLister:     listersnetworkingv1.NewIngressClassLister(classIndexer),
+			ApisixIngressClassConfigLister: kube.NewApisixIngressClassConfigLister(config.ApisixV2,
+				listersv2.NewApisixIngressClassConfigLister(configIndexer)),
+		},
+	}
+}
+
+func newIngressClass(name, controller string, isDefault bool) *networkingv1.IngressClass {
+	class := &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: networkingv1.IngressClassSpec{
+			Controller: controller,
+		},
+	}
+	if isDefault {
+		class.Annotations = map[string]string{
+			_defaultIngressClassAnnotation: "true",
+		}
+	}
+	return class
+}
+
+func newClassIngress(t *testing.T, annotation string, className *string) kube.Ingress {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "ing",
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: className,
+		},
+	}
+	if annotation != "" {
+		ing.Annotations = map[string]string{
+			_ingressClassAnnotation: annotation,
+		}
+	}
+	ingress, err := kube.NewIngress(ing)
+	assert.Nil(t, err)
+	return ingress
+}
+
+func TestIsIngressEffectiveWithIngressClass(t *testing.T) {
+	internal := "internal"
+	nginx := "nginx"
+	missing := "missing"
+	tr := newIngressClassTranslator(t,
+		newIngressClass("internal", config.IngressClassController, false),
+		newIngressClass("nginx", "k8s.io/ingress-nginx", false),
+	)
+
+	// IngressClasses served by this controller.
+	assert.True(t, tr.IsIngressEffective(newClassIngress(t, "", &internal)))
+	assert.True(t, tr.IsIngressEffective(newClassIngress(t, "internal", nil)))
+	// The configured class doesn't need an IngressClass resource.
+	assert.True(t, tr.IsIngressEffective(newClassIngress(t, "apisix", nil)))
+	assert.False(t, tr.IsIngressEffective(newClassIngress(t, "", &nginx)))
+	assert.False(t, tr.IsIngressEffective(newClassIngress(t, "", &missing)))
+	// The annotation takes the precedence.
+	assert.False(t, tr.IsIngressEffective(newClassIngress(t, "nginx", &internal)))
+	// No default IngressClass.
+	assert.False(t, tr.IsIngressEffective(newClassIngress(t, "", nil)))
+
+	tr = newIngressClassTranslator(t, newIngressClass("nginx", "k8s.io/ingress-nginx", true))
+	assert.False(t, tr.IsIngressEffective(newClassIngress(t, "", nil)))
+
+	tr = newIngressClassTranslator(t, newIngressClass("internal", config.IngressClassController, true))
+	assert.True(t, tr.IsIngressEffective(newClassIngress(t, "", nil)))
+}
+
+func TestIngressClassConfig(t *testing.T) {
+	apiGroup := configv2.GroupName
+	class := newIngressClass("internal", config.IngressClassController, true)
+	class.Spec.Parameters = &networkingv1.IngressClassParametersReference{
+		APIGroup: &apiGroup,
+		Kind:     "ApisixIngressClassConfig",
+		Name:     "internal",
+	}
+	aicc := &configv2.ApisixIngressClassConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "internal",
+		},
+		Spec: configv2.ApisixIngressClassConfigSpec{
+			Cluster: "internal",
+			Plugins: []configv2.ApisixIngressClassPlugin{
+				{
+					Name:   "cors",
+					Enable: true,
+					Config: configv2.ApisixRoutePluginConfig{
+						"allow_origins": "*",
+					},
+				},
+				{
+					Name:   "real-ip",
+					Enable: true,
+				},
+				{
+					Name:   "gzip",
+					Enable: false,
+				},
+			},
+			UpstreamScheme: "https",
+		},
+	}
+	tr := newIngressClassTranslator(t, class, aicc)
+
+	ing := newClassIngress(t, "", nil)
+	clusterName, err := tr.IngressClusterName(ing)
+	assert.Nil(t, err)
+	assert.Equal(t, "internal", clusterName)
+
+	got, err := tr.ingressClassConfig(ing)
+	assert.Nil(t, err)
+	ingress := &Ingress{
+		Plugins: apisixv1.Plugins{
+			"real-ip": map[string]interface{}{
+				"source": "http_x_forwarded_for",
+			},
+		},
+		UpstreamScheme: "grpc",
+	}
+	applyIngressClassConfig(ingress, got)
+	// The annotations take the precedence.
+	assert.Equal(t, "grpc", ingress.UpstreamScheme)
+	assert.Equal(t, apisixv1.Plugins{
+		"cors": map[string]interface{}{
+			"allow_origins": "*",
+		},
+		"real-ip": map[string]interface{}{
+			"source": "http_x_forwarded_for",
+		},
+	}, ingress.Plugins)
+
+	ingress = &Ingress{}
+	applyIngressClassConfig(ingress, got)
+	assert.Equal(t, "https", ingress.UpstreamScheme)
+
+	// The configured class without IngressClass uses the default cluster.
+	clusterName, err = tr.IngressClusterName(newClassIngress(t, "apisix", nil))
+	assert.Nil(t, err)
+	assert.Equal(t, "default", clusterName)
+
+	// ApisixIngressClassConfig is cluster scoped.
+	scope := networkingv1.IngressClassParametersReferenceScopeNamespace
+	class.Spec.Parameters.Scope = &scope
+	_, err = tr.IngressClusterName(ing)
+	assert.NotNil(t, err)
+
+	// The referred ApisixIngressClassConfig doesn't exist.
+	class.Spec.Parameters.Scope = nil
+	class.Spec.Parameters.Name = "missing"
+	_, err = tr.IngressClusterName(ing)
+	assert.NotNil(t, err)
+}
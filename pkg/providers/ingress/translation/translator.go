@@ -29,6 +29,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	listersnetworkingv1 "k8s.io/client-go/listers/networking/v1"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/apache/apisix-ingress-controller/pkg/apisix"
 	"github.com/apache/apisix-ingress-controller/pkg/id"
@@ -46,7 +48,22 @@ type TranslatorOptions struct {
 	Apisix      apisix.APISIX
 	ClusterName string
 
+	// IngressClass is the configured ingress class name.
+	IngressClass string
+	// IngressClassController is the controller of the IngressClasses served
+	// by this controller.
+	IngressClassController string
+
 	ServiceLister listerscorev1.ServiceLister
+	IngressLister kube.IngressLister
+	// IngressIndexer is the indexer of the Ingress informer, which has the
+	// DefaultBackendIndex.
+	IngressIndexer                 cache.Indexer
+	IngressClassLister             listersnetworkingv1.IngressClassLister
+	ApisixIngressClassConfigLister kube.ApisixIngressClassConfigLister
+	// IsWatchingNamespace tells whether the resource with the given key is
+	// in the watched namespaces.
+	IsWatchingNamespace func(key string) bool
 }
 
 type translator struct {
@@ -60,14 +77,32 @@ type IngressTranslator interface {
 	// to the given Ingress resource.
 	// For old objects, you cannot use TranslateIngress to build. Because it needs to parse the latest service, which will cause data inconsistency.
 	TranslateIngress(ing kube.Ingress, args ...bool) (*translation.TranslateContext, error)
-	// TranslateOldIngress get route objects from cache
+	// TranslateOldIngress get route objects from the cache of the given cluster
 	// Build upstream and plugin_config through route
-	TranslateOldIngress(kube.Ingress) (*translation.TranslateContext, error)
+	TranslateOldIngress(ing kube.Ingress, clusterName string) (*translation.TranslateContext, error)
 	// TranslateSSLV2 translate networkingv1.IngressTLS to APISIX SSL
 	TranslateIngressTLS(namespace, ingName, secretName string, hosts []string) (*apisixv1.Ssl, error)
 	// TranslateIngressDeleteEvent composes a couple of APISIX Routes and upstreams according
-	// to the given Ingress resource.
-	TranslateIngressDeleteEvent(ing kube.Ingress, args ...bool) (*translation.TranslateContext, error)
+	// to the given Ingress resource, which are in the cache of the given cluster.
+	TranslateIngressDeleteEvent(ing kube.Ingress, clusterName string) (*translation.TranslateContext, error)
+	// IsIngressEffective tells whether the Ingress is served by this controller,
+	// according to the ingress class annotation, the IngressClassName field, or
+	// the default IngressClass.
+	IsIngressEffective(ing kube.Ingress) bool
+	// IngressClusterName returns the name of APISIX cluster which the Ingress
+	// should be synced to, it's decided by the ApisixIngressClassConfig of
+	// the IngressClass.
+	IngressClusterName(ing kube.Ingress) (string, error)
+	// IsCanaryIngress tells whether the Ingress is a canary, which is merged
+	// onto the routes of the primary Ingress instead of being translated alone.
+	IsCanaryIngress(ing kube.Ingress) bool
+	// HasGlobalDefaultBackend tells whether the Ingress has a default backend,
+	// which is also the global catch-all backend of all hosts.
+	HasGlobalDefaultBackend(ing kube.Ingress) bool
+	// GlobalDefaultBackendOwner returns the key of the Ingress whose global
+	// default backend route is created, only the first one in namespace/name
+	// order takes effect if there are many.
+	GlobalDefaultBackendOwner() (string, error)
 }
 
 func NewIngressTranslator(opts *TranslatorOptions,
@@ -110,26 +145,42 @@ func (t *translator) TranslateIngress(ing kube.Ingress, args ...bool) (*translat
 	if len(args) != 0 {
 		skipVerify = args[0]
 	}
+	if t.IsCanaryIngress(ing) {
+		return translation.DefaultEmptyTranslateContext(), nil
+	}
+	aicc, err := t.ingressClassConfig(ing)
+	if err != nil {
+		return nil, err
+	}
+	if !skipVerify {
+		clusterName, err := t.IngressClusterName(ing)
+		if err != nil {
+			return nil, err
+		}
+		if err := t.validateGenericPlugins(ing.Object().GetAnnotations(), clusterName); err != nil {
+			return nil, err
+		}
+	}
 	switch ing.GroupVersion() {
 	case kube.IngressV1:
-		return t.translateIngressV1(ing.V1(), skipVerify)
+		return t.translateIngressV1(ing.V1(), aicc, skipVerify)
 	case kube.IngressV1beta1:
-		return t.translateIngressV1beta1(ing.V1beta1(), skipVerify)
+		return t.translateIngressV1beta1(ing.V1beta1(), aicc, skipVerify)
 	case kube.IngressExtensionsV1beta1:
-		return t.translateIngressExtensionsV1beta1(ing.ExtensionsV1beta1(), skipVerify)
+		return t.translateIngressExtensionsV1beta1(ing.ExtensionsV1beta1(), aicc, skipVerify)
 	default:
 		return nil, fmt.Errorf("translator: source group version not supported: %s", ing.GroupVersion())
 	}
 }
 
-func (t *translator) TranslateIngressDeleteEvent(ing kube.Ingress, args ...bool) (*translation.TranslateContext, error) {
+func (t *translator) TranslateIngressDeleteEvent(ing kube.Ingress, clusterName string) (*translation.TranslateContext, error) {
 	switch ing.GroupVersion() {
 	case kube.IngressV1:
-		return t.translateOldIngressV1(ing.V1())
+		return t.translateOldIngressV1(ing.V1(), clusterName)
 	case kube.IngressV1beta1:
-		return t.translateOldIngressV1beta1(ing.V1beta1())
+		return t.translateOldIngressV1beta1(ing.V1beta1(), clusterName)
 	case kube.IngressExtensionsV1beta1:
-		return t.translateOldIngressExtensionsv1beta1(ing.ExtensionsV1beta1())
+		return t.translateOldIngressExtensionsv1beta1(ing.ExtensionsV1beta1(), clusterName)
 	default:
 		return nil, fmt.Errorf("translator: source group version not supported: %s", ing.GroupVersion())
 	}
@@ -139,9 +190,10 @@ const (
 	_regexPriority = 100
 )
 
-func (t *translator) translateIngressV1(ing *networkingv1.Ingress, skipVerify bool) (*translation.TranslateContext, error) {
+func (t *translator) translateIngressV1(ing *networkingv1.Ingress, aicc *kubev2.ApisixIngressClassConfig, skipVerify bool) (*translation.TranslateContext, error) {
 	ctx := translation.DefaultEmptyTranslateContext()
 	ingress := t.TranslateAnnotations(ing.Annotations)
+	applyIngressClassConfig(ingress, aicc)
 
 	// add https
 	for _, tls := range ing.Spec.TLS {
@@ -164,26 +216,15 @@ func (t *translator) translateIngressV1(ing *networkingv1.Ingress, skipVerify bo
 			continue
 		}
 		for _, pathRule := range rule.HTTP.Paths {
-			var (
-				ups *apisixv1.Upstream
-				err error
-			)
-			if pathRule.Backend.Service != nil {
-				if skipVerify {
-					ups = t.translateDefaultUpstreamFromIngressV1(ns, pathRule.Backend.Service)
-				} else {
-					ups, err = t.translateUpstreamFromIngressV1(ns, pathRule.Backend.Service)
-					if err != nil {
-						log.Errorw("failed to translate ingress backend to upstream",
-							zap.Error(err),
-							zap.Any("ingress", ing),
-						)
-						return nil, err
-					}
-				}
-				if ingress.UpstreamScheme != "" {
-					ups.Scheme = ingress.UpstreamScheme
-				}
+			ups, err := t.translateIngressBackendV1(ing.Namespace, ns, &pathRule.Backend, ingress, skipVerify)
+			if err != nil {
+				log.Errorw("failed to translate ingress backend to upstream",
+					zap.Error(err),
+					zap.Any("ingress", ing),
+				)
+				return nil, err
+			}
+			if ups != nil {
 				ctx.AddUpstream(ups)
 			}
 			uris := []string{pathRule.Path}
@@ -240,16 +281,42 @@ func (t *translator) translateIngressV1(ing *networkingv1.Ingress, skipVerify bo
 			}
 			if ups != nil {
 				route.UpstreamId = ups.ID
+				if !skipVerify {
+					// A broken canary doesn't affect the primary route.
+					if err := t.translateCanary(ctx, kube.IngressV1, ing.Namespace, rule.Host, pathRule.Path, route); err != nil {
+						log.Warnw("failed to translate canary ingress, ignore it",
+							zap.Error(err),
+							zap.Any("ingress", ing),
+						)
+					}
+				}
 			}
 			ctx.AddRoute(route)
 		}
 	}
+	if ing.Spec.DefaultBackend != nil {
+		ups, err := t.translateIngressBackendV1(ing.Namespace, ns, ing.Spec.DefaultBackend, ingress, skipVerify)
+		if err != nil {
+			log.Errorw("failed to translate ingress default backend to upstream",
+				zap.Error(err),
+				zap.Any("ingress", ing),
+			)
+			return nil, err
+		}
+		if ups != nil {
+			ctx.AddUpstream(ups)
+			if err := t.translateDefaultBackendRoutes(ctx, kube.MustNewIngress(ing), ups, ingress); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return ctx, nil
 }
 
-func (t *translator) translateIngressV1beta1(ing *networkingv1beta1.Ingress, skipVerify bool) (*translation.TranslateContext, error) {
+func (t *translator) translateIngressV1beta1(ing *networkingv1beta1.Ingress, aicc *kubev2.ApisixIngressClassConfig, skipVerify bool) (*translation.TranslateContext, error) {
 	ctx := translation.DefaultEmptyTranslateContext()
 	ingress := t.TranslateAnnotations(ing.Annotations)
+	applyIngressClassConfig(ingress, aicc)
 
 	// add https
 	for _, tls := range ing.Spec.TLS {
@@ -268,27 +335,19 @@ func (t *translator) translateIngressV1beta1(ing *networkingv1beta1.Ingress, ski
 		ns = ingress.ServiceNamespace
 	}
 	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
 		for _, pathRule := range rule.HTTP.Paths {
-			var (
-				ups *apisixv1.Upstream
-				err error
-			)
-			if pathRule.Backend.ServiceName != "" {
-				if skipVerify {
-					ups = t.translateDefaultUpstreamFromIngressV1beta1(ns, pathRule.Backend.ServiceName, pathRule.Backend.ServicePort)
-				} else {
-					ups, err = t.translateUpstreamFromIngressV1beta1(ns, pathRule.Backend.ServiceName, pathRule.Backend.ServicePort)
-					if err != nil {
-						log.Errorw("failed to translate ingress backend to upstream",
-							zap.Error(err),
-							zap.Any("ingress", ing),
-						)
-						return nil, err
-					}
-				}
-				if ingress.UpstreamScheme != "" {
-					ups.Scheme = ingress.UpstreamScheme
-				}
+			ups, err := t.translateIngressBackendV1beta1(ing.Namespace, ns, &pathRule.Backend, ingress, skipVerify)
+			if err != nil {
+				log.Errorw("failed to translate ingress backend to upstream",
+					zap.Error(err),
+					zap.Any("ingress", ing),
+				)
+				return nil, err
+			}
+			if ups != nil {
 				ctx.AddUpstream(ups)
 			}
 			uris := []string{pathRule.Path}
@@ -345,13 +404,79 @@ func (t *translator) translateIngressV1beta1(ing *networkingv1beta1.Ingress, ski
 			}
 			if ups != nil {
 				route.UpstreamId = ups.ID
+				if !skipVerify {
+					// A broken canary doesn't affect the primary route.
+					if err := t.translateCanary(ctx, kube.IngressV1beta1, ing.Namespace, rule.Host, pathRule.Path, route); err != nil {
+						log.Warnw("failed to translate canary ingress, ignore it",
+							zap.Error(err),
+							zap.Any("ingress", ing),
+						)
+					}
+				}
 			}
 			ctx.AddRoute(route)
 		}
 	}
+	if ing.Spec.Backend != nil {
+		ups, err := t.translateIngressBackendV1beta1(ing.Namespace, ns, ing.Spec.Backend, ingress, skipVerify)
+		if err != nil {
+			log.Errorw("failed to translate ingress default backend to upstream",
+				zap.Error(err),
+				zap.Any("ingress", ing),
+			)
+			return nil, err
+		}
+		if ups != nil {
+			ctx.AddUpstream(ups)
+			if err := t.translateDefaultBackendRoutes(ctx, kube.MustNewIngress(ing), ups, ingress); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return ctx, nil
 }
 
+// applyUpstreamAnnotations sets the upstream options from annotations to the
+// upstream translated from Service.
+func applyUpstreamAnnotations(ups *apisixv1.Upstream, ingress *Ingress) {
+	if ingress.UpstreamScheme != "" {
+		ups.Scheme = ingress.UpstreamScheme
+	}
+	if ingress.UpstreamTimeout != nil {
+		ups.Timeout = ingress.UpstreamTimeout
+	}
+	if ingress.UpstreamRetries != nil {
+		ups.Retries = ingress.UpstreamRetries
+	}
+}
+
+// translateIngressBackendV1 translates the backend of networking/v1 Ingress to
+// APISIX Upstream, the backend refers to either a Service in svcNamespace or an
+// ApisixUpstream in namespace. Nil is returned if the backend refers to nothing.
+func (t *translator) translateIngressBackendV1(namespace, svcNamespace string, backend *networkingv1.IngressBackend,
+	ingress *Ingress, skipVerify bool) (*apisixv1.Upstream, error) {
+	if backend.Resource != nil {
+		return t.translateUpstreamFromResource(namespace, backend.Resource, skipVerify)
+	}
+	if backend.Service == nil {
+		return nil, nil
+	}
+	var (
+		ups *apisixv1.Upstream
+		err error
+	)
+	if skipVerify {
+		ups = t.translateDefaultUpstreamFromIngressV1(svcNamespace, backend.Service)
+	} else {
+		ups, err = t.translateUpstreamFromIngressV1(svcNamespace, backend.Service)
+		if err != nil {
+			return nil, err
+		}
+	}
+	applyUpstreamAnnotations(ups, ingress)
+	return ups, nil
+}
+
 func (t *translator) translateDefaultUpstreamFromIngressV1(namespace string, backend *networkingv1.IngressServiceBackend) *apisixv1.Upstream {
 	var portNumber int32
 	if backend.Port.Name != "" {
@@ -406,9 +531,10 @@ func (t *translator) translateUpstreamFromIngressV1(namespace string, backend *n
 	return ups, nil
 }
 
-func (t *translator) translateIngressExtensionsV1beta1(ing *extensionsv1beta1.Ingress, skipVerify bool) (*translation.TranslateContext, error) {
+func (t *translator) translateIngressExtensionsV1beta1(ing *extensionsv1beta1.Ingress, aicc *kubev2.ApisixIngressClassConfig, skipVerify bool) (*translation.TranslateContext, error) {
 	ctx := translation.DefaultEmptyTranslateContext()
 	ingress := t.TranslateAnnotations(ing.Annotations)
+	applyIngressClassConfig(ingress, aicc)
 
 	// add https
 	for _, tls := range ing.Spec.TLS {
@@ -427,28 +553,20 @@ func (t *translator) translateIngressExtensionsV1beta1(ing *extensionsv1beta1.In
 		ns = ingress.ServiceNamespace
 	}
 	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
 		for _, pathRule := range rule.HTTP.Paths {
-			var (
-				ups *apisixv1.Upstream
-				err error
-			)
-			if pathRule.Backend.ServiceName != "" {
-				// Structure here is same to ingress.extensions/v1beta1, so just use this method.
-				if skipVerify {
-					ups = t.translateDefaultUpstreamFromIngressV1beta1(ns, pathRule.Backend.ServiceName, pathRule.Backend.ServicePort)
-				} else {
-					ups, err = t.translateUpstreamFromIngressV1beta1(ns, pathRule.Backend.ServiceName, pathRule.Backend.ServicePort)
-					if err != nil {
-						log.Errorw("failed to translate ingress backend to upstream",
-							zap.Error(err),
-							zap.Any("ingress", ing),
-						)
-						return nil, err
-					}
-				}
-				if ingress.UpstreamScheme != "" {
-					ups.Scheme = ingress.UpstreamScheme
-				}
+			// Structure here is same to ingress.extensions/v1beta1, so just use this method.
+			ups, err := t.translateIngressBackendV1beta1(ing.Namespace, ns, (*networkingv1beta1.IngressBackend)(&pathRule.Backend), ingress, skipVerify)
+			if err != nil {
+				log.Errorw("failed to translate ingress backend to upstream",
+					zap.Error(err),
+					zap.Any("ingress", ing),
+				)
+				return nil, err
+			}
+			if ups != nil {
 				ctx.AddUpstream(ups)
 			}
 			uris := []string{pathRule.Path}
@@ -506,13 +624,64 @@ func (t *translator) translateIngressExtensionsV1beta1(ing *extensionsv1beta1.In
 
 			if ups != nil {
 				route.UpstreamId = ups.ID
+				if !skipVerify {
+					// A broken canary doesn't affect the primary route.
+					if err := t.translateCanary(ctx, kube.IngressExtensionsV1beta1, ing.Namespace, rule.Host, pathRule.Path, route); err != nil {
+						log.Warnw("failed to translate canary ingress, ignore it",
+							zap.Error(err),
+							zap.Any("ingress", ing),
+						)
+					}
+				}
 			}
 			ctx.AddRoute(route)
 		}
 	}
+	if ing.Spec.Backend != nil {
+		ups, err := t.translateIngressBackendV1beta1(ing.Namespace, ns, (*networkingv1beta1.IngressBackend)(ing.Spec.Backend), ingress, skipVerify)
+		if err != nil {
+			log.Errorw("failed to translate ingress default backend to upstream",
+				zap.Error(err),
+				zap.Any("ingress", ing),
+			)
+			return nil, err
+		}
+		if ups != nil {
+			ctx.AddUpstream(ups)
+			if err := t.translateDefaultBackendRoutes(ctx, kube.MustNewIngress(ing), ups, ingress); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return ctx, nil
 }
 
+// translateIngressBackendV1beta1 is same to translateIngressBackendV1, but for
+// networking/v1beta1 and extensions/v1beta1 Ingress.
+func (t *translator) translateIngressBackendV1beta1(namespace, svcNamespace string, backend *networkingv1beta1.IngressBackend,
+	ingress *Ingress, skipVerify bool) (*apisixv1.Upstream, error) {
+	if backend.Resource != nil {
+		return t.translateUpstreamFromResource(namespace, backend.Resource, skipVerify)
+	}
+	if backend.ServiceName == "" {
+		return nil, nil
+	}
+	var (
+		ups *apisixv1.Upstream
+		err error
+	)
+	if skipVerify {
+		ups = t.translateDefaultUpstreamFromIngressV1beta1(svcNamespace, backend.ServiceName, backend.ServicePort)
+	} else {
+		ups, err = t.translateUpstreamFromIngressV1beta1(svcNamespace, backend.ServiceName, backend.ServicePort)
+		if err != nil {
+			return nil, err
+		}
+	}
+	applyUpstreamAnnotations(ups, ingress)
+	return ups, nil
+}
+
 func (t *translator) translateDefaultUpstreamFromIngressV1beta1(namespace string, svcName string, svcPort intstr.IntOrString) *apisixv1.Upstream {
 	var portNumber int32
 	if svcPort.Type == intstr.String {
@@ -567,20 +736,20 @@ func (t *translator) translateUpstreamFromIngressV1beta1(namespace string, svcNa
 	return ups, nil
 }
 
-func (t *translator) TranslateOldIngress(ing kube.Ingress) (*translation.TranslateContext, error) {
+func (t *translator) TranslateOldIngress(ing kube.Ingress, clusterName string) (*translation.TranslateContext, error) {
 	switch ing.GroupVersion() {
 	case kube.IngressV1:
-		return t.translateOldIngressV1(ing.V1())
+		return t.translateOldIngressV1(ing.V1(), clusterName)
 	case kube.IngressV1beta1:
-		return t.translateOldIngressV1beta1(ing.V1beta1())
+		return t.translateOldIngressV1beta1(ing.V1beta1(), clusterName)
 	case kube.IngressExtensionsV1beta1:
-		return t.translateOldIngressExtensionsv1beta1(ing.ExtensionsV1beta1())
+		return t.translateOldIngressExtensionsv1beta1(ing.ExtensionsV1beta1(), clusterName)
 	default:
 		return nil, fmt.Errorf("translator: source group version not supported: %s", ing.GroupVersion())
 	}
 }
 
-func (t *translator) translateOldIngressV1(ing *networkingv1.Ingress) (*translation.TranslateContext, error) {
+func (t *translator) translateOldIngressV1(ing *networkingv1.Ingress, clusterName string) (*translation.TranslateContext, error) {
 	oldCtx := translation.DefaultEmptyTranslateContext()
 
 	for _, tls := range ing.Spec.TLS {
@@ -595,29 +764,23 @@ func (t *translator) translateOldIngressV1(ing *networkingv1.Ingress) (*translat
 		oldCtx.AddSSL(ssl)
 	}
 	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
 		for _, pathRule := range rule.HTTP.Paths {
 			name := composeIngressRouteName(ing.Namespace, ing.Name, rule.Host, pathRule.Path)
-			r, err := t.Apisix.Cluster(t.ClusterName).Route().Get(context.Background(), name)
-			if err != nil {
-				continue
-			}
-			if r.UpstreamId != "" {
-				ups := apisixv1.NewDefaultUpstream()
-				ups.ID = r.UpstreamId
-				oldCtx.AddUpstream(ups)
-			}
-			if r.PluginConfigId != "" {
-				pc := apisixv1.NewDefaultPluginConfig()
-				pc.ID = r.PluginConfigId
-				oldCtx.AddPluginConfig(pc)
-			}
-			oldCtx.AddRoute(r)
+			t.translateOldRoute(oldCtx, clusterName, name)
+		}
+	}
+	if ing.Spec.DefaultBackend != nil {
+		for _, name := range composeDefaultBackendRouteNames(kube.MustNewIngress(ing)) {
+			t.translateOldRoute(oldCtx, clusterName, name)
 		}
 	}
 	return oldCtx, nil
 }
 
-func (t *translator) translateOldIngressV1beta1(ing *networkingv1beta1.Ingress) (*translation.TranslateContext, error) {
+func (t *translator) translateOldIngressV1beta1(ing *networkingv1beta1.Ingress, clusterName string) (*translation.TranslateContext, error) {
 	oldCtx := translation.DefaultEmptyTranslateContext()
 
 	for _, tls := range ing.Spec.TLS {
@@ -628,29 +791,23 @@ func (t *translator) translateOldIngressV1beta1(ing *networkingv1beta1.Ingress)
 		oldCtx.AddSSL(ssl)
 	}
 	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
 		for _, pathRule := range rule.HTTP.Paths {
 			name := composeIngressRouteName(ing.Namespace, ing.Name, rule.Host, pathRule.Path)
-			r, err := t.Apisix.Cluster(t.ClusterName).Route().Get(context.Background(), name)
-			if err != nil {
-				continue
-			}
-			if r.UpstreamId != "" {
-				ups := apisixv1.NewDefaultUpstream()
-				ups.ID = r.UpstreamId
-				oldCtx.AddUpstream(ups)
-			}
-			if r.PluginConfigId != "" {
-				pc := apisixv1.NewDefaultPluginConfig()
-				pc.ID = r.PluginConfigId
-				oldCtx.AddPluginConfig(pc)
-			}
-			oldCtx.AddRoute(r)
+			t.translateOldRoute(oldCtx, clusterName, name)
+		}
+	}
+	if ing.Spec.Backend != nil {
+		for _, name := range composeDefaultBackendRouteNames(kube.MustNewIngress(ing)) {
+			t.translateOldRoute(oldCtx, clusterName, name)
 		}
 	}
 	return oldCtx, nil
 }
 
-func (t *translator) translateOldIngressExtensionsv1beta1(ing *extensionsv1beta1.Ingress) (*translation.TranslateContext, error) {
+func (t *translator) translateOldIngressExtensionsv1beta1(ing *extensionsv1beta1.Ingress, clusterName string) (*translation.TranslateContext, error) {
 	oldCtx := translation.DefaultEmptyTranslateContext()
 
 	for _, tls := range ing.Spec.TLS {
@@ -661,28 +818,60 @@ func (t *translator) translateOldIngressExtensionsv1beta1(ing *extensionsv1beta1
 		oldCtx.AddSSL(ssl)
 	}
 	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
 		for _, pathRule := range rule.HTTP.Paths {
 			name := composeIngressRouteName(ing.Namespace, ing.Name, rule.Host, pathRule.Path)
-			r, err := t.Apisix.Cluster(t.ClusterName).Route().Get(context.Background(), name)
-			if err != nil {
-				continue
-			}
-			if r.UpstreamId != "" {
-				ups := apisixv1.NewDefaultUpstream()
-				ups.ID = r.UpstreamId
-				oldCtx.AddUpstream(ups)
-			}
-			if r.PluginConfigId != "" {
-				pc := apisixv1.NewDefaultPluginConfig()
-				pc.ID = r.PluginConfigId
-				oldCtx.AddPluginConfig(pc)
-			}
-			oldCtx.AddRoute(r)
+			t.translateOldRoute(oldCtx, clusterName, name)
+		}
+	}
+	if ing.Spec.Backend != nil {
+		for _, name := range composeDefaultBackendRouteNames(kube.MustNewIngress(ing)) {
+			t.translateOldRoute(oldCtx, clusterName, name)
 		}
 	}
 	return oldCtx, nil
 }
 
+// translateOldRoute gets the route from the cache of the given cluster, and adds
+// it to the context with the upstreams and plugin_config it refers to. The
+// upstreams include the ones of the traffic-split plugin, e.g. the canary
+// upstream.
+func (t *translator) translateOldRoute(oldCtx *translation.TranslateContext, clusterName, name string) {
+	r, err := t.Apisix.Cluster(clusterName).Route().Get(context.Background(), name)
+	if err != nil {
+		return
+	}
+	upstreamIDs := r.Plugins.TrafficSplitUpstreamIDs()
+	if r.UpstreamId != "" {
+		upstreamIDs = append([]string{r.UpstreamId}, upstreamIDs...)
+	}
+	for _, upstreamID := range upstreamIDs {
+		addOldUpstream(oldCtx, upstreamID)
+	}
+	if r.PluginConfigId != "" {
+		pc := apisixv1.NewDefaultPluginConfig()
+		pc.ID = r.PluginConfigId
+		oldCtx.AddPluginConfig(pc)
+	}
+	oldCtx.AddRoute(r)
+}
+
+// addOldUpstream adds the upstream of the ID to the context. The names of old
+// upstreams are unknown, so they are deduplicated by ID rather than by
+// AddUpstream.
+func addOldUpstream(oldCtx *translation.TranslateContext, upstreamID string) {
+	for _, ups := range oldCtx.Upstreams {
+		if ups.ID == upstreamID {
+			return
+		}
+	}
+	ups := apisixv1.NewDefaultUpstream()
+	ups.ID = upstreamID
+	oldCtx.Upstreams = append(oldCtx.Upstreams, ups)
+}
+
 // In the past, we used host + path directly to form its route name for readability,
 // but this method can cause problems in some scenarios.
 // For example, the generated name is too long.
@@ -0,0 +1,204 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package translation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apisixclient "github.com/apache/apisix-ingress-controller/pkg/apisix"
+	apisixcache "github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+// fakeRouteAPISIX serves routes by name, for translating old Ingresses.
+type fakeRouteAPISIX struct {
+	apisixclient.APISIX
+	routes map[string]*apisixv1.Route
+}
+
+func (f *fakeRouteAPISIX) Cluster(_ string) apisixclient.Cluster {
+	return &fakeRouteCluster{routes: f.routes}
+}
+
+type fakeRouteCluster struct {
+	apisixclient.Cluster
+	routes map[string]*apisixv1.Route
+}
+
+func (f *fakeRouteCluster) Route() apisixclient.Route {
+	return &fakeRoute{routes: f.routes}
+}
+
+type fakeRoute struct {
+	apisixclient.Route
+	routes map[string]*apisixv1.Route
+}
+
+func (f *fakeRoute) Get(_ context.Context, name string) (*apisixv1.Route, error) {
+	if r, ok := f.routes[name]; ok {
+		return r, nil
+	}
+	return nil, apisixcache.ErrNotFound
+}
+
+func TestTranslateOldIngressWithCanary(t *testing.T) {
+	pathType := networkingv1.PathTypePrefix
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "httpbin",
+			Namespace: "default",
+		},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{
+				{
+					Host: "httpbin.org",
+					IngressRuleValue: networkingv1.IngressRuleValue{
+						HTTP: &networkingv1.HTTPIngressRuleValue{
+							Paths: []networkingv1.HTTPIngressPath{
+								{Path: "/ip", PathType: &pathType},
+								{Path: "/get", PathType: &pathType},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	newRoute := func(path string) *apisixv1.Route {
+		r := apisixv1.NewDefaultRoute()
+		r.Name = composeIngressRouteName("default", "httpbin", "httpbin.org", path)
+		r.ID = id.GenID(r.Name)
+		r.UpstreamId = "stable"
+		return r
+	}
+	ip := newRoute("/ip")
+	ip.Plugins = apisixv1.Plugins{
+		"traffic-split": &apisixv1.TrafficSplitConfig{
+			Rules: []apisixv1.TrafficSplitConfigRule{
+				{
+					WeightedUpstreams: []apisixv1.TrafficSplitConfigRuleWeightedUpstream{
+						{UpstreamID: "canary", Weight: 10},
+						{Weight: 90},
+					},
+				},
+			},
+		},
+	}
+	get := newRoute("/get")
+	tr := &translator{
+		TranslatorOptions: &TranslatorOptions{
+			Apisix: &fakeRouteAPISIX{
+				routes: map[string]*apisixv1.Route{
+					ip.Name:  ip,
+					get.Name: get,
+				},
+			},
+		},
+	}
+
+	ctx, err := tr.TranslateOldIngress(kube.MustNewIngress(ing), "default")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []*apisixv1.Route{ip, get}, ctx.Routes)
+	var upstreamIDs []string
+	for _, ups := range ctx.Upstreams {
+		upstreamIDs = append(upstreamIDs, ups.ID)
+	}
+	assert.Equal(t, []string{"stable", "canary"}, upstreamIDs)
+}
+
+func TestTranslateOldIngressWithHostOnlyRule(t *testing.T) {
+	meta := metav1.ObjectMeta{
+		Name:      "httpbin",
+		Namespace: "default",
+	}
+	r := apisixv1.NewDefaultRoute()
+	r.Name = composeIngressRouteName("default", "httpbin", "httpbin.org", "/ip")
+	r.ID = id.GenID(r.Name)
+	tr := &translator{
+		TranslatorOptions: &TranslatorOptions{
+			Apisix: &fakeRouteAPISIX{
+				routes: map[string]*apisixv1.Route{r.Name: r},
+			},
+		},
+	}
+
+	ings := []kube.Ingress{
+		kube.MustNewIngress(&networkingv1.Ingress{
+			ObjectMeta: meta,
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{
+					{Host: "foo.org"},
+					{
+						Host: "httpbin.org",
+						IngressRuleValue: networkingv1.IngressRuleValue{
+							HTTP: &networkingv1.HTTPIngressRuleValue{
+								Paths: []networkingv1.HTTPIngressPath{{Path: "/ip"}},
+							},
+						},
+					},
+				},
+			},
+		}),
+		kube.MustNewIngress(&networkingv1beta1.Ingress{
+			ObjectMeta: meta,
+			Spec: networkingv1beta1.IngressSpec{
+				Rules: []networkingv1beta1.IngressRule{
+					{Host: "foo.org"},
+					{
+						Host: "httpbin.org",
+						IngressRuleValue: networkingv1beta1.IngressRuleValue{
+							HTTP: &networkingv1beta1.HTTPIngressRuleValue{
+								Paths: []networkingv1beta1.HTTPIngressPath{{Path: "/ip"}},
+							},
+						},
+					},
+				},
+			},
+		}),
+		kube.MustNewIngress(&extensionsv1beta1.Ingress{
+			ObjectMeta: meta,
+			Spec: extensionsv1beta1.IngressSpec{
+				Rules: []extensionsv1beta1.IngressRule{
+					{Host: "foo.org"},
+					{
+						Host: "httpbin.org",
+						IngressRuleValue: extensionsv1beta1.IngressRuleValue{
+							HTTP: &extensionsv1beta1.HTTPIngressRuleValue{
+								Paths: []extensionsv1beta1.HTTPIngressPath{{Path: "/ip"}},
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+	for _, ing := range ings {
+		ctx, err := tr.TranslateOldIngress(ing, "default")
+		assert.Nil(t, err, ing.GroupVersion())
+		assert.Equal(t, []*apisixv1.Route{r}, ctx.Routes, ing.GroupVersion())
+	}
+}
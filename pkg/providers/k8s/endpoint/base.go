@@ -24,6 +24,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	listerscorev1 "k8s.io/client-go/listers/core/v1"
 
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
 	"github.com/apache/apisix-ingress-controller/pkg/config"
 	"github.com/apache/apisix-ingress-controller/pkg/kube"
 	configv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/apis/config/v2"
@@ -88,7 +89,7 @@ func (c *baseEndpointController) syncEndpoint(ctx context.Context, ep kube.Endpo
 				}
 				name := apisixv1.ComposeUpstreamName(namespace, svcName, subset.Name, port.Port, types.ResolveGranularity.Endpoint)
 				for _, cluster := range clusters {
-					if err := c.SyncUpstreamNodesChangeToCluster(ctx, cluster, nodes, name); err != nil {
+					if err := c.syncUpstreamNodes(ctx, cluster, nodes, name); err != nil {
 						return err
 					}
 				}
@@ -119,7 +120,7 @@ func (c *baseEndpointController) syncEndpoint(ctx context.Context, ep kube.Endpo
 				}
 				name := apisixv1.ComposeUpstreamName(namespace, svcName, subset.Name, port.Port, types.ResolveGranularity.Endpoint)
 				for _, cluster := range clusters {
-					if err := c.SyncUpstreamNodesChangeToCluster(ctx, cluster, nodes, name); err != nil {
+					if err := c.syncUpstreamNodes(ctx, cluster, nodes, name); err != nil {
 						return err
 					}
 				}
@@ -131,6 +132,22 @@ func (c *baseEndpointController) syncEndpoint(ctx context.Context, ep kube.Endpo
 	return nil
 }
 
+// syncUpstreamNodes syncs the nodes to the upstream of the Service port, and
+// to the ones of GRPCRoutes, whose names are suffixed with the grpc schemes.
+func (c *baseEndpointController) syncUpstreamNodes(ctx context.Context, cluster apisix.Cluster, nodes apisixv1.UpstreamNodes, name string) error {
+	names := []string{
+		name,
+		apisixv1.ComposeGRPCUpstreamName(name, apisixv1.SchemeGRPC),
+		apisixv1.ComposeGRPCUpstreamName(name, apisixv1.SchemeGRPCS),
+	}
+	for _, upsName := range names {
+		if err := c.SyncUpstreamNodesChangeToCluster(ctx, cluster, nodes, upsName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *baseEndpointController) syncEmptyEndpoint(ctx context.Context, ep kube.Endpoint) error {
 	namespace, err := ep.Namespace()
 	if err != nil {
@@ -0,0 +1,109 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package endpoint
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/id"
+	"github.com/apache/apisix-ingress-controller/pkg/kube"
+	listersv2 "github.com/apache/apisix-ingress-controller/pkg/kube/apisix/client/listers/config/v2"
+	"github.com/apache/apisix-ingress-controller/pkg/providers/translation"
+	providertypes "github.com/apache/apisix-ingress-controller/pkg/providers/types"
+	"github.com/apache/apisix-ingress-controller/pkg/types"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestSyncEndpointOfGRPCUpstream(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "grpc",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "grpc", Port: 50051}},
+		},
+	}
+	svcIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, svcIndexer.Add(svc))
+	svcLister := listerscorev1.NewServiceLister(svcIndexer)
+
+	client, err := apisix.NewClient("v3")
+	assert.Nil(t, err)
+	assert.Nil(t, client.AddCluster(ctx, &apisix.ClusterOptions{
+		Name:               "default",
+		StandaloneRenderer: apisix.NewFileRenderer(filepath.Join(t.TempDir(), "apisix.yaml")),
+	}))
+	cluster := client.Cluster("default")
+
+	name := apisixv1.ComposeGRPCUpstreamName(
+		apisixv1.ComposeUpstreamName("default", "grpc", "", 50051, types.ResolveGranularity.Endpoint),
+		apisixv1.SchemeGRPC,
+	)
+	ups := apisixv1.NewDefaultUpstream()
+	ups.Name = name
+	ups.ID = id.GenID(name)
+	ups.Scheme = apisixv1.SchemeGRPC
+	ups.Nodes = apisixv1.UpstreamNodes{{Host: "10.0.0.1", Port: 50051, Weight: 100}}
+	_, err = cluster.Upstream().Create(ctx, ups)
+	assert.Nil(t, err)
+
+	cfg := config.NewDefaultConfig()
+	cfg.Kubernetes.APIVersion = config.ApisixV2
+	c := &baseEndpointController{
+		Common: &providertypes.Common{
+			Config: cfg,
+			APISIX: client,
+		},
+		translator: translation.NewTranslator(&translation.TranslatorOptions{
+			ServiceLister: svcLister,
+		}),
+		apisixUpstreamLister: kube.NewApisixUpstreamLister(nil,
+			listersv2.NewApisixUpstreamLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))),
+		svcLister: svcLister,
+	}
+
+	// The pod of the Service is replaced.
+	ep := kube.NewEndpoint(&corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "grpc",
+		},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.0.2"}},
+				Ports:     []corev1.EndpointPort{{Name: "grpc", Port: 50051}},
+			},
+		},
+	})
+	assert.Nil(t, c.syncEndpoint(ctx, ep))
+
+	ups, err = cluster.Upstream().Get(ctx, name)
+	assert.Nil(t, err)
+	assert.Equal(t, apisixv1.UpstreamNodes{{Host: "10.0.0.2", Port: 50051, Weight: 100}}, ups.Nodes)
+}
@@ -61,7 +61,7 @@ func newSecretController(common *providertypes.Common, namespaceProvider namespa
 
 		namespaceProvider: namespaceProvider,
 		apisixProvider:    apisixProvider,
-		ingressProvider:   apisixProvider,
+		ingressProvider:   ingressProvider,
 	}
 
 	c.secretInformer.AddEventHandler(
@@ -176,7 +176,7 @@ func (c *secretController) onAdd(obj interface{}) {
 		log.Errorf("found secret object with bad namespace/name: %s, ignore it", err)
 		return
 	}
-	if !c.namespaceProvider.IsWatchingNamespace(key) {
+	if !c.isWatchingSecret(key) {
 		return
 	}
 
@@ -203,7 +203,7 @@ func (c *secretController) onUpdate(prev, curr interface{}) {
 		log.Errorf("found secrets object with bad namespace/name: %s, ignore it", err)
 		return
 	}
-	if !c.namespaceProvider.IsWatchingNamespace(key) {
+	if !c.isWatchingSecret(key) {
 		return
 	}
 	log.Debugw("secret update event arrived",
@@ -236,7 +236,7 @@ func (c *secretController) onDelete(obj interface{}) {
 	// FIXME Refactor Controller.isWatchingNamespace to just use
 	// namespace after all controllers use the same way to fetch
 	// the object.
-	if !c.namespaceProvider.IsWatchingNamespace(key) {
+	if !c.isWatchingSecret(key) {
 		return
 	}
 	log.Debugw("secret delete event arrived",
@@ -250,3 +250,17 @@ func (c *secretController) onDelete(obj interface{}) {
 
 	c.MetricsCollector.IncrEvents("secret", "delete")
 }
+
+// isWatchingSecret tells whether the Secret should be handled. The admin key
+// Secret of the default cluster and the Secrets referenced by the admin config
+// of ApisixClusterConfigs are always handled, even if their namespaces are
+// not watched, since the controller itself depends on them.
+func (c *secretController) isWatchingSecret(key string) bool {
+	if c.Config.APISIX.DefaultClusterAdminKeySecret != "" && key == c.Config.APISIX.DefaultClusterAdminKeySecret {
+		return true
+	}
+	if c.namespaceProvider.IsWatchingNamespace(key) {
+		return true
+	}
+	return c.apisixProvider.IsAdminSecret(key)
+}
@@ -25,6 +25,7 @@ type TranslateContext struct {
 	SSL           []*apisix.Ssl
 	PluginConfigs []*apisix.PluginConfig
 	GlobalRules   []*apisix.GlobalRule
+	Services      []*apisix.Service
 }
 
 func DefaultEmptyTranslateContext() *TranslateContext {
@@ -65,3 +66,7 @@ func (tc *TranslateContext) AddPluginConfig(pc *apisix.PluginConfig) {
 func (tc *TranslateContext) AddGlobalRule(gr *apisix.GlobalRule) {
 	tc.GlobalRules = append(tc.GlobalRules, gr)
 }
+
+func (tc *TranslateContext) AddService(svc *apisix.Service) {
+	tc.Services = append(tc.Services, svc)
+}
@@ -18,12 +18,17 @@ package types
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
 	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
 	listerscorev1 "k8s.io/client-go/listers/core/v1"
+	listersnetworkingv1 "k8s.io/client-go/listers/networking/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
@@ -44,6 +49,19 @@ type Provider interface {
 	Run(ctx context.Context)
 }
 
+// ManifestTranslator translates the watched resources to APISIX resources
+// without syncing them, it's used to preview the pending changes.
+type ManifestTranslator interface {
+	// TranslateManifests translates the resources of the given kind into m,
+	// only the resource with the given key is translated if key is not empty.
+	// Resources which failed to be translated are skipped and their errors
+	// are returned together.
+	TranslateManifests(kind, key string, m *utils.Manifest) error
+	// ListManifestResources lists the resources of the given kind, which can
+	// be translated by TranslateManifests one by one.
+	ListManifestResources(kind string) ([]interface{}, error)
+}
+
 type ListerInformer struct {
 	KubeFactory   informers.SharedInformerFactory
 	ApisixFactory externalversions.SharedInformerFactory
@@ -69,21 +87,36 @@ type ListerInformer struct {
 	IngressLister   kube.IngressLister
 	IngressInformer cache.SharedIndexInformer
 
-	ApisixUpstreamInformer      cache.SharedIndexInformer
-	ApisixRouteInformer         cache.SharedIndexInformer
-	ApisixPluginConfigInformer  cache.SharedIndexInformer
-	ApisixConsumerInformer      cache.SharedIndexInformer
-	ApisixTlsInformer           cache.SharedIndexInformer
-	ApisixClusterConfigInformer cache.SharedIndexInformer
-	ApisixGlobalRuleInformer    cache.SharedIndexInformer
-
-	ApisixRouteLister         kube.ApisixRouteLister
-	ApisixUpstreamLister      kube.ApisixUpstreamLister
-	ApisixPluginConfigLister  kube.ApisixPluginConfigLister
-	ApisixConsumerLister      kube.ApisixConsumerLister
-	ApisixTlsLister           kube.ApisixTlsLister
-	ApisixClusterConfigLister kube.ApisixClusterConfigLister
-	ApisixGlobalRuleLister    kube.ApisixGlobalRuleLister
+	// IngressClassLister and IngressClassInformer are nil unless the
+	// Ingress version is networking/v1.
+	IngressClassLister   listersnetworkingv1.IngressClassLister
+	IngressClassInformer cache.SharedIndexInformer
+
+	ApisixUpstreamInformer           cache.SharedIndexInformer
+	ApisixRouteInformer              cache.SharedIndexInformer
+	ApisixPluginConfigInformer       cache.SharedIndexInformer
+	ApisixConsumerInformer           cache.SharedIndexInformer
+	ApisixTlsInformer                cache.SharedIndexInformer
+	ApisixClusterConfigInformer      cache.SharedIndexInformer
+	ApisixGlobalRuleInformer         cache.SharedIndexInformer
+	ApisixConsumerGroupInformer      cache.SharedIndexInformer
+	ApisixSecretProviderInformer     cache.SharedIndexInformer
+	ApisixServiceInformer            cache.SharedIndexInformer
+	ApisixProtoInformer              cache.SharedIndexInformer
+	ApisixIngressClassConfigInformer cache.SharedIndexInformer
+
+	ApisixRouteLister              kube.ApisixRouteLister
+	ApisixUpstreamLister           kube.ApisixUpstreamLister
+	ApisixPluginConfigLister       kube.ApisixPluginConfigLister
+	ApisixConsumerLister           kube.ApisixConsumerLister
+	ApisixTlsLister                kube.ApisixTlsLister
+	ApisixClusterConfigLister      kube.ApisixClusterConfigLister
+	ApisixGlobalRuleLister         kube.ApisixGlobalRuleLister
+	ApisixConsumerGroupLister      kube.ApisixConsumerGroupLister
+	ApisixSecretProviderLister     kube.ApisixSecretProviderLister
+	ApisixServiceLister            kube.ApisixServiceLister
+	ApisixProtoLister              kube.ApisixProtoLister
+	ApisixIngressClassConfigLister kube.ApisixIngressClassConfigLister
 }
 
 func (c *ListerInformer) StartAndWaitForCacheSync(ctx context.Context) bool {
@@ -149,18 +182,119 @@ func (c *Common) RecordEventS(object runtime.Object, eventtype, reason string, m
 
 // TODO: Move sync utils to apisix.APISIX interface?
 func (c *Common) SyncManifests(ctx context.Context, added, updated, deleted *utils.Manifest) error {
-	return utils.SyncManifests(ctx, c.APISIX, c.Config.APISIX.DefaultClusterName, added, updated, deleted)
+	return c.SyncClusterManifests(ctx, c.Config.APISIX.DefaultClusterName, added, updated, deleted)
 }
 
-// TODO: suppport multiple cluster
 func (c *Common) SyncClusterManifests(ctx context.Context, clusterName string, added, updated, deleted *utils.Manifest) error {
-	if clusterName != c.Config.APISIX.DefaultClusterName {
-		log.Errorw("cluster does not exist",
-			zap.String("cluster_name", clusterName),
+	if c.Config.APISIX.TransactionalSync {
+		return utils.ApplyManifests(ctx, c.APISIX, clusterName, added, updated, deleted)
+	}
+	return utils.SyncManifests(ctx, c.APISIX, clusterName, added, updated, deleted)
+}
+
+// SyncClustersManifests syncs the manifests to each of the given clusters. A
+// *utils.ClusterSyncError is returned if any of the clusters failed to sync.
+func (c *Common) SyncClustersManifests(ctx context.Context, clusters []string, added, updated, deleted *utils.Manifest) error {
+	errs := make(map[string]error)
+	for _, cluster := range clusters {
+		if err := c.SyncClusterManifests(ctx, cluster, added, updated, deleted); err != nil {
+			log.Errorw("failed to sync manifests to cluster",
+				zap.String("cluster", cluster),
+				zap.Error(err),
+			)
+			errs[cluster] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &utils.ClusterSyncError{Errors: errs}
+	}
+	return nil
+}
+
+// SelectClusters returns the names of APISIX clusters that the object should be
+// synced to. If the object doesn't have the cluster selector annotation, only the
+// default cluster is selected, otherwise the selector is matched against the labels
+// of ApisixClusterConfig resources whose clusters are registered.
+func (c *Common) SelectClusters(obj metav1.Object) ([]string, error) {
+	value, ok := obj.GetAnnotations()[utils.ClusterSelectorAnnotation]
+	if !ok {
+		return []string{c.Config.APISIX.DefaultClusterName}, nil
+	}
+	selector, err := labels.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cluster selector %q: %s", value, err)
+	}
+
+	var clusters []string
+	for _, item := range c.ApisixClusterConfigInformer.GetIndexer().List() {
+		acc, err := kube.NewApisixClusterConfig(item)
+		if err != nil {
+			continue
+		}
+		var (
+			accMeta  metav1.Object
+			hasAdmin bool
 		)
+		switch acc.GroupVersion() {
+		case config.ApisixV2beta3:
+			accMeta = acc.V2beta3()
+			hasAdmin = acc.V2beta3().Spec.Admin != nil
+		case config.ApisixV2:
+			accMeta = acc.V2()
+			hasAdmin = acc.V2().Spec.Admin != nil
+		default:
+			continue
+		}
+		// Only the default cluster and clusters with admin config are registered.
+		if accMeta.GetName() != c.Config.APISIX.DefaultClusterName && !hasAdmin {
+			continue
+		}
+		if selector.Matches(labels.Set(accMeta.GetLabels())) {
+			clusters = append(clusters, accMeta.GetName())
+		}
+	}
+	sort.Strings(clusters)
+	return clusters, nil
+}
+
+// ClusterConditions builds the per-cluster status conditions for the object
+// according to the sync error. An empty slice is returned if the object doesn't
+// select clusters by itself, and nil is returned if the per-cluster status
+// cannot be decided (the sync failed before pushing to clusters).
+func (c *Common) ClusterConditions(obj metav1.Object, err error, generation int64) []metav1.Condition {
+	if _, ok := obj.GetAnnotations()[utils.ClusterSelectorAnnotation]; !ok {
+		return []metav1.Condition{}
+	}
+	clusterErrs := map[string]error{}
+	if err != nil {
+		var syncErr *utils.ClusterSyncError
+		if !errors.As(err, &syncErr) {
+			return nil
+		}
+		clusterErrs = syncErr.Errors
+	}
+	clusters, selectErr := c.SelectClusters(obj)
+	if selectErr != nil {
 		return nil
 	}
-	return utils.SyncManifests(ctx, c.APISIX, clusterName, added, updated, deleted)
+
+	conditions := make([]metav1.Condition, 0, len(clusters))
+	for _, cluster := range clusters {
+		condition := metav1.Condition{
+			Type:               utils.ClusterConditionType(cluster),
+			Reason:             utils.ResourceSynced,
+			Status:             metav1.ConditionTrue,
+			Message:            utils.CommonSuccessMessage,
+			ObservedGeneration: generation,
+		}
+		if clusterErr, ok := clusterErrs[cluster]; ok {
+			condition.Reason = utils.SyncFailedReason(clusterErr)
+			condition.Status = metav1.ConditionFalse
+			condition.Message = clusterErr.Error()
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions
 }
 
 func (c *Common) SyncSSL(ctx context.Context, ssl *apisixv1.Ssl, event types.EventType) error {
@@ -202,6 +336,42 @@ func (c *Common) SyncConsumer(ctx context.Context, consumer *apisixv1.Consumer,
 	return
 }
 
+func (c *Common) SyncConsumerGroup(ctx context.Context, cg *apisixv1.ConsumerGroup, event types.EventType) (err error) {
+	clusterName := c.Config.APISIX.DefaultClusterName
+	if event == types.EventDelete {
+		err = c.APISIX.Cluster(clusterName).ConsumerGroup().Delete(ctx, cg)
+	} else if event == types.EventUpdate {
+		_, err = c.APISIX.Cluster(clusterName).ConsumerGroup().Update(ctx, cg)
+	} else {
+		_, err = c.APISIX.Cluster(clusterName).ConsumerGroup().Create(ctx, cg)
+	}
+	return
+}
+
+func (c *Common) SyncProto(ctx context.Context, proto *apisixv1.Proto, event types.EventType) (err error) {
+	clusterName := c.Config.APISIX.DefaultClusterName
+	if event == types.EventDelete {
+		err = c.APISIX.Cluster(clusterName).Proto().Delete(ctx, proto)
+	} else if event == types.EventUpdate {
+		_, err = c.APISIX.Cluster(clusterName).Proto().Update(ctx, proto)
+	} else {
+		_, err = c.APISIX.Cluster(clusterName).Proto().Create(ctx, proto)
+	}
+	return
+}
+
+func (c *Common) SyncSecret(ctx context.Context, secret *apisixv1.Secret, event types.EventType) (err error) {
+	clusterName := c.Config.APISIX.DefaultClusterName
+	if event == types.EventDelete {
+		err = c.APISIX.Cluster(clusterName).Secret().Delete(ctx, secret)
+	} else if event == types.EventUpdate {
+		_, err = c.APISIX.Cluster(clusterName).Secret().Update(ctx, secret)
+	} else {
+		_, err = c.APISIX.Cluster(clusterName).Secret().Create(ctx, secret)
+	}
+	return
+}
+
 func (c *Common) SyncUpstreamNodesChangeToCluster(ctx context.Context, cluster apisix.Cluster, nodes apisixv1.UpstreamNodes, upsName string) error {
 	log.Debugw("sync upstream nodes change",
 		zap.String("cluster", cluster.String()),
@@ -237,8 +407,6 @@ func (c *Common) SyncUpstreamNodesChangeToCluster(ctx context.Context, cluster a
 		zap.String("cluster", cluster.String()),
 	)
 
-	updated := &utils.Manifest{
-		Upstreams: []*apisixv1.Upstream{upstream},
-	}
-	return c.SyncManifests(ctx, nil, updated, nil)
+	_, err = cluster.Upstream().Update(ctx, upstream)
+	return err
 }
@@ -0,0 +1,145 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+	"github.com/apache/apisix-ingress-controller/pkg/metrics"
+)
+
+const (
+	// ClusterSelectorAnnotation is the annotation to select the APISIX clusters
+	// that a resource should be synced to. The value is a label selector which
+	// is matched against the labels of ApisixClusterConfig resources. Resources
+	// without this annotation are only synced to the default cluster.
+	ClusterSelectorAnnotation = "k8s.apisix.apache.org/cluster-selector"
+
+	// _clusterConditionTypePrefix is the prefix of per-cluster condition types.
+	_clusterConditionTypePrefix = ConditionType + "/"
+)
+
+// ClusterSyncError is returned when a resource failed to be synced
+// to some of the selected APISIX clusters, it keeps the error of
+// each failed cluster.
+type ClusterSyncError struct {
+	Errors map[string]error
+}
+
+func (e *ClusterSyncError) Error() string {
+	clusters := make([]string, 0, len(e.Errors))
+	for cluster := range e.Errors {
+		clusters = append(clusters, cluster)
+	}
+	sort.Strings(clusters)
+
+	msgs := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		msgs = append(msgs, fmt.Sprintf("cluster %s: %s", cluster, e.Errors[cluster]))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ClusterConditionType returns the condition type which reports the
+// sync status of a resource in the given APISIX cluster.
+func ClusterConditionType(cluster string) string {
+	return _clusterConditionTypePrefix + cluster
+}
+
+// SetClusterConditions sets the per-cluster conditions, conditions of
+// clusters which are not in clusterConditions will be removed. It returns
+// true if conditions was changed.
+func SetClusterConditions(conditions *[]metav1.Condition, clusterConditions []metav1.Condition) bool {
+	changed := false
+	expected := make(map[string]struct{}, len(clusterConditions))
+	for _, cond := range clusterConditions {
+		expected[cond.Type] = struct{}{}
+		existing := meta.FindStatusCondition(*conditions, cond.Type)
+		if existing != nil && existing.Status == cond.Status && existing.Reason == cond.Reason &&
+			existing.Message == cond.Message && existing.ObservedGeneration == cond.ObservedGeneration {
+			continue
+		}
+		meta.SetStatusCondition(conditions, cond)
+		changed = true
+	}
+
+	var stale []string
+	for _, cond := range *conditions {
+		if !strings.HasPrefix(cond.Type, _clusterConditionTypePrefix) {
+			continue
+		}
+		if _, ok := expected[cond.Type]; !ok {
+			stale = append(stale, cond.Type)
+		}
+	}
+	for _, condType := range stale {
+		meta.RemoveStatusCondition(conditions, condType)
+		changed = true
+	}
+	return changed
+}
+
+// AdminKeyFromSecret returns the APISIX admin key stored in the Secret
+// under the given key.
+func AdminKeyFromSecret(secret *corev1.Secret, key string) (string, error) {
+	adminKey, ok := secret.Data[key]
+	if !ok || len(adminKey) == 0 {
+		return "", fmt.Errorf("admin key %s not found in secret %s/%s", key, secret.Namespace, secret.Name)
+	}
+	return string(adminKey), nil
+}
+
+// DefaultClusterOptions returns the options of the default APISIX cluster,
+// TLS materials are read from the configured files.
+func DefaultClusterOptions(cfg *config.APISIXConfig, collector metrics.Collector) (*apisix.ClusterOptions, error) {
+	opts := &apisix.ClusterOptions{
+		AdminAPIVersion:  cfg.AdminAPIVersion,
+		Name:             cfg.DefaultClusterName,
+		AdminKey:         cfg.GetDefaultClusterAdminKey(),
+		BaseURL:          cfg.DefaultClusterBaseURL,
+		ServerName:       cfg.DefaultClusterTLSServerName,
+		MetricsCollector: collector,
+	}
+	var err error
+	if cfg.DefaultClusterCACertFile != "" {
+		opts.CACert, err = os.ReadFile(cfg.DefaultClusterCACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA cert file: %s", err)
+		}
+	}
+	if cfg.DefaultClusterClientCertFile != "" {
+		opts.ClientCert, err = os.ReadFile(cfg.DefaultClusterClientCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client cert file: %s", err)
+		}
+	}
+	if cfg.DefaultClusterClientKeyFile != "" {
+		opts.ClientKey, err = os.ReadFile(cfg.DefaultClusterClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client key file: %s", err)
+		}
+	}
+	return opts, nil
+}
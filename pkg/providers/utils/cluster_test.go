@@ -0,0 +1,150 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/apisix-ingress-controller/pkg/config"
+)
+
+func TestClusterSyncError(t *testing.T) {
+	err := &ClusterSyncError{
+		Errors: map[string]error{
+			"zone-b": errors.New("timeout"),
+			"zone-a": errors.New("client not exist"),
+		},
+	}
+	assert.Equal(t, "cluster zone-a: client not exist; cluster zone-b: timeout", err.Error())
+}
+
+func TestSetClusterConditions(t *testing.T) {
+	conditions := []metav1.Condition{
+		{
+			Type:               ConditionType,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+		},
+		{
+			Type:               ClusterConditionType("zone-a"),
+			Status:             metav1.ConditionTrue,
+			Reason:             ResourceSynced,
+			Message:            CommonSuccessMessage,
+			ObservedGeneration: 1,
+		},
+		{
+			Type:               ClusterConditionType("zone-b"),
+			Status:             metav1.ConditionTrue,
+			Reason:             ResourceSynced,
+			Message:            CommonSuccessMessage,
+			ObservedGeneration: 1,
+		},
+	}
+
+	// same conditions
+	changed := SetClusterConditions(&conditions, []metav1.Condition{
+		{
+			Type:               ClusterConditionType("zone-a"),
+			Status:             metav1.ConditionTrue,
+			Reason:             ResourceSynced,
+			Message:            CommonSuccessMessage,
+			ObservedGeneration: 1,
+		},
+		{
+			Type:               ClusterConditionType("zone-b"),
+			Status:             metav1.ConditionTrue,
+			Reason:             ResourceSynced,
+			Message:            CommonSuccessMessage,
+			ObservedGeneration: 1,
+		},
+	})
+	assert.False(t, changed)
+	assert.Len(t, conditions, 3)
+
+	// zone-a failed and zone-b is no longer selected
+	changed = SetClusterConditions(&conditions, []metav1.Condition{
+		{
+			Type:               ClusterConditionType("zone-a"),
+			Status:             metav1.ConditionFalse,
+			Reason:             ResourceSyncAborted,
+			Message:            "timeout",
+			ObservedGeneration: 2,
+		},
+	})
+	assert.True(t, changed)
+	assert.Len(t, conditions, 2)
+	assert.Equal(t, ConditionType, conditions[0].Type)
+	assert.Equal(t, ClusterConditionType("zone-a"), conditions[1].Type)
+	assert.Equal(t, metav1.ConditionFalse, conditions[1].Status)
+	assert.Equal(t, "timeout", conditions[1].Message)
+
+	// no clusters are selected
+	changed = SetClusterConditions(&conditions, []metav1.Condition{})
+	assert.True(t, changed)
+	assert.Len(t, conditions, 1)
+	assert.Equal(t, ConditionType, conditions[0].Type)
+}
+
+func TestAdminKeyFromSecret(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "admin-key",
+			Namespace: "ingress-apisix",
+		},
+		Data: map[string][]byte{
+			"admin-key": []byte("edd1c9f034335f136f87ad84b625c8f1"),
+		},
+	}
+	adminKey, err := AdminKeyFromSecret(secret, "admin-key")
+	assert.Nil(t, err)
+	assert.Equal(t, "edd1c9f034335f136f87ad84b625c8f1", adminKey)
+
+	_, err = AdminKeyFromSecret(secret, "key")
+	assert.NotNil(t, err)
+	assert.Equal(t, "admin key key not found in secret ingress-apisix/admin-key", err.Error())
+}
+
+func TestDefaultClusterOptions(t *testing.T) {
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.crt")
+	assert.Nil(t, os.WriteFile(caFile, []byte("ca"), 0600))
+
+	cfg := &config.APISIXConfig{
+		DefaultClusterName:          "default",
+		DefaultClusterBaseURL:       "https://apisix-admin:9180/apisix/admin",
+		DefaultClusterAdminKey:      "123456",
+		DefaultClusterCACertFile:    caFile,
+		DefaultClusterTLSServerName: "apisix-admin",
+	}
+	opts, err := DefaultClusterOptions(cfg, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "default", opts.Name)
+	assert.Equal(t, "123456", opts.AdminKey)
+	assert.Equal(t, []byte("ca"), opts.CACert)
+	assert.Equal(t, "apisix-admin", opts.ServerName)
+	assert.Nil(t, opts.ClientCert)
+
+	cfg.DefaultClusterClientCertFile = filepath.Join(dir, "tls.crt")
+	_, err = DefaultClusterOptions(cfg, nil)
+	assert.NotNil(t, err)
+}
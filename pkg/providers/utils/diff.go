@@ -0,0 +1,454 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/client-go/tools/cache"
+
+	apisixcache "github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	// DiffActionAdd means the object will be created.
+	DiffActionAdd = "add"
+	// DiffActionUpdate means the object will be updated.
+	DiffActionUpdate = "update"
+	// DiffActionDelete means the object will be deleted.
+	DiffActionDelete = "delete"
+
+	_managedByLabel   = "managed-by"
+	_managedByValue   = "apisix-ingress-controller"
+	_redactedValue    = "<redacted>"
+	_fieldPathRootKey = ""
+)
+
+var (
+	// ErrNotLeader means the pending changes are unavailable since the
+	// controller is not the leader.
+	ErrNotLeader = errors.New("controller is not the leader")
+	// ErrBadDiffRequest means the pending changes are requested with
+	// invalid arguments.
+	ErrBadDiffRequest = errors.New("bad diff request")
+)
+
+// FieldDiff is a changed field of an APISIX object, Path is the path of the
+// field in the JSON representation of the object, e.g. "plugins.cors.max_age".
+type FieldDiff struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+// ObjectDiff describes how an APISIX object will be changed.
+type ObjectDiff struct {
+	Kind   string      `json:"kind"`
+	ID     string      `json:"id"`
+	Name   string      `json:"name,omitempty"`
+	Action string      `json:"action"`
+	Fields []FieldDiff `json:"fields,omitempty"`
+}
+
+// ManifestDiff contains the changes which will be applied to an APISIX cluster
+// to reach the desired manifest. Private keys of SSL objects and configs of
+// plugins, which may contain the values of Secrets, are redacted.
+type ManifestDiff struct {
+	Cluster string       `json:"cluster"`
+	Added   *Manifest    `json:"added"`
+	Updated *Manifest    `json:"updated"`
+	Deleted *Manifest    `json:"deleted"`
+	Objects []ObjectDiff `json:"objects,omitempty"`
+	// Errors are the errors occurred when translating the resources, these
+	// resources are not included in the diff.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ListObjects lists the objects in the indexer, only the object with the given
+// key is returned if key is not empty.
+func ListObjects(indexer cache.Indexer, key string) ([]interface{}, error) {
+	if key == "" {
+		return indexer.List(), nil
+	}
+	obj, exists, err := indexer.GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: resource %s not found", ErrBadDiffRequest, key)
+	}
+	return []interface{}{obj}, nil
+}
+
+type diffKind[T any] struct {
+	kind   string
+	id     func(T) string
+	name   func(T) string
+	labels func(T) map[string]string
+}
+
+var (
+	_routeKind = diffKind[*apisixv1.Route]{
+		kind:   "route",
+		id:     func(r *apisixv1.Route) string { return r.ID },
+		name:   func(r *apisixv1.Route) string { return r.Name },
+		labels: func(r *apisixv1.Route) map[string]string { return r.Labels },
+	}
+	_upstreamKind = diffKind[*apisixv1.Upstream]{
+		kind:   "upstream",
+		id:     func(u *apisixv1.Upstream) string { return u.ID },
+		name:   func(u *apisixv1.Upstream) string { return u.Name },
+		labels: func(u *apisixv1.Upstream) map[string]string { return u.Labels },
+	}
+	_streamRouteKind = diffKind[*apisixv1.StreamRoute]{
+		kind:   "stream_route",
+		id:     func(sr *apisixv1.StreamRoute) string { return sr.ID },
+		name:   func(sr *apisixv1.StreamRoute) string { return "" },
+		labels: func(sr *apisixv1.StreamRoute) map[string]string { return sr.Labels },
+	}
+	_sslKind = diffKind[*apisixv1.Ssl]{
+		kind:   "ssl",
+		id:     func(ssl *apisixv1.Ssl) string { return ssl.ID },
+		name:   func(ssl *apisixv1.Ssl) string { return "" },
+		labels: func(ssl *apisixv1.Ssl) map[string]string { return ssl.Labels },
+	}
+	_pluginConfigKind = diffKind[*apisixv1.PluginConfig]{
+		kind:   "plugin_config",
+		id:     func(pc *apisixv1.PluginConfig) string { return pc.ID },
+		name:   func(pc *apisixv1.PluginConfig) string { return pc.Name },
+		labels: func(pc *apisixv1.PluginConfig) map[string]string { return pc.Labels },
+	}
+	_serviceKind = diffKind[*apisixv1.Service]{
+		kind:   "service",
+		id:     func(svc *apisixv1.Service) string { return svc.ID },
+		name:   func(svc *apisixv1.Service) string { return svc.Name },
+		labels: func(svc *apisixv1.Service) map[string]string { return svc.Labels },
+	}
+	// Global rules don't have labels, so they are never pruned.
+	_globalRuleKind = diffKind[*apisixv1.GlobalRule]{
+		kind:   "global_rule",
+		id:     func(gr *apisixv1.GlobalRule) string { return gr.ID },
+		name:   func(gr *apisixv1.GlobalRule) string { return "" },
+		labels: func(gr *apisixv1.GlobalRule) map[string]string { return nil },
+	}
+)
+
+// DiffManifestWithCache compares the desired manifest with the contents of
+// the APISIX cluster cache, nothing is applied. Objects in the cache which are
+// managed by apisix-ingress-controller, translated from the resources of
+// pruneKinds, but not in the desired manifest are reported as deleted. The
+// manifest should contain all the watched resources of pruneKinds, objects
+// translated from other kinds are never reported as deleted.
+func DiffManifestWithCache(c apisixcache.Cache, m *Manifest, pruneKinds []string) (*ManifestDiff, error) {
+	var err error
+	desired := &Manifest{
+		Routes:        dedupObjects(_routeKind, m.Routes),
+		Upstreams:     dedupObjects(_upstreamKind, m.Upstreams),
+		StreamRoutes:  dedupObjects(_streamRouteKind, m.StreamRoutes),
+		SSLs:          dedupObjects(_sslKind, m.SSLs),
+		PluginConfigs: dedupObjects(_pluginConfigKind, m.PluginConfigs),
+		GlobalRules:   dedupObjects(_globalRuleKind, m.GlobalRules),
+		Services:      dedupObjects(_serviceKind, m.Services),
+	}
+	om := &Manifest{}
+	if om.Routes, err = cachedObjects(_routeKind, desired.Routes, c.GetRoute, c.ListRoutes, pruneKinds); err != nil {
+		return nil, err
+	}
+	if om.Upstreams, err = cachedObjects(_upstreamKind, desired.Upstreams, c.GetUpstream, c.ListUpstreams, pruneKinds); err != nil {
+		return nil, err
+	}
+	if om.StreamRoutes, err = cachedObjects(_streamRouteKind, desired.StreamRoutes, c.GetStreamRoute, c.ListStreamRoutes, pruneKinds); err != nil {
+		return nil, err
+	}
+	if om.SSLs, err = cachedObjects(_sslKind, desired.SSLs, c.GetSSL, c.ListSSL, pruneKinds); err != nil {
+		return nil, err
+	}
+	if om.PluginConfigs, err = cachedObjects(_pluginConfigKind, desired.PluginConfigs, c.GetPluginConfig, c.ListPluginConfigs, pruneKinds); err != nil {
+		return nil, err
+	}
+	if om.GlobalRules, err = cachedObjects(_globalRuleKind, desired.GlobalRules, c.GetGlobalRule, c.ListGlobalRules, pruneKinds); err != nil {
+		return nil, err
+	}
+	if om.Services, err = cachedObjects(_serviceKind, desired.Services, c.GetService, c.ListServices, pruneKinds); err != nil {
+		return nil, err
+	}
+
+	added, updated, deleted := desired.Diff(om)
+	diff := &ManifestDiff{
+		Added:   added,
+		Updated: updated,
+		Deleted: deleted,
+	}
+	if updated.Routes, err = diffObjects(diff, _routeKind, added.Routes, updated.Routes, deleted.Routes, om.Routes); err != nil {
+		return nil, err
+	}
+	if updated.Upstreams, err = diffObjects(diff, _upstreamKind, added.Upstreams, updated.Upstreams, deleted.Upstreams, om.Upstreams); err != nil {
+		return nil, err
+	}
+	if updated.StreamRoutes, err = diffObjects(diff, _streamRouteKind, added.StreamRoutes, updated.StreamRoutes, deleted.StreamRoutes, om.StreamRoutes); err != nil {
+		return nil, err
+	}
+	if updated.SSLs, err = diffObjects(diff, _sslKind, added.SSLs, updated.SSLs, deleted.SSLs, om.SSLs); err != nil {
+		return nil, err
+	}
+	if updated.PluginConfigs, err = diffObjects(diff, _pluginConfigKind, added.PluginConfigs, updated.PluginConfigs, deleted.PluginConfigs, om.PluginConfigs); err != nil {
+		return nil, err
+	}
+	if updated.GlobalRules, err = diffObjects(diff, _globalRuleKind, added.GlobalRules, updated.GlobalRules, deleted.GlobalRules, om.GlobalRules); err != nil {
+		return nil, err
+	}
+	if updated.Services, err = diffObjects(diff, _serviceKind, added.Services, updated.Services, deleted.Services, om.Services); err != nil {
+		return nil, err
+	}
+	diff.Added = redactManifest(added)
+	diff.Updated = redactManifest(updated)
+	diff.Deleted = redactManifest(deleted)
+	return diff, nil
+}
+
+// dedupObjects removes the objects with duplicated id, the last one wins.
+func dedupObjects[T any](k diffKind[T], objs []T) []T {
+	index := make(map[string]int, len(objs))
+	var result []T
+	for _, obj := range objs {
+		if i, ok := index[k.id(obj)]; ok {
+			result[i] = obj
+			continue
+		}
+		index[k.id(obj)] = len(result)
+		result = append(result, obj)
+	}
+	return result
+}
+
+// cachedObjects returns the cached objects which have the same id as the
+// desired ones, and the ones managed by pruneKinds if any.
+func cachedObjects[T any](k diffKind[T], desired []T, get func(string) (T, error), list func() ([]T, error), pruneKinds []string) ([]T, error) {
+	var result []T
+	if len(pruneKinds) > 0 {
+		objs, err := list()
+		if err != nil {
+			return nil, err
+		}
+		ids := make(map[string]struct{}, len(desired))
+		for _, obj := range desired {
+			ids[k.id(obj)] = struct{}{}
+		}
+		for _, obj := range objs {
+			if _, ok := ids[k.id(obj)]; ok || isManagedByKinds(k.labels(obj), pruneKinds) {
+				result = append(result, obj)
+			}
+		}
+		return result, nil
+	}
+	for _, obj := range desired {
+		old, err := get(k.id(obj))
+		if err == apisixcache.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, old)
+	}
+	return result, nil
+}
+
+// isManagedByKinds tells whether the object is managed by apisix-ingress-controller
+// and translated from a resource of the kinds.
+func isManagedByKinds(labels map[string]string, kinds []string) bool {
+	return labels[_managedByLabel] == _managedByValue && Contains(kinds, labels[LabelOwnerKind])
+}
+
+// diffObjects records the changes of the objects, updated objects which are
+// the same as the cached ones in JSON are dropped.
+func diffObjects[T any](diff *ManifestDiff, k diffKind[T], added, updated, deleted, olds []T) ([]T, error) {
+	for _, obj := range added {
+		diff.Objects = append(diff.Objects, ObjectDiff{Kind: k.kind, ID: k.id(obj), Name: k.name(obj), Action: DiffActionAdd})
+	}
+	oldMap := make(map[string]T, len(olds))
+	for _, old := range olds {
+		oldMap[k.id(old)] = old
+	}
+	var changed []T
+	for _, obj := range updated {
+		fields, err := DiffFields(oldMap[k.id(obj)], obj)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		fields = redactFields(k.kind, fields)
+		changed = append(changed, obj)
+		diff.Objects = append(diff.Objects, ObjectDiff{Kind: k.kind, ID: k.id(obj), Name: k.name(obj), Action: DiffActionUpdate, Fields: fields})
+	}
+	for _, obj := range deleted {
+		diff.Objects = append(diff.Objects, ObjectDiff{Kind: k.kind, ID: k.id(obj), Name: k.name(obj), Action: DiffActionDelete})
+	}
+	return changed, nil
+}
+
+// redactManifest returns a copy of the manifest, in which private keys of SSL
+// objects and configs of plugins are redacted.
+func redactManifest(m *Manifest) *Manifest {
+	redacted := *m
+	redacted.Routes = redactObjects(m.Routes, func(r *apisixv1.Route) *apisixv1.Route {
+		r = r.DeepCopy()
+		r.Plugins = redactPlugins(r.Plugins)
+		return r
+	})
+	redacted.StreamRoutes = redactObjects(m.StreamRoutes, func(sr *apisixv1.StreamRoute) *apisixv1.StreamRoute {
+		sr = sr.DeepCopy()
+		sr.Plugins = redactPlugins(sr.Plugins)
+		return sr
+	})
+	redacted.SSLs = redactObjects(m.SSLs, func(ssl *apisixv1.Ssl) *apisixv1.Ssl {
+		ssl = ssl.DeepCopy()
+		if ssl.Key != "" {
+			ssl.Key = _redactedValue
+		}
+		return ssl
+	})
+	redacted.PluginConfigs = redactObjects(m.PluginConfigs, func(pc *apisixv1.PluginConfig) *apisixv1.PluginConfig {
+		pc = pc.DeepCopy()
+		pc.Plugins = redactPlugins(pc.Plugins)
+		return pc
+	})
+	redacted.GlobalRules = redactObjects(m.GlobalRules, func(gr *apisixv1.GlobalRule) *apisixv1.GlobalRule {
+		gr = gr.DeepCopy()
+		gr.Plugins = redactPlugins(gr.Plugins)
+		return gr
+	})
+	redacted.Services = redactObjects(m.Services, func(svc *apisixv1.Service) *apisixv1.Service {
+		svc = svc.DeepCopy()
+		svc.Plugins = redactPlugins(svc.Plugins)
+		return svc
+	})
+	return &redacted
+}
+
+func redactObjects[T any](objs []T, redact func(T) T) []T {
+	if objs == nil {
+		return nil
+	}
+	result := make([]T, 0, len(objs))
+	for _, obj := range objs {
+		result = append(result, redact(obj))
+	}
+	return result
+}
+
+// redactPlugins keeps the names of plugins only, since their configs may
+// contain the values of Secrets.
+func redactPlugins(plugins apisixv1.Plugins) apisixv1.Plugins {
+	if plugins == nil {
+		return nil
+	}
+	redacted := make(apisixv1.Plugins, len(plugins))
+	for name := range plugins {
+		redacted[name] = _redactedValue
+	}
+	return redacted
+}
+
+// redactFields redacts the private keys of SSL objects and the configs of
+// plugins, only the paths of the changed fields are kept.
+func redactFields(kind string, fields []FieldDiff) []FieldDiff {
+	for i := range fields {
+		path := fields[i].Path
+		if (kind == _sslKind.kind && path == "key") || path == "plugins" || strings.HasPrefix(path, "plugins.") {
+			fields[i].Old = _redactedValue
+			fields[i].New = _redactedValue
+		}
+	}
+	return fields
+}
+
+// DiffFields compares the JSON representation of two objects and returns
+// the changed fields, sorted by path.
+func DiffFields(old, new interface{}) ([]FieldDiff, error) {
+	oldValue, err := toJSONValue(old)
+	if err != nil {
+		return nil, err
+	}
+	newValue, err := toJSONValue(new)
+	if err != nil {
+		return nil, err
+	}
+	var fields []FieldDiff
+	diffValues(_fieldPathRootKey, oldValue, newValue, &fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+	return fields, nil
+}
+
+func toJSONValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diffValues(path string, old, new interface{}, fields *[]FieldDiff) {
+	oldMap, oldIsMap := old.(map[string]interface{})
+	newMap, newIsMap := new.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		for key, oldField := range oldMap {
+			diffValues(joinFieldPath(path, key), oldField, newMap[key], fields)
+		}
+		for key, newField := range newMap {
+			if _, ok := oldMap[key]; !ok {
+				diffValues(joinFieldPath(path, key), nil, newField, fields)
+			}
+		}
+		return
+	}
+	oldSlice, oldIsSlice := old.([]interface{})
+	newSlice, newIsSlice := new.([]interface{})
+	if oldIsSlice && newIsSlice && len(oldSlice) == len(newSlice) {
+		for i := range oldSlice {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), oldSlice[i], newSlice[i], fields)
+		}
+		return
+	}
+	if !reflect.DeepEqual(old, new) {
+		*fields = append(*fields, FieldDiff{
+			Path: path,
+			Old:  old,
+			New:  new,
+		})
+	}
+}
+
+func joinFieldPath(path, key string) string {
+	if path == _fieldPathRootKey {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,156 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestDiffFields(t *testing.T) {
+	old := &apisixv1.Route{
+		Metadata: apisixv1.Metadata{ID: "1", Name: "r1"},
+		Uris:     []string{"/ip"},
+		Plugins: apisixv1.Plugins{
+			"cors":         map[string]interface{}{"max_age": 5},
+			"key-auth":     map[string]interface{}{},
+			"proxy-mirror": map[string]interface{}{"host": "http://mirror"},
+		},
+	}
+	new := &apisixv1.Route{
+		Metadata: apisixv1.Metadata{ID: "1", Name: "r1"},
+		Uris:     []string{"/headers"},
+		Plugins: apisixv1.Plugins{
+			"cors":     map[string]interface{}{"max_age": 10},
+			"key-auth": map[string]interface{}{},
+		},
+	}
+	fields, err := DiffFields(old, new)
+	assert.Nil(t, err)
+	assert.Equal(t, []FieldDiff{
+		{Path: "plugins.cors.max_age", Old: float64(5), New: float64(10)},
+		{Path: "plugins.proxy-mirror", Old: map[string]interface{}{"host": "http://mirror"}},
+		{Path: "uris[0]", Old: "/ip", New: "/headers"},
+	}, fields)
+
+	fields, err = DiffFields(old, old)
+	assert.Nil(t, err)
+	assert.Len(t, fields, 0)
+}
+
+func TestDiffManifestWithCache(t *testing.T) {
+	c, err := cache.NewMemDBCache()
+	assert.Nil(t, err)
+
+	unchanged := apisixv1.NewDefaultRoute()
+	unchanged.ID = "1"
+	unchanged.Name = "unchanged"
+	unchanged.Uri = "/ip"
+	changed := apisixv1.NewDefaultRoute()
+	changed.ID = "2"
+	changed.Name = "changed"
+	changed.Uri = "/get"
+	stale := apisixv1.NewDefaultRoute()
+	stale.ID = "3"
+	stale.Name = "stale"
+	stale.Labels = OwnerLabels(stale.Labels, "leader", "ApisixRoute", "default/stale")
+	unmanaged := &apisixv1.Route{Metadata: apisixv1.Metadata{ID: "4", Name: "unmanaged"}}
+	// the GRPCRoute kind is not translated, so it's unknown whether the route is desired
+	grpc := apisixv1.NewDefaultRoute()
+	grpc.ID = "6"
+	grpc.Name = "grpc"
+	grpc.Labels = OwnerLabels(grpc.Labels, "leader", "GRPCRoute", "default/grpc")
+	for _, r := range []*apisixv1.Route{unchanged, changed, stale, unmanaged, grpc} {
+		assert.Nil(t, c.InsertRoute(r))
+	}
+	ssl := &apisixv1.Ssl{ID: "1", Cert: "cert", Key: "key"}
+	assert.Nil(t, c.InsertSSL(ssl))
+
+	newChanged := changed.DeepCopy()
+	newChanged.Uri = "/post"
+	added := apisixv1.NewDefaultRoute()
+	added.ID = "5"
+	added.Name = "added"
+	newSSL := &apisixv1.Ssl{ID: "1", Cert: "cert", Key: "new key"}
+	m := &Manifest{
+		Routes: []*apisixv1.Route{unchanged.DeepCopy(), newChanged, added, added},
+		SSLs:   []*apisixv1.Ssl{newSSL},
+	}
+
+	diff, err := DiffManifestWithCache(c, m, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []*apisixv1.Route{added}, diff.Added.Routes)
+	assert.Equal(t, []*apisixv1.Route{newChanged}, diff.Updated.Routes)
+	assert.Len(t, diff.Deleted.Routes, 0)
+	assert.Equal(t, []ObjectDiff{
+		{Kind: "route", ID: "5", Name: "added", Action: DiffActionAdd},
+		{Kind: "route", ID: "2", Name: "changed", Action: DiffActionUpdate, Fields: []FieldDiff{
+			{Path: "uri", Old: "/get", New: "/post"},
+		}},
+		{Kind: "ssl", ID: "1", Action: DiffActionUpdate, Fields: []FieldDiff{
+			{Path: "key", Old: _redactedValue, New: _redactedValue},
+		}},
+	}, diff.Objects)
+	assert.Equal(t, _redactedValue, diff.Updated.SSLs[0].Key)
+	assert.Equal(t, "new key", newSSL.Key)
+
+	// managed objects of the pruned kinds which are not desired are deleted when pruning
+	diff, err = DiffManifestWithCache(c, m, []string{"ApisixRoute", "Ingress"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*apisixv1.Route{stale}, diff.Deleted.Routes)
+}
+
+func TestDiffManifestRedactsPlugins(t *testing.T) {
+	c, err := cache.NewMemDBCache()
+	assert.Nil(t, err)
+
+	old := apisixv1.NewDefaultRoute()
+	old.ID = "1"
+	old.Name = "auth"
+	old.Plugins = apisixv1.Plugins{
+		"basic-auth": map[string]interface{}{"password": "old"},
+	}
+	assert.Nil(t, c.InsertRoute(old))
+
+	route := old.DeepCopy()
+	route.Plugins = apisixv1.Plugins{
+		"basic-auth": map[string]interface{}{"password": "new"},
+	}
+	pc := &apisixv1.PluginConfig{
+		Metadata: apisixv1.Metadata{ID: "2", Name: "auth"},
+		Plugins: apisixv1.Plugins{
+			"basic-auth": map[string]interface{}{"password": "new"},
+		},
+	}
+	diff, err := DiffManifestWithCache(c, &Manifest{
+		Routes:        []*apisixv1.Route{route},
+		PluginConfigs: []*apisixv1.PluginConfig{pc},
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, apisixv1.Plugins{"basic-auth": _redactedValue}, diff.Updated.Routes[0].Plugins)
+	assert.Equal(t, apisixv1.Plugins{"basic-auth": _redactedValue}, diff.Added.PluginConfigs[0].Plugins)
+	assert.Equal(t, []ObjectDiff{
+		{Kind: "route", ID: "1", Name: "auth", Action: DiffActionUpdate, Fields: []FieldDiff{
+			{Path: "plugins.basic-auth.password", Old: _redactedValue, New: _redactedValue},
+		}},
+		{Kind: "plugin_config", ID: "2", Name: "auth", Action: DiffActionAdd},
+	}, diff.Objects)
+	// the desired objects are untouched
+	assert.Equal(t, "new", route.Plugins["basic-auth"].(map[string]interface{})["password"])
+}
@@ -0,0 +1,215 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	apisixcache "github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+)
+
+const (
+	// DriftTypeModified means the object was changed out-of-band.
+	DriftTypeModified = "modified"
+	// DriftTypeMissing means the object was deleted out-of-band.
+	DriftTypeMissing = "missing"
+	// DriftTypeOrphaned means the object is labelled as managed by
+	// apisix-ingress-controller and owned by a translated kind of resources,
+	// but not translated from any resource.
+	DriftTypeOrphaned = "orphaned"
+)
+
+// ObjectDrift describes how an APISIX object drifts from the desired state.
+type ObjectDrift struct {
+	Kind   string      `json:"kind"`
+	ID     string      `json:"id"`
+	Name   string      `json:"name,omitempty"`
+	Type   string      `json:"type"`
+	Fields []FieldDiff `json:"fields,omitempty"`
+}
+
+// DriftReport contains the APISIX objects which drift from the desired state.
+type DriftReport struct {
+	// Modified contains the desired objects whose live ones were changed.
+	Modified *Manifest
+	// Missing contains the desired objects whose live ones were deleted.
+	Missing *Manifest
+	// Orphaned contains the live objects which are not desired.
+	Orphaned *Manifest
+	Objects  []ObjectDrift
+}
+
+// ListManifest lists the objects from APISIX rather than the cache.
+func ListManifest(ctx context.Context, cluster apisix.Cluster) (*Manifest, error) {
+	var (
+		m   Manifest
+		err error
+	)
+	if m.Routes, err = cluster.Route().List(ctx); err != nil {
+		return nil, err
+	}
+	if m.Upstreams, err = cluster.Upstream().List(ctx); err != nil {
+		return nil, err
+	}
+	if m.StreamRoutes, err = cluster.StreamRoute().List(ctx); err != nil {
+		return nil, err
+	}
+	if m.SSLs, err = cluster.SSL().List(ctx); err != nil {
+		return nil, err
+	}
+	if m.PluginConfigs, err = cluster.PluginConfig().List(ctx); err != nil {
+		return nil, err
+	}
+	if m.GlobalRules, err = cluster.GlobalRule().List(ctx); err != nil {
+		return nil, err
+	}
+	if m.Services, err = cluster.Service().List(ctx); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// DetectDrifts compares the live objects to the desired ones. Since APISIX
+// fills default values into the objects, a live object is compared to its
+// cached one, i.e. the last applied one, if exists. Orphaned objects are
+// detected only for the objects translated from the resources of orphanKinds,
+// the desired manifest should contain all the watched resources of them.
+func DetectDrifts(c apisixcache.Cache, desired, live *Manifest, orphanKinds []string) (*DriftReport, error) {
+	report := &DriftReport{
+		Modified: &Manifest{},
+		Missing:  &Manifest{},
+		Orphaned: &Manifest{},
+	}
+	var err error
+	if report.Modified.Routes, report.Missing.Routes, report.Orphaned.Routes, err = detectDrifts(report, _routeKind,
+		desired.Routes, live.Routes, c.GetRoute, orphanKinds); err != nil {
+		return nil, err
+	}
+	if report.Modified.Upstreams, report.Missing.Upstreams, report.Orphaned.Upstreams, err = detectDrifts(report, _upstreamKind,
+		desired.Upstreams, live.Upstreams, c.GetUpstream, orphanKinds); err != nil {
+		return nil, err
+	}
+	if report.Modified.StreamRoutes, report.Missing.StreamRoutes, report.Orphaned.StreamRoutes, err = detectDrifts(report, _streamRouteKind,
+		desired.StreamRoutes, live.StreamRoutes, c.GetStreamRoute, orphanKinds); err != nil {
+		return nil, err
+	}
+	if report.Modified.SSLs, report.Missing.SSLs, report.Orphaned.SSLs, err = detectDrifts(report, _sslKind,
+		desired.SSLs, live.SSLs, c.GetSSL, orphanKinds); err != nil {
+		return nil, err
+	}
+	if report.Modified.PluginConfigs, report.Missing.PluginConfigs, report.Orphaned.PluginConfigs, err = detectDrifts(report, _pluginConfigKind,
+		desired.PluginConfigs, live.PluginConfigs, c.GetPluginConfig, orphanKinds); err != nil {
+		return nil, err
+	}
+	if report.Modified.GlobalRules, report.Missing.GlobalRules, report.Orphaned.GlobalRules, err = detectDrifts(report, _globalRuleKind,
+		desired.GlobalRules, live.GlobalRules, c.GetGlobalRule, orphanKinds); err != nil {
+		return nil, err
+	}
+	if report.Modified.Services, report.Missing.Services, report.Orphaned.Services, err = detectDrifts(report, _serviceKind,
+		desired.Services, live.Services, c.GetService, orphanKinds); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func detectDrifts[T any](report *DriftReport, k diffKind[T], desired, live []T, cached func(string) (T, error), orphanKinds []string) (modified, missing, orphaned []T, err error) {
+	desired = dedupObjects(k, desired)
+	liveMap := make(map[string]T, len(live))
+	for _, obj := range live {
+		liveMap[k.id(obj)] = obj
+	}
+	desiredMap := make(map[string]struct{}, len(desired))
+	for _, obj := range desired {
+		desiredMap[k.id(obj)] = struct{}{}
+		liveObj, ok := liveMap[k.id(obj)]
+		if !ok {
+			missing = append(missing, obj)
+			report.Objects = append(report.Objects, ObjectDrift{Kind: k.kind, ID: k.id(obj), Name: k.name(obj), Type: DriftTypeMissing})
+			continue
+		}
+		baseline, err := cached(k.id(obj))
+		if err == apisixcache.ErrNotFound {
+			baseline = obj
+		} else if err != nil {
+			return nil, nil, nil, err
+		}
+		fields, err := DiffFields(baseline, liveObj)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		fields = redactFields(k.kind, fields)
+		modified = append(modified, obj)
+		report.Objects = append(report.Objects, ObjectDrift{Kind: k.kind, ID: k.id(obj), Name: k.name(obj), Type: DriftTypeModified, Fields: fields})
+	}
+	if len(orphanKinds) == 0 {
+		return modified, missing, nil, nil
+	}
+	for _, obj := range live {
+		if _, ok := desiredMap[k.id(obj)]; ok || !isManagedByKinds(k.labels(obj), orphanKinds) {
+			continue
+		}
+		orphaned = append(orphaned, obj)
+		report.Objects = append(report.Objects, ObjectDrift{Kind: k.kind, ID: k.id(obj), Name: k.name(obj), Type: DriftTypeOrphaned})
+	}
+	return modified, missing, orphaned, nil
+}
+
+// Counts returns the number of drifted objects, indexed by the resource type
+// and the drift type.
+func (r *DriftReport) Counts() map[string]map[string]int {
+	counts := make(map[string]map[string]int)
+	for _, obj := range r.Objects {
+		if counts[obj.Kind] == nil {
+			counts[obj.Kind] = make(map[string]int)
+		}
+		counts[obj.Kind][obj.Type]++
+	}
+	return counts
+}
+
+// Corrections returns the changes which restore the modified and missing
+// objects of the autoCorrect resource types, and delete the orphaned objects
+// of the garbageCollect resource types.
+func (r *DriftReport) Corrections(autoCorrect, garbageCollect []string) (added, updated, deleted *Manifest) {
+	return filterManifest(r.Missing, autoCorrect), filterManifest(r.Modified, autoCorrect), filterManifest(r.Orphaned, garbageCollect)
+}
+
+func filterManifest(m *Manifest, resourceTypes []string) *Manifest {
+	result := &Manifest{}
+	for _, rt := range resourceTypes {
+		switch rt {
+		case _routeKind.kind:
+			result.Routes = m.Routes
+		case _upstreamKind.kind:
+			result.Upstreams = m.Upstreams
+		case _streamRouteKind.kind:
+			result.StreamRoutes = m.StreamRoutes
+		case _sslKind.kind:
+			result.SSLs = m.SSLs
+		case _pluginConfigKind.kind:
+			result.PluginConfigs = m.PluginConfigs
+		case _globalRuleKind.kind:
+			result.GlobalRules = m.GlobalRules
+		case _serviceKind.kind:
+			result.Services = m.Services
+		}
+	}
+	return result
+}
@@ -0,0 +1,106 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix/cache"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestDetectDrifts(t *testing.T) {
+	c, err := cache.NewMemDBCache()
+	assert.Nil(t, err)
+
+	unchanged := apisixv1.NewDefaultRoute()
+	unchanged.ID = "1"
+	unchanged.Name = "unchanged"
+	unchanged.Uri = "/ip"
+	modified := apisixv1.NewDefaultRoute()
+	modified.ID = "2"
+	modified.Name = "modified"
+	modified.Uri = "/get"
+	missing := apisixv1.NewDefaultRoute()
+	missing.ID = "3"
+	missing.Name = "missing"
+	for _, r := range []*apisixv1.Route{unchanged, modified, missing} {
+		assert.Nil(t, c.InsertRoute(r))
+	}
+	// APISIX fills the default values, which are cached as well
+	ups := apisixv1.NewDefaultUpstream()
+	ups.ID = "1"
+	ups.Name = "ups"
+	cachedUps := ups.DeepCopy()
+	cachedUps.Scheme = "http"
+	assert.Nil(t, c.InsertUpstream(cachedUps))
+
+	liveModified := modified.DeepCopy()
+	liveModified.Uri = "/post"
+	orphaned := apisixv1.NewDefaultRoute()
+	orphaned.ID = "4"
+	orphaned.Name = "orphaned"
+	orphaned.Labels = OwnerLabels(orphaned.Labels, "leader", "ApisixRoute", "default/orphaned")
+	unmanaged := &apisixv1.Route{Metadata: apisixv1.Metadata{ID: "5", Name: "unmanaged"}}
+	// the GRPCRoute kind is not translated, so it's unknown whether the route is desired
+	grpc := apisixv1.NewDefaultRoute()
+	grpc.ID = "6"
+	grpc.Name = "grpc"
+	grpc.Labels = OwnerLabels(grpc.Labels, "leader", "GRPCRoute", "default/grpc")
+	// the route is managed but its owner is unknown
+	unowned := apisixv1.NewDefaultRoute()
+	unowned.ID = "7"
+	unowned.Name = "unowned"
+	live := &Manifest{
+		Routes:    []*apisixv1.Route{unchanged.DeepCopy(), liveModified, orphaned, unmanaged, grpc, unowned},
+		Upstreams: []*apisixv1.Upstream{cachedUps.DeepCopy()},
+	}
+	desired := &Manifest{
+		Routes:    []*apisixv1.Route{unchanged, modified, missing},
+		Upstreams: []*apisixv1.Upstream{ups},
+	}
+
+	report, err := DetectDrifts(c, desired, live, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []*apisixv1.Route{modified}, report.Modified.Routes)
+	assert.Equal(t, []*apisixv1.Route{missing}, report.Missing.Routes)
+	assert.Len(t, report.Orphaned.Routes, 0)
+	assert.Len(t, report.Modified.Upstreams, 0)
+	assert.Equal(t, []ObjectDrift{
+		{Kind: "route", ID: "2", Name: "modified", Type: DriftTypeModified, Fields: []FieldDiff{
+			{Path: "uri", Old: "/get", New: "/post"},
+		}},
+		{Kind: "route", ID: "3", Name: "missing", Type: DriftTypeMissing},
+	}, report.Objects)
+
+	report, err = DetectDrifts(c, desired, live, []string{"ApisixRoute", "HTTPRoute"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*apisixv1.Route{orphaned}, report.Orphaned.Routes)
+	assert.Equal(t, map[string]map[string]int{
+		"route": {DriftTypeMissing: 1, DriftTypeModified: 1, DriftTypeOrphaned: 1},
+	}, report.Counts())
+
+	added, updated, deleted := report.Corrections([]string{"route"}, nil)
+	assert.Equal(t, []*apisixv1.Route{missing}, added.Routes)
+	assert.Equal(t, []*apisixv1.Route{modified}, updated.Routes)
+	assert.Len(t, deleted.Routes, 0)
+
+	added, updated, deleted = report.Corrections(nil, []string{"route"})
+	assert.Len(t, added.Routes, 0)
+	assert.Len(t, updated.Routes, 0)
+	assert.Equal(t, []*apisixv1.Route{orphaned}, deleted.Routes)
+}
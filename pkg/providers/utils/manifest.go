@@ -16,6 +16,7 @@ package utils
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	"github.com/hashicorp/go-multierror"
@@ -216,14 +217,52 @@ func DiffGlobalRules(olds, news []*apisixv1.GlobalRule) (added, updated, deleted
 	return
 }
 
+func DiffServices(olds, news []*apisixv1.Service) (added, updated, deleted []*apisixv1.Service) {
+	oldMap := make(map[string]*apisixv1.Service, len(olds))
+	newMap := make(map[string]*apisixv1.Service, len(news))
+	for _, svc := range olds {
+		oldMap[svc.ID] = svc
+	}
+	for _, svc := range news {
+		newMap[svc.ID] = svc
+	}
+
+	for _, svc := range news {
+		if os, ok := oldMap[svc.ID]; !ok {
+			added = append(added, svc)
+		} else if !reflect.DeepEqual(os, svc) {
+			updated = append(updated, svc)
+		}
+	}
+	for _, svc := range olds {
+		if _, ok := newMap[svc.ID]; !ok {
+			deleted = append(deleted, svc)
+		}
+	}
+	return
+}
+
 type Manifest struct {
-	Routes          []*apisixv1.Route
-	Upstreams       []*apisixv1.Upstream
-	StreamRoutes    []*apisixv1.StreamRoute
-	SSLs            []*apisixv1.Ssl
-	PluginConfigs   []*apisixv1.PluginConfig
-	PluginMetadatas []*apisixv1.PluginMetadata
-	GlobalRules     []*apisixv1.GlobalRule
+	Routes          []*apisixv1.Route          `json:"routes,omitempty"`
+	Upstreams       []*apisixv1.Upstream       `json:"upstreams,omitempty"`
+	StreamRoutes    []*apisixv1.StreamRoute    `json:"stream_routes,omitempty"`
+	SSLs            []*apisixv1.Ssl            `json:"ssls,omitempty"`
+	PluginConfigs   []*apisixv1.PluginConfig   `json:"plugin_configs,omitempty"`
+	PluginMetadatas []*apisixv1.PluginMetadata `json:"plugin_metadatas,omitempty"`
+	GlobalRules     []*apisixv1.GlobalRule     `json:"global_rules,omitempty"`
+	Services        []*apisixv1.Service        `json:"services,omitempty"`
+}
+
+// Append appends the objects in om to m.
+func (m *Manifest) Append(om *Manifest) {
+	m.Routes = append(m.Routes, om.Routes...)
+	m.Upstreams = append(m.Upstreams, om.Upstreams...)
+	m.StreamRoutes = append(m.StreamRoutes, om.StreamRoutes...)
+	m.SSLs = append(m.SSLs, om.SSLs...)
+	m.PluginConfigs = append(m.PluginConfigs, om.PluginConfigs...)
+	m.PluginMetadatas = append(m.PluginMetadatas, om.PluginMetadatas...)
+	m.GlobalRules = append(m.GlobalRules, om.GlobalRules...)
+	m.Services = append(m.Services, om.Services...)
 }
 
 func (m *Manifest) Diff(om *Manifest) (added, updated, deleted *Manifest) {
@@ -234,6 +273,7 @@ func (m *Manifest) Diff(om *Manifest) (added, updated, deleted *Manifest) {
 	apc, upc, dpc := DiffPluginConfigs(om.PluginConfigs, m.PluginConfigs)
 	apm, upm, dpm := DiffPluginMetadatas(om.PluginMetadatas, m.PluginMetadatas)
 	agr, ugr, dgr := DiffGlobalRules(om.GlobalRules, m.GlobalRules)
+	asvc, usvc, dsvc := DiffServices(om.Services, m.Services)
 
 	added = &Manifest{
 		Routes:          ar,
@@ -243,6 +283,7 @@ func (m *Manifest) Diff(om *Manifest) (added, updated, deleted *Manifest) {
 		PluginConfigs:   apc,
 		PluginMetadatas: apm,
 		GlobalRules:     agr,
+		Services:        asvc,
 	}
 	updated = &Manifest{
 		Routes:          ur,
@@ -252,6 +293,7 @@ func (m *Manifest) Diff(om *Manifest) (added, updated, deleted *Manifest) {
 		PluginConfigs:   upc,
 		PluginMetadatas: upm,
 		GlobalRules:     ugr,
+		Services:        usvc,
 	}
 	deleted = &Manifest{
 		Routes:          dr,
@@ -261,6 +303,7 @@ func (m *Manifest) Diff(om *Manifest) (added, updated, deleted *Manifest) {
 		PluginConfigs:   dpc,
 		PluginMetadatas: dpm,
 		GlobalRules:     dgr,
+		Services:        dsvc,
 	}
 	return
 }
@@ -286,6 +329,11 @@ func SyncManifests(ctx context.Context, apisix apisix.APISIX, clusterName string
 				merr = multierror.Append(merr, err)
 			}
 		}
+		for _, svc := range added.Services {
+			if _, err := apisix.Cluster(clusterName).Service().Create(ctx, svc); err != nil {
+				merr = multierror.Append(merr, err)
+			}
+		}
 		for _, r := range added.Routes {
 			if _, err := apisix.Cluster(clusterName).Route().Create(ctx, r); err != nil {
 				merr = multierror.Append(merr, err)
@@ -323,6 +371,11 @@ func SyncManifests(ctx context.Context, apisix apisix.APISIX, clusterName string
 				merr = multierror.Append(merr, err)
 			}
 		}
+		for _, svc := range updated.Services {
+			if _, err := apisix.Cluster(clusterName).Service().Update(ctx, svc); err != nil {
+				merr = multierror.Append(merr, err)
+			}
+		}
 		for _, r := range updated.Routes {
 			if _, err := apisix.Cluster(clusterName).Route().Update(ctx, r); err != nil {
 				merr = multierror.Append(merr, err)
@@ -364,6 +417,19 @@ func SyncManifests(ctx context.Context, apisix apisix.APISIX, clusterName string
 				merr = multierror.Append(merr, err)
 			}
 		}
+		for _, svc := range deleted.Services {
+			if err := apisix.Cluster(clusterName).Service().Delete(ctx, svc); err != nil {
+				// Service might be referenced by other routes.
+				if err != cache.ErrStillInUse {
+					merr = multierror.Append(merr, err)
+				} else {
+					log.Infow("service was referenced by other routes",
+						zap.String("service_id", svc.ID),
+						zap.String("service_name", svc.Name),
+					)
+				}
+			}
+		}
 		for _, u := range deleted.Upstreams {
 			if err := apisix.Cluster(clusterName).Upstream().Delete(ctx, u); err != nil {
 				// Upstream might be referenced by other routes.
@@ -455,3 +521,247 @@ func SyncManifests(ctx context.Context, apisix apisix.APISIX, clusterName string
 	}
 	return nil
 }
+
+// RollbackError is returned by ApplyManifests when the manifests failed to be
+// applied, the items which were already applied have been rolled back.
+type RollbackError struct {
+	// Err is the error which aborted the apply.
+	Err error
+	// RollbackErr is the error occurred during the rollback, nil means the
+	// cluster was restored to the state before the apply.
+	RollbackErr error
+}
+
+func (e *RollbackError) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("%s, rollback failed: %s", e.Err, e.RollbackErr)
+	}
+	return fmt.Sprintf("%s, changes were rolled back", e.Err)
+}
+
+func (e *RollbackError) Unwrap() error {
+	return e.Err
+}
+
+type manifestOp struct {
+	kind     string
+	id       string
+	apply    func() error
+	rollback func() error
+}
+
+// ApplyManifests is the transactional version of SyncManifests. Operations are
+// ordered dependency-first and applied one by one, once an operation failed, the
+// already applied operations are rolled back in reverse order, with the objects
+// in the cluster cache before the change. A *RollbackError is returned if the
+// manifests failed to be applied.
+func ApplyManifests(ctx context.Context, apisix apisix.APISIX, clusterName string, added, updated, deleted *Manifest) error {
+	ops := manifestOps(ctx, apisix.Cluster(clusterName), added, updated, deleted)
+	return applyOps(clusterName, ops)
+}
+
+func applyOps(clusterName string, ops []manifestOp) error {
+	for i, op := range ops {
+		err := op.apply()
+		if err == nil {
+			continue
+		}
+		log.Warnw("failed to apply manifests, rolling back",
+			zap.String("cluster", clusterName),
+			zap.String("kind", op.kind),
+			zap.String("id", op.id),
+			zap.Error(err),
+		)
+		var merr *multierror.Error
+		for j := i - 1; j >= 0; j-- {
+			if rbErr := ops[j].rollback(); rbErr != nil {
+				log.Errorw("failed to roll back",
+					zap.String("cluster", clusterName),
+					zap.String("kind", ops[j].kind),
+					zap.String("id", ops[j].id),
+					zap.Error(rbErr),
+				)
+				merr = multierror.Append(merr, rbErr)
+			}
+		}
+		return &RollbackError{
+			Err:         err,
+			RollbackErr: merr.ErrorOrNil(),
+		}
+	}
+	return nil
+}
+
+// manifestOps returns the operations to apply the manifests, the objects
+// to restore are snapshotted before any operation is applied.
+func manifestOps(ctx context.Context, cluster apisix.Cluster, added, updated, deleted *Manifest) []manifestOp {
+	var ops []manifestOp
+	c := cluster.Cache()
+	if added == nil {
+		added = &Manifest{}
+	}
+	if updated == nil {
+		updated = &Manifest{}
+	}
+	if deleted == nil {
+		deleted = &Manifest{}
+	}
+
+	// Upstreams, plugin configs and services are referenced by routes, so
+	// they are created before routes and deleted after routes. Services refer
+	// to upstreams as well, so they are deleted before upstreams.
+	for _, ssl := range added.SSLs {
+		ops = append(ops, createOp(ctx, "ssl", ssl.ID, cluster.SSL().Create, cluster.SSL().Delete, ssl))
+	}
+	for _, ssl := range updated.SSLs {
+		old, err := c.GetSSL(ssl.ID)
+		ops = append(ops, updateOp(ctx, "ssl", ssl.ID, cluster.SSL().Update, cluster.SSL().Delete, ssl, old, err == nil))
+	}
+	for _, u := range added.Upstreams {
+		ops = append(ops, createOp(ctx, "upstream", u.ID, cluster.Upstream().Create, cluster.Upstream().Delete, u))
+	}
+	for _, u := range updated.Upstreams {
+		old, err := c.GetUpstream(u.ID)
+		ops = append(ops, updateOp(ctx, "upstream", u.ID, cluster.Upstream().Update, cluster.Upstream().Delete, u, old, err == nil))
+	}
+	for _, pc := range added.PluginConfigs {
+		ops = append(ops, createOp(ctx, "plugin_config", pc.ID, cluster.PluginConfig().Create, cluster.PluginConfig().Delete, pc))
+	}
+	for _, pc := range updated.PluginConfigs {
+		old, err := c.GetPluginConfig(pc.ID)
+		ops = append(ops, updateOp(ctx, "plugin_config", pc.ID, cluster.PluginConfig().Update, cluster.PluginConfig().Delete, pc, old, err == nil))
+	}
+	for _, svc := range added.Services {
+		ops = append(ops, createOp(ctx, "service", svc.ID, cluster.Service().Create, cluster.Service().Delete, svc))
+	}
+	for _, svc := range updated.Services {
+		old, err := c.GetService(svc.ID)
+		ops = append(ops, updateOp(ctx, "service", svc.ID, cluster.Service().Update, cluster.Service().Delete, svc, old, err == nil))
+	}
+	for _, r := range added.Routes {
+		ops = append(ops, createOp(ctx, "route", r.ID, cluster.Route().Create, cluster.Route().Delete, r))
+	}
+	for _, r := range updated.Routes {
+		old, err := c.GetRoute(r.ID)
+		ops = append(ops, updateOp(ctx, "route", r.ID, cluster.Route().Update, cluster.Route().Delete, r, old, err == nil))
+	}
+	for _, sr := range added.StreamRoutes {
+		ops = append(ops, createOp(ctx, "stream_route", sr.ID, cluster.StreamRoute().Create, cluster.StreamRoute().Delete, sr))
+	}
+	for _, sr := range updated.StreamRoutes {
+		old, err := c.GetStreamRoute(sr.ID)
+		ops = append(ops, updateOp(ctx, "stream_route", sr.ID, cluster.StreamRoute().Update, cluster.StreamRoute().Delete, sr, old, err == nil))
+	}
+	// Plugin metadata are not cached, fetch them from APISIX instead.
+	for _, pm := range append(added.PluginMetadatas, updated.PluginMetadatas...) {
+		old, err := cluster.PluginMetadata().Get(ctx, pm.Name)
+		ops = append(ops, updateOp(ctx, "plugin_metadata", pm.Name, cluster.PluginMetadata().Update, cluster.PluginMetadata().Delete, pm, old, err == nil && old != nil))
+	}
+	for _, gr := range added.GlobalRules {
+		ops = append(ops, createOp(ctx, "global_rule", gr.ID, cluster.GlobalRule().Create, cluster.GlobalRule().Delete, gr))
+	}
+	for _, gr := range updated.GlobalRules {
+		old, err := c.GetGlobalRule(gr.ID)
+		ops = append(ops, updateOp(ctx, "global_rule", gr.ID, cluster.GlobalRule().Update, cluster.GlobalRule().Delete, gr, old, err == nil))
+	}
+
+	for _, ssl := range deleted.SSLs {
+		old, err := c.GetSSL(ssl.ID)
+		ops = append(ops, deleteOp(ctx, "ssl", ssl.ID, cluster.SSL().Create, cluster.SSL().Delete, ssl, old, err == nil))
+	}
+	for _, r := range deleted.Routes {
+		old, err := c.GetRoute(r.ID)
+		ops = append(ops, deleteOp(ctx, "route", r.ID, cluster.Route().Create, cluster.Route().Delete, r, old, err == nil))
+	}
+	for _, sr := range deleted.StreamRoutes {
+		old, err := c.GetStreamRoute(sr.ID)
+		ops = append(ops, deleteOp(ctx, "stream_route", sr.ID, cluster.StreamRoute().Create, cluster.StreamRoute().Delete, sr, old, err == nil))
+	}
+	for _, gr := range deleted.GlobalRules {
+		old, err := c.GetGlobalRule(gr.ID)
+		ops = append(ops, deleteOp(ctx, "global_rule", gr.ID, cluster.GlobalRule().Create, cluster.GlobalRule().Delete, gr, old, err == nil))
+	}
+	for _, pm := range deleted.PluginMetadatas {
+		old, err := cluster.PluginMetadata().Get(ctx, pm.Name)
+		ops = append(ops, deleteOp(ctx, "plugin_metadata", pm.Name, cluster.PluginMetadata().Update, cluster.PluginMetadata().Delete, pm, old, err == nil && old != nil))
+	}
+	for _, svc := range deleted.Services {
+		old, err := c.GetService(svc.ID)
+		ops = append(ops, deleteOp(ctx, "service", svc.ID, cluster.Service().Create, cluster.Service().Delete, svc, old, err == nil))
+	}
+	for _, u := range deleted.Upstreams {
+		old, err := c.GetUpstream(u.ID)
+		ops = append(ops, deleteOp(ctx, "upstream", u.ID, cluster.Upstream().Create, cluster.Upstream().Delete, u, old, err == nil))
+	}
+	for _, pc := range deleted.PluginConfigs {
+		old, err := c.GetPluginConfig(pc.ID)
+		ops = append(ops, deleteOp(ctx, "plugin_config", pc.ID, cluster.PluginConfig().Create, cluster.PluginConfig().Delete, pc, old, err == nil))
+	}
+	return ops
+}
+
+func createOp[T any](ctx context.Context, kind, id string, create func(context.Context, T) (T, error), del func(context.Context, T) error, obj T) manifestOp {
+	return manifestOp{
+		kind: kind,
+		id:   id,
+		apply: func() error {
+			_, err := create(ctx, obj)
+			return err
+		},
+		rollback: func() error {
+			return del(ctx, obj)
+		},
+	}
+}
+
+// updateOp restores the old object on rollback, the object is deleted
+// instead if it didn't exist before the update.
+func updateOp[T any](ctx context.Context, kind, id string, update func(context.Context, T) (T, error), del func(context.Context, T) error, obj, old T, found bool) manifestOp {
+	return manifestOp{
+		kind: kind,
+		id:   id,
+		apply: func() error {
+			_, err := update(ctx, obj)
+			return err
+		},
+		rollback: func() error {
+			if !found {
+				return del(ctx, obj)
+			}
+			_, err := update(ctx, old)
+			return err
+		},
+	}
+}
+
+// deleteOp re-creates the old object on rollback. Objects which are still
+// referenced by others are kept, the same as SyncManifests.
+func deleteOp[T any](ctx context.Context, kind, id string, create func(context.Context, T) (T, error), del func(context.Context, T) error, obj, old T, found bool) manifestOp {
+	skipped := false
+	if !found {
+		old = obj
+	}
+	return manifestOp{
+		kind: kind,
+		id:   id,
+		apply: func() error {
+			err := del(ctx, obj)
+			if err == cache.ErrStillInUse {
+				log.Infow("object was referenced by others, skip deleting it",
+					zap.String("kind", kind),
+					zap.String("id", id),
+				)
+				skipped = true
+				return nil
+			}
+			return err
+		},
+		rollback: func() error {
+			if skipped {
+				return nil
+			}
+			_, err := create(ctx, old)
+			return err
+		},
+	}
+}
@@ -15,6 +15,8 @@
 package utils
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -248,6 +250,65 @@ func TestDiffPluginConfigs(t *testing.T) {
 	assert.Equal(t, "2", deleted[0].ID)
 }
 
+func TestDiffServices(t *testing.T) {
+	news := []*apisixv1.Service{
+		{
+			Metadata: apisixv1.Metadata{
+				ID: "1",
+			},
+			UpstreamId: "1",
+		},
+		{
+			Metadata: apisixv1.Metadata{
+				ID: "3",
+			},
+			UpstreamId: "3",
+			Plugins: map[string]interface{}{
+				"key-1": 123456,
+			},
+		},
+	}
+	added, updated, deleted := DiffServices(nil, news)
+	assert.Nil(t, updated)
+	assert.Nil(t, deleted)
+	assert.Len(t, added, 2)
+	assert.Equal(t, "1", added[0].ID)
+	assert.Equal(t, "3", added[1].ID)
+
+	olds := []*apisixv1.Service{
+		{
+			Metadata: apisixv1.Metadata{
+				ID: "2",
+			},
+			UpstreamId: "2",
+		},
+		{
+			Metadata: apisixv1.Metadata{
+				ID: "3",
+			},
+			UpstreamId: "3",
+			Plugins: map[string]interface{}{
+				"key-1": 123456789,
+			},
+		},
+	}
+	added, updated, deleted = DiffServices(olds, nil)
+	assert.Nil(t, updated)
+	assert.Nil(t, added)
+	assert.Len(t, deleted, 2)
+	assert.Equal(t, "2", deleted[0].ID)
+	assert.Equal(t, "3", deleted[1].ID)
+
+	added, updated, deleted = DiffServices(olds, news)
+	assert.Len(t, added, 1)
+	assert.Equal(t, "1", added[0].ID)
+	assert.Len(t, updated, 1)
+	assert.Equal(t, "3", updated[0].ID)
+	assert.Equal(t, 123456, updated[0].Plugins["key-1"])
+	assert.Len(t, deleted, 1)
+	assert.Equal(t, "2", deleted[0].ID)
+}
+
 func TestManifestDiff(t *testing.T) {
 	retries := 2
 	m := &Manifest{
@@ -324,3 +385,86 @@ func TestManifestDiff(t *testing.T) {
 	assert.Nil(t, updated.Upstreams)
 	assert.Nil(t, updated.PluginConfigs)
 }
+
+func TestApplyOps(t *testing.T) {
+	var calls []string
+	op := func(id string, applyErr, rollbackErr error) manifestOp {
+		return manifestOp{
+			kind: "route",
+			id:   id,
+			apply: func() error {
+				calls = append(calls, "apply "+id)
+				return applyErr
+			},
+			rollback: func() error {
+				calls = append(calls, "rollback "+id)
+				return rollbackErr
+			},
+		}
+	}
+
+	err := applyOps("default", []manifestOp{op("1", nil, nil), op("2", nil, nil)})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"apply 1", "apply 2"}, calls)
+
+	calls = nil
+	err = applyOps("default", []manifestOp{op("1", nil, nil), op("2", nil, nil), op("3", errors.New("timeout"), nil), op("4", nil, nil)})
+	assert.Equal(t, []string{"apply 1", "apply 2", "apply 3", "rollback 2", "rollback 1"}, calls)
+	var rollbackErr *RollbackError
+	assert.True(t, errors.As(err, &rollbackErr))
+	assert.Nil(t, rollbackErr.RollbackErr)
+	assert.Equal(t, "timeout, changes were rolled back", err.Error())
+	assert.Equal(t, ResourceSyncRolledBack, SyncFailedReason(err))
+
+	calls = nil
+	err = applyOps("default", []manifestOp{op("1", nil, errors.New("connection refused")), op("2", errors.New("timeout"), nil)})
+	assert.Equal(t, []string{"apply 1", "apply 2", "rollback 1"}, calls)
+	assert.True(t, errors.As(err, &rollbackErr))
+	assert.NotNil(t, rollbackErr.RollbackErr)
+	assert.Equal(t, ResourceSyncRollbackFailed, SyncFailedReason(err))
+}
+
+func TestUpdateOpRollback(t *testing.T) {
+	stored := map[string]*apisixv1.Route{}
+	update := func(_ context.Context, r *apisixv1.Route) (*apisixv1.Route, error) {
+		stored[r.ID] = r
+		return r, nil
+	}
+	del := func(_ context.Context, r *apisixv1.Route) error {
+		delete(stored, r.ID)
+		return nil
+	}
+	old := &apisixv1.Route{Metadata: apisixv1.Metadata{ID: "1"}, Methods: []string{"GET"}}
+	obj := &apisixv1.Route{Metadata: apisixv1.Metadata{ID: "1"}, Methods: []string{"POST"}}
+	stored["1"] = old
+
+	op := updateOp(context.Background(), "route", "1", update, del, obj, old, true)
+	assert.Nil(t, op.apply())
+	assert.Equal(t, obj, stored["1"])
+	assert.Nil(t, op.rollback())
+	assert.Equal(t, old, stored["1"])
+
+	// the route didn't exist before the update
+	op = updateOp(context.Background(), "route", "2", update, del, &apisixv1.Route{Metadata: apisixv1.Metadata{ID: "2"}}, nil, false)
+	assert.Nil(t, op.apply())
+	assert.Len(t, stored, 2)
+	assert.Nil(t, op.rollback())
+	assert.Len(t, stored, 1)
+}
+
+func TestSyncFailedReason(t *testing.T) {
+	assert.Equal(t, ResourceSyncAborted, SyncFailedReason(errors.New("timeout")))
+	err := &ClusterSyncError{
+		Errors: map[string]error{
+			"zone-a": &RollbackError{Err: errors.New("timeout")},
+			"zone-b": errors.New("timeout"),
+		},
+	}
+	assert.Equal(t, ResourceSyncAborted, SyncFailedReason(err))
+	err.Errors["zone-b"] = &RollbackError{Err: errors.New("timeout")}
+	assert.Equal(t, ResourceSyncRolledBack, SyncFailedReason(err))
+	err.Errors["zone-b"] = &RollbackError{Err: errors.New("timeout"), RollbackErr: errors.New("connection refused")}
+	assert.Equal(t, ResourceSyncRollbackFailed, SyncFailedReason(err))
+	err.Errors["zone-a"] = errors.New("timeout")
+	assert.Equal(t, ResourceSyncRollbackFailed, SyncFailedReason(err))
+}
@@ -0,0 +1,306 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/apache/apisix-ingress-controller/pkg/apisix"
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+const (
+	// LabelManagedBy tells the object is managed by apisix-ingress-controller.
+	LabelManagedBy = _managedByLabel
+	// LabelOwnerKind is the kind of the resource which the object is
+	// translated from.
+	LabelOwnerKind = "owner-kind"
+	// LabelOwnerNamespace is the namespace of the resource which the object
+	// is translated from.
+	LabelOwnerNamespace = "owner-namespace"
+	// LabelOwnerName is the name of the resource which the object is
+	// translated from.
+	LabelOwnerName = "owner-name"
+	// LabelControllerInstance is the election id of the controller instance
+	// which manages the object.
+	LabelControllerInstance = "controller-instance"
+
+	// APISIX limits the length of label values.
+	_maxLabelValueLength = 64
+)
+
+// OwnerLabels returns labels with the ownership labels set, which tell the
+// resource the object is translated from and the controller instance that
+// manages the object, key is the key (namespace/name) of the resource.
+// labels is modified in place unless it's nil.
+func OwnerLabels(labels map[string]string, instance, kind, key string) map[string]string {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		name = key
+	}
+	labels[LabelManagedBy] = _managedByValue
+	labels[LabelControllerInstance] = TruncateString(instance, _maxLabelValueLength)
+	labels[LabelOwnerKind] = kind
+	if ns != "" {
+		labels[LabelOwnerNamespace] = ns
+	}
+	labels[LabelOwnerName] = TruncateString(name, _maxLabelValueLength)
+	return labels
+}
+
+// SetOwnerLabels sets the ownership labels to all the objects in m.
+func (m *Manifest) SetOwnerLabels(instance, kind, key string) {
+	for _, r := range m.Routes {
+		r.Labels = OwnerLabels(r.Labels, instance, kind, key)
+	}
+	for _, u := range m.Upstreams {
+		u.Labels = OwnerLabels(u.Labels, instance, kind, key)
+	}
+	for _, sr := range m.StreamRoutes {
+		sr.Labels = OwnerLabels(sr.Labels, instance, kind, key)
+	}
+	for _, ssl := range m.SSLs {
+		ssl.Labels = OwnerLabels(ssl.Labels, instance, kind, key)
+	}
+	for _, pc := range m.PluginConfigs {
+		pc.Labels = OwnerLabels(pc.Labels, instance, kind, key)
+	}
+	for _, svc := range m.Services {
+		svc.Labels = OwnerLabels(svc.Labels, instance, kind, key)
+	}
+}
+
+// OwnerRef is the resource which an object is translated from, it's parsed
+// from the ownership labels.
+type OwnerRef struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// Key returns the key of the owner in the informer cache.
+func (ref OwnerRef) Key() string {
+	if ref.Namespace == "" {
+		return ref.Name
+	}
+	return ref.Namespace + "/" + ref.Name
+}
+
+// ownerOf returns the owner of the object which is managed by the instance,
+// ok is false if the object isn't owned by the instance, or the name of the
+// owner might be truncated, which is ambiguous.
+func ownerOf(labels map[string]string, instance string) (ref OwnerRef, ok bool) {
+	if labels[LabelManagedBy] != _managedByValue ||
+		labels[LabelControllerInstance] != TruncateString(instance, _maxLabelValueLength) {
+		return ref, false
+	}
+	ref = OwnerRef{
+		Kind:      labels[LabelOwnerKind],
+		Namespace: labels[LabelOwnerNamespace],
+		Name:      labels[LabelOwnerName],
+	}
+	if ref.Kind == "" || ref.Name == "" || len(ref.Name) >= _maxLabelValueLength {
+		return ref, false
+	}
+	return ref, true
+}
+
+// IsOwnedBy tells whether the object is translated from the resource of kind
+// and key, and managed by the instance.
+func IsOwnedBy(labels map[string]string, instance, kind, key string) bool {
+	ref, ok := ownerOf(labels, instance)
+	return ok && ref.Kind == kind && ref.Key() == key
+}
+
+// OwnerExistsFunc tells whether the owner exists, known is false if the
+// existence of the owner can't be decided, e.g. the kind of resources isn't
+// watched.
+type OwnerExistsFunc func(ref OwnerRef) (exists, known bool)
+
+// FindGarbage finds the objects in live which are owned by the instance but
+// whose owners no longer exist. Upstreams might be shared by the routes of
+// several resources, so upstreams which are still referenced by the objects
+// that aren't garbage are kept.
+func FindGarbage(live *Manifest, instance string, ownerExists OwnerExistsFunc) *Manifest {
+	isGarbage := func(labels map[string]string) bool {
+		return isGarbage(labels, instance, ownerExists)
+	}
+	garbage := &Manifest{}
+	referenced := make(map[string]struct{})
+	for _, r := range live.Routes {
+		if isGarbage(r.Labels) {
+			garbage.Routes = append(garbage.Routes, r)
+			continue
+		}
+		referenced[r.UpstreamId] = struct{}{}
+		for _, id := range r.Plugins.TrafficSplitUpstreamIDs() {
+			referenced[id] = struct{}{}
+		}
+	}
+	for _, sr := range live.StreamRoutes {
+		if isGarbage(sr.Labels) {
+			garbage.StreamRoutes = append(garbage.StreamRoutes, sr)
+			continue
+		}
+		referenced[sr.UpstreamId] = struct{}{}
+	}
+	for _, svc := range live.Services {
+		if isGarbage(svc.Labels) {
+			garbage.Services = append(garbage.Services, svc)
+			continue
+		}
+		referenced[svc.UpstreamId] = struct{}{}
+		for _, id := range svc.Plugins.TrafficSplitUpstreamIDs() {
+			referenced[id] = struct{}{}
+		}
+	}
+	for _, u := range live.Upstreams {
+		if _, ok := referenced[u.ID]; ok {
+			continue
+		}
+		if isGarbage(u.Labels) {
+			garbage.Upstreams = append(garbage.Upstreams, u)
+		}
+	}
+	for _, ssl := range live.SSLs {
+		if isGarbage(ssl.Labels) {
+			garbage.SSLs = append(garbage.SSLs, ssl)
+		}
+	}
+	for _, pc := range live.PluginConfigs {
+		if isGarbage(pc.Labels) {
+			garbage.PluginConfigs = append(garbage.PluginConfigs, pc)
+		}
+	}
+	return garbage
+}
+
+// isGarbage tells whether the object is owned by the instance but its owner
+// no longer exists.
+func isGarbage(labels map[string]string, instance string, ownerExists OwnerExistsFunc) bool {
+	ref, ok := ownerOf(labels, instance)
+	if !ok {
+		return false
+	}
+	exists, known := ownerExists(ref)
+	return known && !exists
+}
+
+// findGarbageObjects finds the objects which are owned by the instance but
+// whose owners no longer exist.
+func findGarbageObjects[T any](objs []T, labelsOf func(T) map[string]string, instance string, ownerExists OwnerExistsFunc) []T {
+	var garbage []T
+	for _, obj := range objs {
+		if isGarbage(labelsOf(obj), instance, ownerExists) {
+			garbage = append(garbage, obj)
+		}
+	}
+	return garbage
+}
+
+// FindGarbageConsumers finds the consumers which are owned by the instance
+// but whose owners no longer exist.
+func FindGarbageConsumers(consumers []*apisixv1.Consumer, instance string, ownerExists OwnerExistsFunc) []*apisixv1.Consumer {
+	return findGarbageObjects(consumers, func(c *apisixv1.Consumer) map[string]string { return c.Labels }, instance, ownerExists)
+}
+
+// FindGarbageConsumerGroups finds the consumer groups which are owned by the
+// instance but whose owners no longer exist.
+func FindGarbageConsumerGroups(groups []*apisixv1.ConsumerGroup, instance string, ownerExists OwnerExistsFunc) []*apisixv1.ConsumerGroup {
+	return findGarbageObjects(groups, func(cg *apisixv1.ConsumerGroup) map[string]string { return cg.Labels }, instance, ownerExists)
+}
+
+// FindGarbageProtos finds the protos which are owned by the instance but
+// whose owners no longer exist.
+func FindGarbageProtos(protos []*apisixv1.Proto, instance string, ownerExists OwnerExistsFunc) []*apisixv1.Proto {
+	return findGarbageObjects(protos, func(p *apisixv1.Proto) map[string]string { return p.Labels }, instance, ownerExists)
+}
+
+// Garbage is the objects which are owned by a controller instance but whose
+// owners no longer exist.
+type Garbage struct {
+	*Manifest
+	Consumers      []*apisixv1.Consumer
+	ConsumerGroups []*apisixv1.ConsumerGroup
+	Protos         []*apisixv1.Proto
+}
+
+// Len returns the number of the garbage objects.
+func (g *Garbage) Len() int {
+	n := len(g.Consumers) + len(g.ConsumerGroups) + len(g.Protos)
+	if g.Manifest != nil {
+		n += len(g.Routes) + len(g.Upstreams) + len(g.StreamRoutes) + len(g.SSLs) +
+			len(g.PluginConfigs) + len(g.Services)
+	}
+	return n
+}
+
+// CollectGarbage deletes the objects in the cluster which are owned by the
+// instance but whose owners no longer exist, the deleted objects are returned.
+func CollectGarbage(ctx context.Context, apisix apisix.APISIX, clusterName, instance string, ownerExists OwnerExistsFunc) (*Garbage, error) {
+	cluster := apisix.Cluster(clusterName)
+	live, err := ListManifest(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	consumers, err := cluster.Consumer().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := cluster.ConsumerGroup().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	protos, err := cluster.Proto().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	garbage := &Garbage{
+		Manifest:       FindGarbage(live, instance, ownerExists),
+		Consumers:      FindGarbageConsumers(consumers, instance, ownerExists),
+		ConsumerGroups: FindGarbageConsumerGroups(groups, instance, ownerExists),
+		Protos:         FindGarbageProtos(protos, instance, ownerExists),
+	}
+
+	// Routes refer to protos and consumers refer to consumer groups, so
+	// protos and consumer groups are deleted at last.
+	var merr *multierror.Error
+	if err := SyncManifests(ctx, apisix, clusterName, nil, nil, garbage.Manifest); err != nil {
+		merr = multierror.Append(merr, err)
+	}
+	for _, consumer := range garbage.Consumers {
+		if err := cluster.Consumer().Delete(ctx, consumer); err != nil {
+			merr = multierror.Append(merr, err)
+		}
+	}
+	for _, cg := range garbage.ConsumerGroups {
+		if err := cluster.ConsumerGroup().Delete(ctx, cg); err != nil {
+			merr = multierror.Append(merr, err)
+		}
+	}
+	for _, proto := range garbage.Protos {
+		if err := cluster.Proto().Delete(ctx, proto); err != nil {
+			merr = multierror.Append(merr, err)
+		}
+	}
+	return garbage, merr.ErrorOrNil()
+}
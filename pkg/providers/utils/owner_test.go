@@ -0,0 +1,155 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apisixv1 "github.com/apache/apisix-ingress-controller/pkg/types/apisix/v1"
+)
+
+func TestSetOwnerLabels(t *testing.T) {
+	route := apisixv1.NewDefaultRoute()
+	route.Labels["meta_weight"] = "100"
+	ssl := &apisixv1.Ssl{ID: "1"}
+	m := &Manifest{
+		Routes: []*apisixv1.Route{route},
+		SSLs:   []*apisixv1.Ssl{ssl},
+	}
+	m.SetOwnerLabels("ingress-apisix-leader", "ApisixRoute", "default/httpbin")
+	expected := map[string]string{
+		LabelManagedBy:          "apisix-ingress-controller",
+		LabelControllerInstance: "ingress-apisix-leader",
+		LabelOwnerKind:          "ApisixRoute",
+		LabelOwnerNamespace:     "default",
+		LabelOwnerName:          "httpbin",
+	}
+	assert.Equal(t, expected, ssl.Labels)
+	expected["meta_weight"] = "100"
+	assert.Equal(t, expected, route.Labels)
+
+	labels := OwnerLabels(nil, "ingress-apisix-leader", "ApisixRoute", strings.Repeat("a", 100))
+	assert.Len(t, labels[LabelOwnerName], 64)
+	assert.NotContains(t, labels, LabelOwnerNamespace)
+}
+
+func TestIsOwnedBy(t *testing.T) {
+	labels := OwnerLabels(nil, "ingress-apisix-leader", "HTTPRoute", "default/httpbin")
+	assert.True(t, IsOwnedBy(labels, "ingress-apisix-leader", "HTTPRoute", "default/httpbin"))
+	assert.False(t, IsOwnedBy(labels, "ingress-apisix-leader", "GRPCRoute", "default/httpbin"))
+	assert.False(t, IsOwnedBy(labels, "ingress-apisix-leader", "HTTPRoute", "test/httpbin"))
+	assert.False(t, IsOwnedBy(labels, "another-leader", "HTTPRoute", "default/httpbin"))
+	assert.False(t, IsOwnedBy(nil, "ingress-apisix-leader", "HTTPRoute", "default/httpbin"))
+}
+
+func TestFindGarbage(t *testing.T) {
+	newRoute := func(id, instance, name string) *apisixv1.Route {
+		r := apisixv1.NewDefaultRoute()
+		r.ID = id
+		r.Labels = OwnerLabels(r.Labels, instance, "ApisixRoute", "default/"+name)
+		return r
+	}
+	deleted := newRoute("1", "leader", "deleted")
+	exists := newRoute("2", "leader", "exists")
+	otherInstance := newRoute("3", "another-leader", "deleted")
+	unwatched := newRoute("4", "leader", "unwatched")
+	truncated := newRoute("5", "leader", strings.Repeat("a", 100))
+	unlabelled := apisixv1.NewDefaultRoute()
+	unlabelled.ID = "6"
+	consumer := &apisixv1.Consumer{
+		Username: "default_jack",
+		Labels:   OwnerLabels(nil, "leader", "ApisixConsumer", "default/jack"),
+	}
+
+	ownerExists := func(ref OwnerRef) (bool, bool) {
+		switch ref.Key() {
+		case "default/exists":
+			return true, true
+		case "default/unwatched":
+			return false, false
+		}
+		return false, true
+	}
+	live := &Manifest{
+		Routes: []*apisixv1.Route{deleted, exists, otherInstance, unwatched, truncated, unlabelled},
+	}
+	garbage := FindGarbage(live, "leader", ownerExists)
+	assert.Equal(t, []*apisixv1.Route{deleted}, garbage.Routes)
+	assert.Equal(t, []*apisixv1.Consumer{consumer}, FindGarbageConsumers([]*apisixv1.Consumer{consumer}, "leader", ownerExists))
+	assert.Len(t, FindGarbageConsumers([]*apisixv1.Consumer{consumer}, "another-leader", ownerExists), 0)
+
+	group := &apisixv1.ConsumerGroup{
+		ID:     "1",
+		Labels: OwnerLabels(nil, "leader", "ApisixConsumerGroup", "default/deleted"),
+	}
+	assert.Equal(t, []*apisixv1.ConsumerGroup{group}, FindGarbageConsumerGroups([]*apisixv1.ConsumerGroup{group}, "leader", ownerExists))
+	proto := &apisixv1.Proto{
+		ID:     "1",
+		Labels: OwnerLabels(nil, "leader", "ApisixProto", "default/exists"),
+	}
+	assert.Len(t, FindGarbageProtos([]*apisixv1.Proto{proto}, "leader", ownerExists), 0)
+}
+
+func TestFindGarbageSharedUpstreams(t *testing.T) {
+	newUpstream := func(id string) *apisixv1.Upstream {
+		u := apisixv1.NewDefaultUpstream()
+		u.ID = id
+		u.Labels = OwnerLabels(u.Labels, "leader", "Ingress", "default/deleted")
+		return u
+	}
+	deleted := apisixv1.NewDefaultRoute()
+	deleted.ID = "1"
+	deleted.UpstreamId = "1"
+	deleted.Labels = OwnerLabels(deleted.Labels, "leader", "Ingress", "default/deleted")
+	exists := apisixv1.NewDefaultRoute()
+	exists.ID = "2"
+	exists.UpstreamId = "2"
+	exists.Plugins = apisixv1.Plugins{
+		"traffic-split": map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{
+					"weighted_upstreams": []interface{}{
+						map[string]interface{}{"upstream_id": "3", "weight": 10},
+						map[string]interface{}{"weight": 10},
+					},
+				},
+			},
+		},
+	}
+	exists.Labels = OwnerLabels(exists.Labels, "leader", "Ingress", "default/exists")
+	sr := apisixv1.NewDefaultStreamRoute()
+	sr.ID = "3"
+	sr.UpstreamId = "4"
+	sr.Labels = OwnerLabels(sr.Labels, "leader", "ApisixRoute", "default/exists")
+
+	ownerExists := func(ref OwnerRef) (bool, bool) {
+		return ref.Key() == "default/exists", true
+	}
+	// The upstreams 2, 3 and 4 are labelled by the deleted Ingress, which
+	// synced them at last, but they are still used by others.
+	live := &Manifest{
+		Routes:       []*apisixv1.Route{deleted, exists},
+		StreamRoutes: []*apisixv1.StreamRoute{sr},
+		Upstreams:    []*apisixv1.Upstream{newUpstream("1"), newUpstream("2"), newUpstream("3"), newUpstream("4")},
+	}
+	garbage := FindGarbage(live, "leader", ownerExists)
+	assert.Equal(t, []*apisixv1.Route{deleted}, garbage.Routes)
+	assert.Equal(t, []*apisixv1.Upstream{live.Upstreams[0]}, garbage.Upstreams)
+	assert.Len(t, garbage.StreamRoutes, 0)
+}
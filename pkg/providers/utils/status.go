@@ -16,6 +16,7 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -36,8 +37,19 @@ const (
 	MessageResourceSynced = "%s synced successfully"
 	// ResourceSyncAborted is used when a resource synced failed
 	ResourceSyncAborted = "ResourceSyncAborted"
+	// ResourceSyncRolledBack is used when a resource synced failed and the
+	// applied changes were rolled back
+	ResourceSyncRolledBack = "ResourceSyncRolledBack"
+	// ResourceSyncRollbackFailed is used when a resource synced failed and
+	// the applied changes failed to be rolled back, APISIX may be left with
+	// part of the changes.
+	ResourceSyncRollbackFailed = "ResourceSyncRollbackFailed"
 	// MessageResourceFailed is used to report error
 	MessageResourceFailed = "%s synced failed, with error: %s"
+	// SecretChanged is used when a Secret referred by the resource is changed
+	SecretChanged = "SecretChanged"
+	// MessageSecretChanged is used to specify the changed Secret
+	MessageSecretChanged = "referred Secret %s changed, re-syncing"
 )
 
 // RecorderEvent recorder events for resources
@@ -51,6 +63,33 @@ func RecorderEvent(recorder record.EventRecorder, object runtime.Object, eventty
 	}
 }
 
+// SyncFailedReason returns the reason of the failed sync, it tells whether
+// the applied changes were rolled back in all failed clusters, or failed to be
+// rolled back in any of them.
+func SyncFailedReason(err error) string {
+	var syncErr *ClusterSyncError
+	if errors.As(err, &syncErr) && len(syncErr.Errors) > 0 {
+		reason := ResourceSyncRolledBack
+		for _, clusterErr := range syncErr.Errors {
+			switch SyncFailedReason(clusterErr) {
+			case ResourceSyncRollbackFailed:
+				return ResourceSyncRollbackFailed
+			case ResourceSyncAborted:
+				reason = ResourceSyncAborted
+			}
+		}
+		return reason
+	}
+	var rollbackErr *RollbackError
+	if errors.As(err, &rollbackErr) {
+		if rollbackErr.RollbackErr != nil {
+			return ResourceSyncRollbackFailed
+		}
+		return ResourceSyncRolledBack
+	}
+	return ResourceSyncAborted
+}
+
 // RecorderEventS recorder events for resources
 func RecorderEventS(recorder record.EventRecorder, object runtime.Object, eventtype, reason string, msg string) {
 	recorder.Event(object, eventtype, reason, msg)
@@ -45,3 +45,13 @@ func Equal(a, b []string) bool {
 	}
 	return len(Difference(a, b)) == 0 && len(Difference(b, a)) == 0
 }
+
+// Contains reports whether elem is in a
+func Contains(a []string, elem string) bool {
+	for _, e := range a {
+		if e == elem {
+			return true
+		}
+	}
+	return false
+}
@@ -29,9 +29,17 @@ type TrafficSplitConfig struct {
 // TrafficSplitConfigRule is the rule config in traffic-split plugin config.
 // +k8s:deepcopy-gen=true
 type TrafficSplitConfigRule struct {
+	Match             []TrafficSplitConfigRuleMatch            `json:"match,omitempty"`
 	WeightedUpstreams []TrafficSplitConfigRuleWeightedUpstream `json:"weighted_upstreams"`
 }
 
+// TrafficSplitConfigRuleMatch is the match condition of the traffic split
+// plugin rule, the rule takes effect only if the request matches it.
+// +k8s:deepcopy-gen=true
+type TrafficSplitConfigRuleMatch struct {
+	Vars Vars `json:"vars,omitempty"`
+}
+
 // TrafficSplitConfigRuleWeightedUpstream is the weighted upstream config in
 // the traffic split plugin rule.
 // +k8s:deepcopy-gen=true
@@ -136,6 +144,7 @@ type WolfRBACConsumerConfig struct {
 type RewriteConfig struct {
 	RewriteTarget      string   `json:"uri,omitempty"`
 	RewriteTargetRegex []string `json:"regex_uri,omitempty"`
+	Host               string   `json:"host,omitempty"`
 	Headers            Headers  `json:"headers,omitempty"`
 }
 
@@ -168,6 +177,40 @@ type ForwardAuthConfig struct {
 	ClientHeaders   []string `json:"client_headers,omitempty"`
 }
 
+// LimitCountConfig is the rule config for limit-count plugin.
+// +k8s:deepcopy-gen=true
+type LimitCountConfig struct {
+	Count        int    `json:"count"`
+	TimeWindow   int    `json:"time_window"`
+	Key          string `json:"key,omitempty"`
+	RejectedCode int    `json:"rejected_code,omitempty"`
+}
+
+// LimitReqConfig is the rule config for limit-req plugin.
+// +k8s:deepcopy-gen=true
+type LimitReqConfig struct {
+	Rate         float64 `json:"rate"`
+	Burst        float64 `json:"burst"`
+	Key          string  `json:"key"`
+	RejectedCode int     `json:"rejected_code,omitempty"`
+}
+
+// LimitConnConfig is the rule config for limit-conn plugin.
+// +k8s:deepcopy-gen=true
+type LimitConnConfig struct {
+	Conn             int     `json:"conn"`
+	Burst            int     `json:"burst"`
+	DefaultConnDelay float64 `json:"default_conn_delay"`
+	Key              string  `json:"key"`
+	RejectedCode     int     `json:"rejected_code,omitempty"`
+}
+
+// ClientControlConfig is the rule config for client-control plugin.
+// +k8s:deepcopy-gen=true
+type ClientControlConfig struct {
+	MaxBodySize int64 `json:"max_body_size"`
+}
+
 // BasicAuthConfig is the rule config for basic-auth plugin.
 // +k8s:deepcopy-gen=true
 type BasicAuthConfig struct {
@@ -79,6 +79,9 @@ const (
 	// DefaultUpstreamTimeout represents the default connect,
 	// read and send timeout (in seconds) with upstreams.
 	DefaultUpstreamTimeout = 60
+
+	// SecretManagerVault represents the HashiCorp Vault secret manager.
+	SecretManagerVault = "vault"
 )
 
 var ValidSchemes map[string]struct{} = map[string]struct{}{
@@ -115,6 +118,7 @@ type Route struct {
 	UpstreamId      string           `json:"upstream_id,omitempty" yaml:"upstream_id,omitempty"`
 	Plugins         Plugins          `json:"plugins,omitempty" yaml:"plugins,omitempty"`
 	PluginConfigId  string           `json:"plugin_config_id,omitempty" yaml:"plugin_config_id,omitempty"`
+	ServiceId       string           `json:"service_id,omitempty" yaml:"service_id,omitempty"`
 	FilterFunc      string           `json:"filter_func,omitempty" yaml:"filter_func,omitempty"`
 }
 
@@ -191,6 +195,36 @@ func (p *Plugins) DeepCopy() *Plugins {
 	return out
 }
 
+// TrafficSplitUpstreamIDs returns the IDs of the upstreams referenced by the
+// weighted upstreams of the traffic-split plugin.
+func (p Plugins) TrafficSplitUpstreamIDs() []string {
+	cfg, ok := p["traffic-split"]
+	if !ok || cfg == nil {
+		return nil
+	}
+	ts, ok := cfg.(*TrafficSplitConfig)
+	if !ok {
+		// The plugin config is a generic map if it's decoded from APISIX.
+		data, err := json.Marshal(cfg)
+		if err != nil {
+			return nil
+		}
+		ts = &TrafficSplitConfig{}
+		if err := json.Unmarshal(data, ts); err != nil {
+			return nil
+		}
+	}
+	var ids []string
+	for _, rule := range ts.Rules {
+		for _, wu := range rule.WeightedUpstreams {
+			if wu.UpstreamID != "" {
+				ids = append(ids, wu.UpstreamID)
+			}
+		}
+	}
+	return ids
+}
+
 // Upstream is the apisix upstream definition.
 // +k8s:deepcopy-gen=true
 type Upstream struct {
@@ -485,6 +519,39 @@ type Consumer struct {
 	Desc     string            `json:"desc,omitempty" yaml:"desc,omitempty"`
 	Labels   map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
 	Plugins  Plugins           `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	GroupID  string            `json:"group_id,omitempty" yaml:"group_id,omitempty"`
+}
+
+// ConsumerGroup represents the consumer_group object in APISIX, the
+// plugins are shared by the consumers in the group.
+// +k8s:deepcopy-gen=true
+type ConsumerGroup struct {
+	ID      string            `json:"id" yaml:"id"`
+	Desc    string            `json:"desc,omitempty" yaml:"desc,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Plugins Plugins           `json:"plugins" yaml:"plugins"`
+}
+
+// Secret represents the secret object in APISIX, which tells APISIX how
+// to access the secret manager to resolve the `$secret://` references.
+// +k8s:deepcopy-gen=true
+type Secret struct {
+	// ID is composed by the secret manager and the identity of the object,
+	// like "vault/1".
+	ID     string `json:"id" yaml:"id"`
+	URI    string `json:"uri" yaml:"uri"`
+	Prefix string `json:"prefix" yaml:"prefix"`
+	Token  string `json:"token" yaml:"token"`
+}
+
+// Proto represents the proto object in APISIX, the protobuf definition
+// is referred by the grpc-transcode plugin through its ID.
+// +k8s:deepcopy-gen=true
+type Proto struct {
+	ID      string            `json:"id" yaml:"id"`
+	Desc    string            `json:"desc,omitempty" yaml:"desc,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Content string            `json:"content" yaml:"content"`
 }
 
 // PluginConfig apisix plugin object
@@ -494,6 +561,17 @@ type PluginConfig struct {
 	Plugins  Plugins `json:"plugins" yaml:"plugins"`
 }
 
+// Service apisix service object, the upstream and plugins of a service are
+// shared by the routes which refer to it.
+// +k8s:deepcopy-gen=true
+type Service struct {
+	Metadata        `json:",inline" yaml:",inline"`
+	Hosts           []string `json:"hosts,omitempty" yaml:"hosts,omitempty"`
+	UpstreamId      string   `json:"upstream_id,omitempty" yaml:"upstream_id,omitempty"`
+	Plugins         Plugins  `json:"plugins,omitempty" yaml:"plugins,omitempty"`
+	EnableWebsocket bool     `json:"enable_websocket,omitempty" yaml:"enable_websocket,omitempty"`
+}
+
 type PluginMetadata struct {
 	Name     string
 	Metadata map[string]any
@@ -567,6 +645,19 @@ func NewDefaultPluginConfig() *PluginConfig {
 	}
 }
 
+// NewDefaultService returns an empty Service with default values.
+func NewDefaultService() *Service {
+	return &Service{
+		Metadata: Metadata{
+			Desc: "Created by apisix-ingress-controller, DO NOT modify it manually",
+			Labels: map[string]string{
+				"managed-by": "apisix-ingress-controller",
+			},
+		},
+		Plugins: make(Plugins),
+	}
+}
+
 // NewDefaultGlobalRule returns an empty PluginConfig with default values.
 func NewDefaultGlobalRule() *GlobalRule {
 	return &GlobalRule{
@@ -574,6 +665,34 @@ func NewDefaultGlobalRule() *GlobalRule {
 	}
 }
 
+// NewDefaultConsumerGroup returns an empty ConsumerGroup with default values.
+func NewDefaultConsumerGroup() *ConsumerGroup {
+	return &ConsumerGroup{
+		Desc: "Created by apisix-ingress-controller, DO NOT modify it manually",
+		Labels: map[string]string{
+			"managed-by": "apisix-ingress-controller",
+		},
+		Plugins: make(Plugins),
+	}
+}
+
+// NewDefaultProto returns an empty Proto with default values.
+func NewDefaultProto() *Proto {
+	return &Proto{
+		Desc: "Created by apisix-ingress-controller, DO NOT modify it manually",
+		Labels: map[string]string{
+			"managed-by": "apisix-ingress-controller",
+		},
+	}
+}
+
+// NewDefaultSecret returns an empty Secret managed by the given secret manager.
+func NewDefaultSecret(manager, id string) *Secret {
+	return &Secret{
+		ID: manager + "/" + id,
+	}
+}
+
 // ComposeUpstreamName uses namespace, name, subset (optional), port, resolveGranularity info to compose
 // the upstream name.
 // the resolveGranularity is not composited in the upstream name when it is endpoint.
@@ -609,6 +728,13 @@ func ComposeUpstreamName(namespace, name, subset string, port int32, resolveGran
 	return buf.String()
 }
 
+// ComposeGRPCUpstreamName composes the name of the upstream proxied with the
+// grpc or grpcs scheme, so that it doesn't collide with the upstream of the
+// same Service port proxied with other schemes.
+func ComposeGRPCUpstreamName(upstreamName, scheme string) string {
+	return upstreamName + "_" + scheme
+}
+
 // ComposeExternalUpstreamName uses ApisixUpstream namespace, name to compose the upstream name.
 func ComposeExternalUpstreamName(namespace, name string) string {
 	return namespace + "_" + name
@@ -678,6 +804,19 @@ func ComposePluginConfigName(namespace, name string) string {
 	return buf.String()
 }
 
+// ComposeServiceName uses namespace, name to compose
+// the service name.
+func ComposeServiceName(namespace, name string) string {
+	p := make([]byte, 0, len(namespace)+len(name)+1)
+	buf := bytes.NewBuffer(p)
+
+	buf.WriteString(namespace)
+	buf.WriteByte('_')
+	buf.WriteString(name)
+
+	return buf.String()
+}
+
 // ComposeGlobalRuleName uses namespace, name to compose
 // the global_rule name.
 func ComposeGlobalRuleName(namespace, name string) string {
@@ -693,6 +832,57 @@ func ComposeGlobalRuleName(namespace, name string) string {
 	return buf.String()
 }
 
+// ComposeConsumerGroupName uses namespace, name to compose
+// the consumer_group name.
+func ComposeConsumerGroupName(namespace, name string) string {
+	p := make([]byte, 0, len(namespace)+len(name)+1)
+	buf := bytes.NewBuffer(p)
+
+	buf.WriteString(namespace)
+	buf.WriteByte('_')
+	buf.WriteString(name)
+
+	return buf.String()
+}
+
+// ComposeProtoName uses namespace, name to compose
+// the proto name.
+func ComposeProtoName(namespace, name string) string {
+	p := make([]byte, 0, len(namespace)+len(name)+1)
+	buf := bytes.NewBuffer(p)
+
+	buf.WriteString(namespace)
+	buf.WriteByte('_')
+	buf.WriteString(name)
+
+	return buf.String()
+}
+
+// ComposeSecretProviderName uses namespace, name to compose
+// the secret name.
+func ComposeSecretProviderName(namespace, name string) string {
+	p := make([]byte, 0, len(namespace)+len(name)+1)
+	buf := bytes.NewBuffer(p)
+
+	buf.WriteString(namespace)
+	buf.WriteByte('_')
+	buf.WriteString(name)
+
+	return buf.String()
+}
+
+// ComposeSecretReference composes the reference to the key of the secret
+// stored in the secret manager, which will be resolved by APISIX.
+func ComposeSecretReference(secretID, name, key string) string {
+	return "$secret://" + secretID + "/" + name + "/" + key
+}
+
+// ComposeEnvReference composes the reference to the environment variable of
+// APISIX, which will be resolved by APISIX.
+func ComposeEnvReference(name string) string {
+	return "$env://" + name
+}
+
 // Schema represents the schema of APISIX objects.
 type Schema struct {
 	Name    string `json:"name,omitempty" yaml:"name,omitempty"`
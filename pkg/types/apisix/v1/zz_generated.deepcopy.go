@@ -88,6 +88,22 @@ func (in *CSRFConfig) DeepCopy() *CSRFConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientControlConfig) DeepCopyInto(out *ClientControlConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientControlConfig.
+func (in *ClientControlConfig) DeepCopy() *ClientControlConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientControlConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Consumer) DeepCopyInto(out *Consumer) {
 	*out = *in
@@ -112,6 +128,30 @@ func (in *Consumer) DeepCopy() *Consumer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsumerGroup) DeepCopyInto(out *ConsumerGroup) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Plugins.DeepCopyInto(&out.Plugins)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsumerGroup.
+func (in *ConsumerGroup) DeepCopy() *ConsumerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsumerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CorsConfig) DeepCopyInto(out *CorsConfig) {
 	*out = *in
@@ -287,6 +327,54 @@ func (in *LDAPAuthConsumerConfig) DeepCopy() *LDAPAuthConsumerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitConnConfig) DeepCopyInto(out *LimitConnConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitConnConfig.
+func (in *LimitConnConfig) DeepCopy() *LimitConnConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitConnConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitCountConfig) DeepCopyInto(out *LimitCountConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitCountConfig.
+func (in *LimitCountConfig) DeepCopy() *LimitCountConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitCountConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LimitReqConfig) DeepCopyInto(out *LimitReqConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitReqConfig.
+func (in *LimitReqConfig) DeepCopy() *LimitReqConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LimitReqConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -344,6 +432,29 @@ func (in *PluginConfig) DeepCopy() *PluginConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Proto) DeepCopyInto(out *Proto) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Proto.
+func (in *Proto) DeepCopy() *Proto {
+	if in == nil {
+		return nil
+	}
+	out := new(Proto)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedirectConfig) DeepCopyInto(out *RedirectConfig) {
 	*out = *in
@@ -491,6 +602,45 @@ func (in *Route) DeepCopy() *Route {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Secret) DeepCopyInto(out *Secret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Secret.
+func (in *Secret) DeepCopy() *Secret {
+	if in == nil {
+		return nil
+	}
+	out := new(Secret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Service) DeepCopyInto(out *Service) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Plugins.DeepCopyInto(&out.Plugins)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Service.
+func (in *Service) DeepCopy() *Service {
+	if in == nil {
+		return nil
+	}
+	out := new(Service)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Ssl) DeepCopyInto(out *Ssl) {
 	*out = *in
@@ -600,6 +750,13 @@ func (in *TrafficSplitConfig) DeepCopy() *TrafficSplitConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficSplitConfigRule) DeepCopyInto(out *TrafficSplitConfigRule) {
 	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = make([]TrafficSplitConfigRuleMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.WeightedUpstreams != nil {
 		in, out := &in.WeightedUpstreams, &out.WeightedUpstreams
 		*out = make([]TrafficSplitConfigRuleWeightedUpstream, len(*in))
@@ -618,6 +775,35 @@ func (in *TrafficSplitConfigRule) DeepCopy() *TrafficSplitConfigRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitConfigRuleMatch) DeepCopyInto(out *TrafficSplitConfigRuleMatch) {
+	*out = *in
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(Vars, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make([]StringOrSlice, len(*in))
+				for i := range *in {
+					(*in)[i].DeepCopyInto(&(*out)[i])
+				}
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitConfigRuleMatch.
+func (in *TrafficSplitConfigRuleMatch) DeepCopy() *TrafficSplitConfigRuleMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitConfigRuleMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficSplitConfigRuleWeightedUpstream) DeepCopyInto(out *TrafficSplitConfigRuleWeightedUpstream) {
 	*out = *in
@@ -145,6 +145,7 @@ rules:
     resources:
       - ingresses
       - ingresses/status
+      - ingressclasses
       - networkpolicies
     verbs:
       - '*'
@@ -173,6 +174,15 @@ rules:
       - apisixpluginconfigs/status
       - apisixglobalrules
       - apisixglobalrules/status
+      - apisixconsumergroups
+      - apisixconsumergroups/status
+      - apisixsecretproviders
+      - apisixsecretproviders/status
+      - apisixservices
+      - apisixservices/status
+      - apisixprotos
+      - apisixprotos/status
+      - apisixingressclassconfigs
     verbs:
       - '*'
   - apiGroups:
@@ -193,15 +203,27 @@ rules:
     - gateway.networking.k8s.io
     resources:
     - httproutes
+    - grpcroutes
     - tlsroutes
     - tcproutes
     - gateways
     - gatewayclasses
     - udproutes
+    - referencegrants
     verbs:
     - get
     - list
     - watch
+  - apiGroups:
+    - gateway.networking.k8s.io
+    resources:
+    - httproutes/status
+    - grpcroutes/status
+    - tlsroutes/status
+    - tcproutes/status
+    - udproutes/status
+    verbs:
+    - update
 `
 	_clusterRoleBinding = `
 apiVersion: rbac.authorization.k8s.io/v1